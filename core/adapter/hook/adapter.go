@@ -1,6 +1,7 @@
 package hook
 
 import (
+	"fmt"
 	"net"
 	"time"
 
@@ -8,13 +9,20 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
 	"github.com/ff14wed/aetherometer/core/process"
 	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
 )
 
 // Adapter defines the implementation of the hook Adapter
 type Adapter struct {
 	*suture.Supervisor
+
+	health  *HealthMonitor
+	scanner *process.Scanner
+	manager *Manager
 }
 
 // AdapterConfig provides commonly accessed configuration for the hook
@@ -27,6 +35,9 @@ type AdapterConfig struct {
 
 	RemoteProcessProvider RemoteProcessProvider
 	ProcessEnumerator     process.Enumerator
+
+	OpcodeRegistry    *opcodes.Registry
+	TransformRegistry *transform.Registry
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RemoteProcessProvider
@@ -55,16 +66,27 @@ func NewAdapter(cfg AdapterConfig, logger *zap.Logger) *Adapter {
 				supervisorLogger.Info(line)
 			},
 		}),
+		health: NewHealthMonitor(0),
+	}
+
+	matcher, err := newProcessMatcher(cfg.HookConfig)
+	if err != nil {
+		hookLogger.Error("Invalid process rules", zap.Error(err))
+		a.health.SetState(models.HealthStateDegraded)
+		a.health.SetError(err)
 	}
 
 	scanTicker := time.NewTicker(1 * time.Second)
 	scanner := process.NewScanner(
-		cfg.HookConfig.FFXIVProcess,
+		matcher,
 		scanTicker.C,
 		cfg.ProcessEnumerator,
 		10,
 		hookLogger,
 	)
+	if cfg.HookConfig.DisableAutoAttach {
+		scanner.DisableAutoAttach()
+	}
 
 	streamSupervisorLogger := hookLogger.Named("stream-supervisor")
 	streamSupervisor := suture.New("stream-supervisor", suture.Spec{
@@ -74,7 +96,14 @@ func NewAdapter(cfg AdapterConfig, logger *zap.Logger) *Adapter {
 	})
 
 	streamBuilder := func(streamID uint32) Stream {
-		return NewStream(streamID, cfg, hookLogger)
+		s, err := newStream(streamID, cfg, hookLogger)
+		if err != nil {
+			a.health.SetState(models.HealthStateDegraded)
+			a.health.SetError(err)
+			return nil
+		}
+		a.health.SetState(models.HealthStateConnecting)
+		return s
 	}
 
 	manager := NewManager(
@@ -83,12 +112,71 @@ func NewAdapter(cfg AdapterConfig, logger *zap.Logger) *Adapter {
 		scanner.ProcessRemoveEventListener(),
 		streamBuilder,
 		streamSupervisor,
+		a.health,
 		hookLogger,
 	)
 
+	a.scanner = scanner
+	a.manager = manager
 	a.Add(scanner)
 	a.Add(streamSupervisor)
 	a.Add(manager)
 
 	return a
 }
+
+// newProcessMatcher returns a Matcher for the process rules of the hook
+// config, or for the FFXIVProcess name if no rules are provided. If the rules
+// cannot be compiled, the returned Matcher matches no processes.
+func newProcessMatcher(cfg config.HookConfig) (*process.Matcher, error) {
+	if len(cfg.ProcessRules) == 0 {
+		return process.NewSubstringMatcher(cfg.FFXIVProcess), nil
+	}
+	matcher, err := process.NewMatcher(cfg.ProcessRules)
+	if err != nil {
+		return new(process.Matcher), err
+	}
+	return matcher, nil
+}
+
+// Health reports whether the adapter was able to initialize the hook and
+// complete the handshake in the most recently found process, along with the
+// last error encountered.
+func (a *Adapter) Health() models.Health {
+	return a.health.Health()
+}
+
+// CandidateProcesses lists the processes matched by the scanner, along with
+// the IDs of the streams of the processes that are attached.
+func (a *Adapter) CandidateProcesses() []models.ProcessCandidate {
+	attached := make(map[uint32]struct{})
+	for _, streamID := range a.manager.AttachedStreams() {
+		attached[streamID] = struct{}{}
+	}
+	candidates := []models.ProcessCandidate{}
+	for pid, rule := range a.scanner.Candidates() {
+		c := models.ProcessCandidate{Pid: int(pid), Rule: rule}
+		if _, found := attached[pid]; found {
+			streamID := int(pid)
+			c.StreamID = &streamID
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// AttachProcess injects the hook into the candidate process identified by pid
+// and starts its stream.
+func (a *Adapter) AttachProcess(pid int) error {
+	if _, found := a.scanner.Candidates()[uint32(pid)]; !found {
+		return fmt.Errorf("process %d is not a candidate process", pid)
+	}
+	return a.manager.Attach(uint32(pid))
+}
+
+// DetachProcess shuts down the stream identified by streamID. The hook is not
+// unloaded from the process, so attaching to the process again reconnects to
+// the hook.
+func (a *Adapter) DetachProcess(streamID int) error {
+	return a.manager.Detach(uint32(streamID))
+}
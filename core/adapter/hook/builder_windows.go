@@ -2,21 +2,32 @@ package hook
 
 import (
 	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/opcodes"
 	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
 	"github.com/ff14wed/aetherometer/core/win32"
 	"go.uber.org/zap"
 )
 
-// GetInfo returns the adapter's name and a builder for the adapter.
-func GetInfo() stream.AdapterInfo {
+// GetInfo returns the adapter's name and a builder for the adapter. The
+// registries are used to parse and transform the blocks of every stream.
+func GetInfo(
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
+) stream.AdapterInfo {
 	return stream.AdapterInfo{
-		Name:    "Hook",
-		Builder: &builder{},
+		Name: "Hook",
+		Builder: &builder{
+			opcodes:    opcodeRegistry,
+			transforms: transformRegistry,
+		},
 	}
 }
 
 type builder struct {
-	cfg config.Config
+	cfg        config.Config
+	opcodes    *opcodes.Registry
+	transforms *transform.Registry
 }
 
 // LoadConfig loads the configuration for the adapter into the builder.
@@ -41,6 +52,8 @@ func (b *builder) Build(
 			StreamDown:            streamDown,
 			RemoteProcessProvider: p,
 			ProcessEnumerator:     p,
+			OpcodeRegistry:        b.opcodes,
+			TransformRegistry:     b.transforms,
 		},
 		logger,
 	)
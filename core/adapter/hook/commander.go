@@ -0,0 +1,246 @@
+package hook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrCommanderStopped is returned for requests that are still waiting on a
+// reply when the Commander is stopped.
+var ErrCommanderStopped = errors.New("stream has been stopped")
+
+// replyKey identifies the reply to a request sent to the hook
+type replyKey struct {
+	op      byte
+	channel uint32
+}
+
+// Commander is responsible for sending requests to the hook and correlating
+// them with the hook's replies. The hook echoes the channel of a ping, so
+// pings are matched to their replies by channel. The hook replies to an
+// OpOption payload with the options it applied, so only one change of options
+// may be in flight at any time.
+//
+// The Commander also keeps track of the version and the options reported by
+// the hook. All payloads are forwarded to the next consumer.
+type Commander struct {
+	hds          HookDataSender
+	payloadsChan <-chan Payload
+	timeout      time.Duration
+	logger       *zap.Logger
+
+	outChan chan Payload
+
+	lock       sync.Mutex
+	waiters    map[replyKey]chan Payload
+	nextPingID uint32
+	options    *uint32
+	version    string
+
+	optionsLock sync.Mutex
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewCommander returns a new Commander. Requests fail if the hook does not
+// reply within the timeout.
+func NewCommander(
+	hds HookDataSender,
+	payloadsChan <-chan Payload,
+	timeout time.Duration,
+	logger *zap.Logger,
+) *Commander {
+	return &Commander{
+		hds:          hds,
+		payloadsChan: payloadsChan,
+		timeout:      timeout,
+		logger:       logger.Named("commander"),
+
+		outChan: make(chan Payload),
+
+		waiters: make(map[replyKey]chan Payload),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for matching replies from the hook and
+// forwarding payloads.
+func (c *Commander) Serve() {
+	defer close(c.stopDone)
+	c.logger.Info("Running")
+
+	payloadsChan := c.payloadsChan
+	for {
+		select {
+		case p, ok := <-payloadsChan:
+			if !ok {
+				payloadsChan = nil
+				continue
+			}
+			c.handlePayload(p)
+			select {
+			case c.outChan <- p:
+			case <-c.stop:
+				c.logger.Info("Stopping...")
+				return
+			}
+		case <-c.stop:
+			c.logger.Info("Stopping...")
+			return
+		}
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (c *Commander) Stop() {
+	close(c.stop)
+	<-c.stopDone
+}
+
+// ReceivedPayloadsListener returns a channel on which consumers can listen
+// for payloads forwarded by the Commander.
+func (c *Commander) ReceivedPayloadsListener() <-chan Payload {
+	return c.outChan
+}
+
+func (c *Commander) handlePayload(p Payload) {
+	switch p.Op {
+	case OpDebug:
+		if bytes.HasPrefix(p.Data, helloPrefix) {
+			version := ""
+			if v, ok := parseHello(p.Data); ok {
+				version = v.String()
+			}
+			c.lock.Lock()
+			c.version = version
+			c.lock.Unlock()
+		}
+	case OpOption:
+		options := p.Channel
+		c.lock.Lock()
+		c.options = &options
+		c.lock.Unlock()
+		c.deliver(replyKey{op: OpOption}, p)
+	case OpPing:
+		if p.Channel != 0 {
+			c.deliver(replyKey{op: OpPing, channel: p.Channel}, p)
+		}
+	}
+}
+
+// deliver hands the reply to the request waiting on it, if any
+func (c *Commander) deliver(key replyKey, p Payload) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if ch, found := c.waiters[key]; found {
+		delete(c.waiters, key)
+		ch <- p
+	}
+}
+
+// request sends a payload to the hook and waits for the reply identified by
+// the key.
+func (c *Commander) request(key replyKey, op byte, channel uint32, data []byte) (Payload, error) {
+	ch := make(chan Payload, 1)
+	c.lock.Lock()
+	c.waiters[key] = ch
+	c.lock.Unlock()
+	defer func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if c.waiters[key] == ch {
+			delete(c.waiters, key)
+		}
+	}()
+
+	c.hds.Send(op, channel, data)
+
+	t := time.NewTimer(c.timeout)
+	defer t.Stop()
+	select {
+	case p := <-ch:
+		return p, nil
+	case <-t.C:
+		return Payload{}, fmt.Errorf("timed out waiting for the hook to reply after %s", c.timeout)
+	case <-c.stop:
+		return Payload{}, ErrCommanderStopped
+	}
+}
+
+// SetOptions requests the hook to apply the options and returns the options
+// the hook applied. It returns an error if the hook applied different
+// options.
+func (c *Commander) SetOptions(options uint32) (uint32, error) {
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	return c.setOptions(options)
+}
+
+// UpdateOptions applies the result of update to the options currently applied
+// by the hook. It returns an error if the hook has not reported its options
+// yet.
+func (c *Commander) UpdateOptions(update func(options uint32) uint32) (uint32, error) {
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	options, ok := c.Options()
+	if !ok {
+		return 0, errors.New("the hook has not reported its options yet")
+	}
+	return c.setOptions(update(options))
+}
+
+// setOptions is expected to be used while holding the optionsLock
+func (c *Commander) setOptions(options uint32) (uint32, error) {
+	reply, err := c.request(replyKey{op: OpOption}, OpOption, options, nil)
+	if err != nil {
+		return 0, err
+	}
+	if reply.Channel != options {
+		return reply.Channel, fmt.Errorf("hook applied options %#b, expected %#b", reply.Channel, options)
+	}
+	return reply.Channel, nil
+}
+
+// Ping sends a ping to the hook and returns the round trip time.
+func (c *Commander) Ping() (time.Duration, error) {
+	c.lock.Lock()
+	c.nextPingID++
+	if c.nextPingID == 0 {
+		// Channel 0 is reserved for the StreamPinger
+		c.nextPingID++
+	}
+	id := c.nextPingID
+	c.lock.Unlock()
+
+	start := time.Now()
+	_, err := c.request(replyKey{op: OpPing, channel: id}, OpPing, id, nil)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// Options returns the options last reported by the hook.
+func (c *Commander) Options() (uint32, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.options == nil {
+		return 0, false
+	}
+	return *c.options, true
+}
+
+// Version returns the version reported by the hook in its hello message.
+func (c *Commander) Version() (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.version, c.version != ""
+}
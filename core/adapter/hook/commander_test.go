@@ -0,0 +1,200 @@
+package hook_test
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/adapter/hook/hookfakes"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Commander", func() {
+	var (
+		cmd          *hook.Commander
+		hds          *hookfakes.FakeHookDataSender
+		payloadsChan chan hook.Payload
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+
+		supervisor *suture.Supervisor
+	)
+
+	// reply sends the payload to the Commander as if it came from the hook
+	reply := func(p hook.Payload) {
+		payloadsChan <- p
+		Eventually(cmd.ReceivedPayloadsListener()).Should(Receive(Equal(p)))
+	}
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("commandertest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"commandertest://"}
+		logger, err := zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		hds = new(hookfakes.FakeHookDataSender)
+		payloadsChan = make(chan hook.Payload)
+		cmd = hook.NewCommander(hds, payloadsChan, 200*time.Millisecond, logger)
+
+		supervisor = suture.New("test-commander", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(cmd)
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It(`logs "Running" on startup`, func() {
+		Eventually(logBuf).Should(gbytes.Say("commander.*Running"))
+	})
+
+	It(`logs "Stopping..." on shutdown`, func() {
+		supervisor.Stop()
+		Eventually(logBuf).Should(gbytes.Say("commander.*Stopping..."))
+	})
+
+	It("forwards every payload to the next consumer", func() {
+		reply(hook.Payload{Op: hook.OpRecv, Data: []byte{1, 2, 3}})
+		reply(hook.Payload{Op: hook.OpPing, Channel: 5})
+	})
+
+	Describe("Version", func() {
+		It("returns the version reported in the hook's hello message", func() {
+			_, ok := cmd.Version()
+			Expect(ok).To(BeFalse())
+
+			reply(hook.Payload{Op: hook.OpDebug, Data: []byte("SERVER HELLO. VERSION: 0.9.3.")})
+			version, ok := cmd.Version()
+			Expect(ok).To(BeTrue())
+			Expect(version).To(Equal("0.9.3"))
+		})
+	})
+
+	Describe("Options", func() {
+		It("returns the options last reported by the hook", func() {
+			_, ok := cmd.Options()
+			Expect(ok).To(BeFalse())
+
+			reply(hook.Payload{Op: hook.OpOption, Channel: 54})
+			options, ok := cmd.Options()
+			Expect(ok).To(BeTrue())
+			Expect(options).To(BeEquivalentTo(54))
+		})
+	})
+
+	Describe("SetOptions", func() {
+		It("sends the options and returns the options applied by the hook", func() {
+			result := make(chan uint32)
+			go func() {
+				defer GinkgoRecover()
+				options, err := cmd.SetOptions(6)
+				Expect(err).ToNot(HaveOccurred())
+				result <- options
+			}()
+
+			Eventually(hds.SendCallCount).Should(Equal(1))
+			op, channel, _ := hds.SendArgsForCall(0)
+			Expect(op).To(BeEquivalentTo(hook.OpOption))
+			Expect(channel).To(BeEquivalentTo(6))
+
+			reply(hook.Payload{Op: hook.OpOption, Channel: 6})
+			Eventually(result).Should(Receive(BeEquivalentTo(6)))
+		})
+
+		It("returns an error if the hook applied different options", func() {
+			errChan := make(chan error)
+			go func() {
+				_, err := cmd.SetOptions(6)
+				errChan <- err
+			}()
+
+			Eventually(hds.SendCallCount).Should(Equal(1))
+			reply(hook.Payload{Op: hook.OpOption, Channel: 2})
+			Eventually(errChan).Should(Receive(MatchError("hook applied options 0b10, expected 0b110")))
+		})
+
+		It("returns an error if the hook does not reply in time", func() {
+			_, err := cmd.SetOptions(6)
+			Expect(err).To(MatchError("timed out waiting for the hook to reply after 200ms"))
+		})
+	})
+
+	Describe("UpdateOptions", func() {
+		It("returns an error if the hook has not reported its options", func() {
+			_, err := cmd.UpdateOptions(func(options uint32) uint32 { return options })
+			Expect(err).To(MatchError("the hook has not reported its options yet"))
+			Expect(hds.SendCallCount()).To(BeZero())
+		})
+
+		It("updates the options last reported by the hook", func() {
+			reply(hook.Payload{Op: hook.OpOption, Channel: 54})
+
+			go func() {
+				_, _ = cmd.UpdateOptions(func(options uint32) uint32 {
+					return options &^ hook.OptionRecvChat
+				})
+			}()
+			Eventually(hds.SendCallCount).Should(Equal(1))
+			_, channel, _ := hds.SendArgsForCall(0)
+			Expect(channel).To(BeEquivalentTo(50))
+		})
+	})
+
+	Describe("Ping", func() {
+		It("matches the reply to the ping by channel", func() {
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				rtt, err := cmd.Ping()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rtt).To(BeNumerically(">", 0))
+				close(done)
+			}()
+
+			Eventually(hds.SendCallCount).Should(Equal(1))
+			op, channel, _ := hds.SendArgsForCall(0)
+			Expect(op).To(BeEquivalentTo(hook.OpPing))
+			Expect(channel).ToNot(BeZero())
+
+			reply(hook.Payload{Op: hook.OpPing, Channel: 0})
+			reply(hook.Payload{Op: hook.OpPing, Channel: channel + 1})
+			Consistently(done).ShouldNot(BeClosed())
+
+			reply(hook.Payload{Op: hook.OpPing, Channel: channel})
+			Eventually(done).Should(BeClosed())
+		})
+	})
+
+	It("fails waiting requests when it is stopped", func() {
+		errChan := make(chan error)
+		go func() {
+			_, err := cmd.Ping()
+			errChan <- err
+		}()
+		Eventually(hds.SendCallCount).Should(Equal(1))
+		supervisor.Stop()
+		Eventually(errChan).Should(Receive(MatchError(hook.ErrCommanderStopped)))
+	})
+})
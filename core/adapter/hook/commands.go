@@ -0,0 +1,383 @@
+package hook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// Names of the commands that can be sent in a request to a hook stream. A
+// command request is a JSON object of the form {"command": "<name>", ...}.
+const (
+	CommandSetOptions      = "setOptions"
+	CommandEnableChannels  = "enableChannels"
+	CommandDisableChannels = "disableChannels"
+	CommandVersion         = "version"
+	CommandPing            = "ping"
+	CommandStats           = "stats"
+	CommandRecord          = "record"
+)
+
+// allOptions is the set of all known option flags
+const allOptions = OptionRecvZone | OptionRecvChat | OptionRecvLobby |
+	OptionSendZone | OptionSendChat | OptionSendLobby
+
+// channelOptions maps the names of the hook's channels to their option flags,
+// in the order of the flags
+var channelOptions = []struct {
+	name   string
+	option uint32
+}{
+	{"recvZone", OptionRecvZone},
+	{"recvChat", OptionRecvChat},
+	{"recvLobby", OptionRecvLobby},
+	{"sendZone", OptionSendZone},
+	{"sendChat", OptionSendChat},
+	{"sendLobby", OptionSendLobby},
+}
+
+// schemaProvider is implemented by the types of command fields whose values
+// are restricted further than their Go type
+type schemaProvider interface {
+	jsonSchema() string
+}
+
+// optionFlags is a set of the hook's option flags
+type optionFlags uint32
+
+func (optionFlags) jsonSchema() string {
+	return fmt.Sprintf(`{"type": "integer", "minimum": 0, "maximum": %d}`, allOptions)
+}
+
+// channelName is the name of one of the hook's channels
+type channelName string
+
+func (channelName) jsonSchema() string {
+	names := make([]string, len(channelOptions))
+	for i, c := range channelOptions {
+		names[i] = strconv.Quote(c.name)
+	}
+	return fmt.Sprintf(`{"enum": [%s]}`, strings.Join(names, ", "))
+}
+
+// commandSchema returns the JSON schema of the named command's request,
+// generated from the struct that the request is decoded into. Every field
+// of the struct is required.
+func commandSchema(name string, cmd interface{}) string {
+	props := []string{fmt.Sprintf(`"command": {"const": %q}`, name)}
+	required := []string{`"command"`}
+	t := reflect.TypeOf(cmd)
+	for i := 0; i < t.NumField(); i++ {
+		field := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if field == "" || field == "-" || field == "command" {
+			continue
+		}
+		props = append(props, fmt.Sprintf(`%q: %s`, field, typeSchema(t.Field(i).Type)))
+		required = append(required, strconv.Quote(field))
+	}
+	return fmt.Sprintf(
+		`{"type": "object", "properties": {%s}, "required": [%s], "additionalProperties": false}`,
+		strings.Join(props, ", "), strings.Join(required, ", "),
+	)
+}
+
+// typeSchema returns the JSON schema of the values of a command field
+func typeSchema(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if p, ok := reflect.Zero(t).Interface().(schemaProvider); ok {
+		return p.jsonSchema()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return `{"type": "boolean"}`
+	case reflect.String:
+		return `{"type": "string"}`
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return `{"type": "integer"}`
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return `{"type": "integer", "minimum": 0}`
+	case reflect.Slice:
+		return fmt.Sprintf(`{"type": "array", "minItems": 1, "items": %s}`, typeSchema(t.Elem()))
+	}
+	return `{}`
+}
+
+type setOptionsCommand struct {
+	Command string       `json:"command"`
+	Options *optionFlags `json:"options"`
+}
+
+type channelsCommand struct {
+	Command  string        `json:"command"`
+	Channels []channelName `json:"channels"`
+}
+
+type recordCommand struct {
+	Command string `json:"command"`
+	Enabled *bool  `json:"enabled"`
+}
+
+type emptyCommand struct {
+	Command string `json:"command"`
+}
+
+// optionsResponse reports the options applied by the hook
+type optionsResponse struct {
+	Options  uint32   `json:"options"`
+	Channels []string `json:"channels"`
+}
+
+func newOptionsResponse(options uint32) optionsResponse {
+	resp := optionsResponse{Options: options, Channels: []string{}}
+	for _, c := range channelOptions {
+		if options&c.option != 0 {
+			resp.Channels = append(resp.Channels, c.name)
+		}
+	}
+	return resp
+}
+
+// versionResponse reports the version of the hook
+type versionResponse struct {
+	Version string `json:"version"`
+}
+
+// pingResponse reports the round trip time of a ping in milliseconds
+type pingResponse struct {
+	RTT float64 `json:"rtt"`
+}
+
+// statsResponse reports the statistics of the stream
+type statsResponse struct {
+	Health    models.Health `json:"health"`
+	Options   *uint32       `json:"options"`
+	Recording bool          `json:"recording"`
+}
+
+// recordResponse reports whether the stream is being recorded, and the
+// session file it is being recorded to
+type recordResponse struct {
+	Recording bool   `json:"recording"`
+	File      string `json:"file,omitempty"`
+}
+
+// hookCommand describes a command supported by a hook stream
+type hookCommand struct {
+	models.StreamCommand
+	run func(s *hookStream, req []byte) (interface{}, error)
+}
+
+var hookCommands = []hookCommand{
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandSetOptions,
+			Description: "Sets the option flags of the hook and returns the options the hook applied.",
+			Schema:      commandSchema(CommandSetOptions, setOptionsCommand{}),
+		},
+		run: runSetOptions,
+	},
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandEnableChannels,
+			Description: "Enables the listed channels of the hook and returns the options the hook applied.",
+			Schema:      commandSchema(CommandEnableChannels, channelsCommand{}),
+		},
+		run: func(s *hookStream, req []byte) (interface{}, error) {
+			return runChannels(s, req, true)
+		},
+	},
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandDisableChannels,
+			Description: "Disables the listed channels of the hook and returns the options the hook applied.",
+			Schema:      commandSchema(CommandDisableChannels, channelsCommand{}),
+		},
+		run: func(s *hookStream, req []byte) (interface{}, error) {
+			return runChannels(s, req, false)
+		},
+	},
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandVersion,
+			Description: "Returns the version of the hook.",
+			Schema:      commandSchema(CommandVersion, emptyCommand{}),
+		},
+		run: runVersion,
+	},
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandPing,
+			Description: "Pings the hook and returns the round trip time in milliseconds.",
+			Schema:      commandSchema(CommandPing, emptyCommand{}),
+		},
+		run: runPing,
+	},
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandStats,
+			Description: "Returns the health of the connection to the hook, the applied options, and whether the stream is being recorded.",
+			Schema:      commandSchema(CommandStats, emptyCommand{}),
+		},
+		run: runStats,
+	},
+	{
+		StreamCommand: models.StreamCommand{
+			Name:        CommandRecord,
+			Description: "Starts or stops recording the data received from the hook to a session file, and returns the file being recorded to.",
+			Schema:      commandSchema(CommandRecord, recordCommand{}),
+		},
+		run: runRecord,
+	},
+}
+
+// decodeCommand strictly decodes the command request into v, rejecting any
+// fields that v does not have. The values of the fields are checked by the
+// command itself.
+func decodeCommand(name string, req []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(req))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		return fmt.Errorf("invalid %s command: %s", name, err)
+	}
+	return nil
+}
+
+func runSetOptions(s *hookStream, req []byte) (interface{}, error) {
+	var cmd setOptionsCommand
+	if err := decodeCommand(CommandSetOptions, req, &cmd); err != nil {
+		return nil, err
+	}
+	if cmd.Options == nil {
+		return nil, fmt.Errorf("invalid %s command: options is required", CommandSetOptions)
+	}
+	requested := uint32(*cmd.Options)
+	if requested&^allOptions != 0 {
+		return nil, fmt.Errorf("invalid %s command: unknown options %#b", CommandSetOptions, requested&^allOptions)
+	}
+	options, err := s.commander.SetOptions(requested)
+	if err != nil {
+		return nil, err
+	}
+	return newOptionsResponse(options), nil
+}
+
+func runChannels(s *hookStream, req []byte, enable bool) (interface{}, error) {
+	name := CommandDisableChannels
+	if enable {
+		name = CommandEnableChannels
+	}
+	var cmd channelsCommand
+	if err := decodeCommand(name, req, &cmd); err != nil {
+		return nil, err
+	}
+	if len(cmd.Channels) == 0 {
+		return nil, fmt.Errorf("invalid %s command: channels is required", name)
+	}
+	var mask uint32
+	for _, ch := range cmd.Channels {
+		found := false
+		for _, c := range channelOptions {
+			if c.name == string(ch) {
+				mask |= c.option
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid %s command: unknown channel %q", name, ch)
+		}
+	}
+	options, err := s.commander.UpdateOptions(func(options uint32) uint32 {
+		if enable {
+			return options | mask
+		}
+		return options &^ mask
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newOptionsResponse(options), nil
+}
+
+func runVersion(s *hookStream, req []byte) (interface{}, error) {
+	if err := decodeCommand(CommandVersion, req, &emptyCommand{}); err != nil {
+		return nil, err
+	}
+	version, ok := s.commander.Version()
+	if !ok {
+		return nil, errors.New("the hook did not report its version")
+	}
+	return versionResponse{Version: version}, nil
+}
+
+func runPing(s *hookStream, req []byte) (interface{}, error) {
+	if err := decodeCommand(CommandPing, req, &emptyCommand{}); err != nil {
+		return nil, err
+	}
+	rtt, err := s.commander.Ping()
+	if err != nil {
+		return nil, err
+	}
+	return pingResponse{RTT: float64(rtt) / float64(time.Millisecond)}, nil
+}
+
+func runStats(s *hookStream, req []byte) (interface{}, error) {
+	if err := decodeCommand(CommandStats, req, &emptyCommand{}); err != nil {
+		return nil, err
+	}
+	resp := statsResponse{
+		Health:    s.health.Health(),
+		Recording: s.recorder.IsRecording(),
+	}
+	if options, ok := s.commander.Options(); ok {
+		resp.Options = &options
+	}
+	return resp, nil
+}
+
+func runRecord(s *hookStream, req []byte) (interface{}, error) {
+	var cmd recordCommand
+	if err := decodeCommand(CommandRecord, req, &cmd); err != nil {
+		return nil, err
+	}
+	if cmd.Enabled == nil {
+		return nil, fmt.Errorf("invalid %s command: enabled is required", CommandRecord)
+	}
+	file, err := s.recorder.SetRecording(*cmd.Enabled)
+	if err != nil {
+		return nil, fmt.Errorf("cannot toggle recording: %s", err)
+	}
+	return recordResponse{Recording: *cmd.Enabled, File: file}, nil
+}
+
+// runCommand runs the named command and returns its JSON-encoded response
+func (s *hookStream) runCommand(name string, req []byte) ([]byte, error) {
+	for _, c := range hookCommands {
+		if c.Name == name {
+			resp, err := c.run(s, req)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(resp)
+		}
+	}
+	return nil, fmt.Errorf("unknown command %q", name)
+}
+
+// Commands lists the commands supported by this stream's requests
+func (s *hookStream) Commands() []models.StreamCommand {
+	commands := make([]models.StreamCommand, len(hookCommands))
+	for i, c := range hookCommands {
+		commands[i] = c.StreamCommand
+	}
+	return commands
+}
@@ -0,0 +1,311 @@
+package hook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"go.uber.org/zap"
+)
+
+// Flags for the channel field of an OpOption payload. They control which data
+// the hook sends to its subscribers.
+const (
+	OptionRecvZone  = 1 << 1
+	OptionRecvChat  = 1 << 2
+	OptionRecvLobby = 1 << 3
+	OptionSendZone  = 1 << 4
+	OptionSendChat  = 1 << 5
+	OptionSendLobby = 1 << 6
+)
+
+// nicknameChannel is the channel of the OpDebug payload that sets the name the
+// hook uses to refer to this subscriber
+const nicknameChannel = 9000
+
+// requestedOptions are the options Aetherometer would like to enable, and
+// requiredOptions are the options without which the stream is useless.
+const (
+	requestedOptions = OptionRecvZone | OptionRecvChat | OptionSendZone | OptionSendChat
+	requiredOptions  = OptionRecvZone | OptionSendZone
+)
+
+// hookVersion is the version of the hook, as reported in its hello message
+type hookVersion struct {
+	major, minor, patch int
+}
+
+func (v hookVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+func (v hookVersion) less(o hookVersion) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+	if v.minor != o.minor {
+		return v.minor < o.minor
+	}
+	return v.patch < o.patch
+}
+
+// hookCapabilities lists the options supported by each version of the hook,
+// ordered from oldest to newest. Versions older than the first entry are not
+// supported at all.
+var hookCapabilities = []struct {
+	minVersion hookVersion
+	options    uint32
+}{
+	{hookVersion{0, 8, 0}, OptionRecvZone | OptionSendZone},
+	{hookVersion{0, 9, 0}, OptionRecvZone | OptionRecvChat | OptionSendZone | OptionSendChat},
+}
+
+// defaultHookVersion is assumed when the hook does not report its version. It
+// is the version of the hook that is downloaded by the updater.
+var defaultHookVersion = hookVersion{0, 9, 3}
+
+var helloPrefix = []byte("SERVER HELLO")
+
+var helloVersionRegexp = regexp.MustCompile(`VERSION: v?(\d+)\.(\d+)\.(\d+)`)
+
+// parseHello returns the version reported in the hook's hello message. It
+// returns false if the version is not present in the message.
+func parseHello(data []byte) (hookVersion, bool) {
+	m := helloVersionRegexp.FindSubmatch(data)
+	if m == nil {
+		return hookVersion{}, false
+	}
+	var v hookVersion
+	v.major, _ = strconv.Atoi(string(m[1]))
+	v.minor, _ = strconv.Atoi(string(m[2]))
+	v.patch, _ = strconv.Atoi(string(m[3]))
+	return v, true
+}
+
+// selectOptions returns the requested options that are supported by the
+// version of the hook. It returns an error if the version does not support
+// all of the required options.
+func selectOptions(v hookVersion) (uint32, error) {
+	var supported uint32
+	found := false
+	for _, c := range hookCapabilities {
+		if v.less(c.minVersion) {
+			break
+		}
+		supported = c.options
+		found = true
+	}
+	if !found {
+		return 0, fmt.Errorf("hook version %s is not supported, requires at least %s",
+			v, hookCapabilities[0].minVersion)
+	}
+	options := requestedOptions & supported
+	if options&requiredOptions != requiredOptions {
+		return 0, fmt.Errorf("hook version %s does not support the required options %#b", v, requiredOptions)
+	}
+	return options, nil
+}
+
+// ErrHandshakeAborted is reported when the Handshaker is stopped before the
+// handshake has completed.
+var ErrHandshakeAborted = errors.New("handshake aborted")
+
+// Handshaker is responsible for negotiating the options of the connection
+// with the hook. It waits for the hook's hello message, selects the options
+// that the hook's version supports, and sends them to the hook. The handshake
+// is complete once the options have been sent. It fails if the hook's version
+// is not supported or if the hook does not say hello within the timeout.
+//
+// The handshake does not wait for the hook to echo the options back. Not every
+// version of the hook replies to an OpOption payload, and a quiet connection,
+// such as one made while the game is at the title screen, would otherwise time
+// out. The options reported by the hook, if any, are tracked by the Commander.
+//
+// The handshake can be restarted, such as when the connection to the hook
+// has been re-established, but only the result of the first handshake is
+// reported.
+//
+// All payloads are forwarded to the next consumer regardless of the state of
+// the handshake.
+type Handshaker struct {
+	hds          HookDataSender
+	payloadsChan <-chan Payload
+	timeout      time.Duration
+	health       *HealthMonitor
+	logger       *zap.Logger
+
+	outChan chan Payload
+	result  chan error
+	restart chan struct{}
+
+	// The state of the handshake is only accessed from Serve
+	done     bool
+	reported bool
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewHandshaker returns a new Handshaker
+func NewHandshaker(
+	hds HookDataSender,
+	payloadsChan <-chan Payload,
+	timeout time.Duration,
+	health *HealthMonitor,
+	logger *zap.Logger,
+) *Handshaker {
+	return &Handshaker{
+		hds:          hds,
+		payloadsChan: payloadsChan,
+		timeout:      timeout,
+		health:       health,
+		logger:       logger.Named("handshaker"),
+
+		outChan: make(chan Payload),
+		result:  make(chan error, 1),
+		restart: make(chan struct{}, 1),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for performing the handshake and
+// forwarding payloads.
+func (h *Handshaker) Serve() {
+	defer close(h.stopDone)
+	h.logger.Info("Running")
+
+	t := time.NewTimer(h.timeout)
+	defer t.Stop()
+	timeout := t.C
+	if h.done {
+		timeout = nil
+	}
+
+	payloadsChan := h.payloadsChan
+	for {
+		select {
+		case p, ok := <-payloadsChan:
+			if !ok {
+				payloadsChan = nil
+				continue
+			}
+			if !h.done {
+				h.handlePayload(p)
+			}
+			select {
+			case h.outChan <- p:
+			case <-h.stop:
+				h.shutdown()
+				return
+			}
+		case <-h.restart:
+			h.logger.Info("Restarting handshake")
+			h.done = false
+			if !t.Stop() {
+				select {
+				case <-t.C:
+				default:
+				}
+			}
+			t.Reset(h.timeout)
+			timeout = t.C
+		case <-timeout:
+			timeout = nil
+			if !h.done {
+				h.fail(errors.New("timed out waiting for the hook to say hello"))
+			}
+		case <-h.stop:
+			h.shutdown()
+			return
+		}
+	}
+}
+
+func (h *Handshaker) shutdown() {
+	h.logger.Info("Stopping...")
+	if !h.done {
+		h.done = true
+		h.report(ErrHandshakeAborted)
+	}
+}
+
+// Restart restarts the handshake with the hook. It should be called once the
+// connection to the hook has been re-established.
+func (h *Handshaker) Restart() {
+	select {
+	case h.restart <- struct{}{}:
+	default:
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (h *Handshaker) Stop() {
+	close(h.stop)
+	<-h.stopDone
+}
+
+// ReceivedPayloadsListener returns a channel on which consumers can listen
+// for payloads forwarded by the Handshaker.
+func (h *Handshaker) ReceivedPayloadsListener() <-chan Payload {
+	return h.outChan
+}
+
+// Result returns a channel that receives nil once the first handshake has
+// completed successfully, or the error if the handshake failed. Exactly one
+// value is sent on the channel.
+func (h *Handshaker) Result() <-chan error {
+	return h.result
+}
+
+func (h *Handshaker) handlePayload(p Payload) {
+	if p.Op != OpDebug || !bytes.HasPrefix(p.Data, helloPrefix) {
+		return
+	}
+	version, ok := parseHello(p.Data)
+	if !ok {
+		version = defaultHookVersion
+		h.logger.Warn("Hook did not report its version",
+			zap.String("hello", string(p.Data)),
+			zap.Stringer("assumedVersion", version),
+		)
+	}
+
+	options, err := selectOptions(version)
+	if err != nil {
+		h.fail(err)
+		return
+	}
+	h.hds.Send(OpDebug, nicknameChannel, []byte("Aetherometer"))
+	h.hds.Send(OpOption, options, nil)
+
+	h.done = true
+	h.logger.Info("Handshake complete",
+		zap.Stringer("version", version),
+		zap.String("options", fmt.Sprintf("%#b", options)),
+	)
+	h.report(nil)
+}
+
+func (h *Handshaker) fail(err error) {
+	h.done = true
+	h.logger.Error("Handshake failed", zap.Error(err))
+	h.health.SetError(err)
+	h.health.SetState(models.HealthStateDegraded)
+	h.report(err)
+}
+
+// report sends the result of the handshake unless a result has already been
+// reported
+func (h *Handshaker) report(err error) {
+	if h.reported {
+		return
+	}
+	h.reported = true
+	h.result <- err
+}
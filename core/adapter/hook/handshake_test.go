@@ -0,0 +1,239 @@
+package hook_test
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/adapter/hook/hookfakes"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handshaker", func() {
+	var (
+		hs           *hook.Handshaker
+		hds          *hookfakes.FakeHookDataSender
+		payloadsChan chan hook.Payload
+		health       *hook.HealthMonitor
+		timeout      time.Duration
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+
+		supervisor *suture.Supervisor
+	)
+
+	hello := func(msg string) hook.Payload {
+		return hook.Payload{Op: hook.OpDebug, Data: []byte(msg)}
+	}
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("handshakertest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+
+		hds = new(hookfakes.FakeHookDataSender)
+		payloadsChan = make(chan hook.Payload)
+		health = hook.NewHealthMonitor(0)
+		timeout = 5 * time.Second
+	})
+
+	JustBeforeEach(func() {
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"handshakertest://"}
+		logger, err := zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		hs = hook.NewHandshaker(hds, payloadsChan, timeout, health, logger)
+
+		supervisor = suture.New("test-handshaker", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(hs)
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It(`logs "Running" on startup`, func() {
+		Eventually(logBuf).Should(gbytes.Say("handshaker.*Running"))
+	})
+
+	It(`logs "Stopping..." on shutdown`, func() {
+		supervisor.Stop()
+		Eventually(logBuf).Should(gbytes.Say("handshaker.*Stopping..."))
+	})
+
+	It("forwards every payload to the next consumer", func() {
+		p := hook.Payload{Op: hook.OpRecv, Data: []byte{1, 2, 3}}
+		payloadsChan <- p
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive(Equal(p)))
+
+		payloadsChan <- hello("SERVER HELLO. VERSION: 0.9.3.")
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive(Equal(hello("SERVER HELLO. VERSION: 0.9.3."))))
+	})
+
+	It("does not send anything to the hook before receiving the hello message", func() {
+		payloadsChan <- hook.Payload{Op: hook.OpDebug, Data: []byte("something else")}
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+		Consistently(hds.SendCallCount).Should(BeZero())
+		Expect(hs.Result()).ToNot(Receive())
+	})
+
+	It("sets the nickname and the options supported by the hook's version", func() {
+		payloadsChan <- hello("SERVER HELLO. VERSION: 0.9.3. HOOK STATUS: RECV ON. SEND ON.")
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+
+		Eventually(hds.SendCallCount).Should(Equal(2))
+		op, channel, data := hds.SendArgsForCall(0)
+		Expect(op).To(BeEquivalentTo(hook.OpDebug))
+		Expect(channel).To(BeEquivalentTo(9000))
+		Expect(data).To(Equal([]byte("Aetherometer")))
+
+		op, channel, data = hds.SendArgsForCall(1)
+		Expect(op).To(BeEquivalentTo(hook.OpOption))
+		Expect(channel).To(BeEquivalentTo(54))
+		Expect(data).To(BeNil())
+	})
+
+	It("only enables the options supported by older versions of the hook", func() {
+		payloadsChan <- hello("SERVER HELLO. VERSION: v0.8.1.")
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+
+		Eventually(hds.SendCallCount).Should(Equal(2))
+		_, channel, _ := hds.SendArgsForCall(1)
+		Expect(channel).To(BeEquivalentTo(hook.OptionRecvZone | hook.OptionSendZone))
+	})
+
+	It("assumes the default version if the hook does not report its version", func() {
+		payloadsChan <- hello("SERVER HELLO. HOOK STATUS: RECV ON. SEND ON.")
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+
+		Eventually(logBuf).Should(gbytes.Say("handshaker.*Hook did not report its version"))
+		Eventually(hds.SendCallCount).Should(Equal(2))
+		_, channel, _ := hds.SendArgsForCall(1)
+		Expect(channel).To(BeEquivalentTo(54))
+	})
+
+	It("completes the handshake once the options have been sent", func() {
+		payloadsChan <- hello("SERVER HELLO. VERSION: 0.9.3.")
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+		Eventually(hs.Result()).Should(Receive(BeNil()))
+		Expect(hds.SendCallCount()).To(Equal(2))
+		Eventually(logBuf).Should(gbytes.Say("handshaker.*Handshake complete.*0.9.3"))
+	})
+
+	It("does not depend on the hook echoing the options back", func() {
+		payloadsChan <- hello("SERVER HELLO. VERSION: 0.9.3.")
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+		Eventually(hs.Result()).Should(Receive(BeNil()))
+
+		payloadsChan <- hook.Payload{Op: hook.OpOption, Channel: 6}
+		Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+		Consistently(hs.Result()).ShouldNot(Receive())
+		Expect(health.Health().State).ToNot(Equal(models.HealthStateDegraded))
+	})
+
+	Context("when the hook's version is not supported", func() {
+		It("fails the handshake without setting any options", func() {
+			payloadsChan <- hello("SERVER HELLO. VERSION: 0.7.9.")
+			Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+
+			var err error
+			Eventually(hs.Result()).Should(Receive(&err))
+			Expect(err).To(MatchError("hook version 0.7.9 is not supported, requires at least 0.8.0"))
+			Expect(hds.SendCallCount()).To(BeZero())
+			Expect(health.Health().State).To(Equal(models.HealthStateDegraded))
+		})
+	})
+
+	Context("when the handshake does not complete in time", func() {
+		BeforeEach(func() {
+			timeout = 50 * time.Millisecond
+		})
+
+		It("fails the handshake if the hook never says hello", func() {
+			var err error
+			Eventually(hs.Result()).Should(Receive(&err))
+			Expect(err).To(MatchError("timed out waiting for the hook to say hello"))
+		})
+
+		It("does not time out once the options have been sent", func() {
+			payloadsChan <- hello("SERVER HELLO. VERSION: 0.9.3.")
+			Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+			Eventually(hs.Result()).Should(Receive(BeNil()))
+
+			Consistently(logBuf, 100*time.Millisecond).ShouldNot(gbytes.Say("Handshake failed"))
+			Expect(health.Health().State).ToNot(Equal(models.HealthStateDegraded))
+		})
+
+		It("keeps forwarding payloads after the handshake fails", func() {
+			Eventually(hs.Result()).Should(Receive())
+			payloadsChan <- hook.Payload{Op: hook.OpRecv}
+			Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+		})
+	})
+
+	Context("when the handshake is restarted", func() {
+		completeHandshake := func() {
+			payloadsChan <- hello("SERVER HELLO. VERSION: 0.9.3.")
+			Eventually(hs.ReceivedPayloadsListener()).Should(Receive())
+		}
+
+		It("negotiates the options again without reporting another result", func() {
+			completeHandshake()
+			Eventually(hs.Result()).Should(Receive(BeNil()))
+
+			hs.Restart()
+			Eventually(logBuf).Should(gbytes.Say("handshaker.*Restarting handshake"))
+			completeHandshake()
+			Eventually(hds.SendCallCount).Should(Equal(4))
+			op, channel, _ := hds.SendArgsForCall(3)
+			Expect(op).To(BeEquivalentTo(hook.OpOption))
+			Expect(channel).To(BeEquivalentTo(54))
+			Eventually(logBuf).Should(gbytes.Say("handshaker.*Handshake complete"))
+			Consistently(hs.Result()).ShouldNot(Receive())
+		})
+
+		Context("and the restarted handshake does not complete in time", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+			})
+
+			It("reports the stream as degraded", func() {
+				completeHandshake()
+				Eventually(hs.Result()).Should(Receive(BeNil()))
+
+				hs.Restart()
+				Eventually(logBuf).Should(gbytes.Say("handshaker.*Handshake failed.*timed out waiting for the hook to say hello"))
+				Expect(health.Health().State).To(Equal(models.HealthStateDegraded))
+				Expect(hs.Result()).ToNot(Receive())
+			})
+		})
+	})
+
+	Context("when the Handshaker is stopped before the handshake completes", func() {
+		It("reports that the handshake was aborted", func() {
+			supervisor.Stop()
+			Eventually(hs.Result()).Should(Receive(MatchError(hook.ErrHandshakeAborted)))
+		})
+	})
+})
@@ -0,0 +1,220 @@
+package hook
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// HealthMonitor keeps track of the health of a connection to the hook. It is
+// updated by the services that read from and write to the connection, and it
+// can be polled for a snapshot of the connection's health.
+//
+// Every change to the state of the connection is also sent to the channel
+// returned by SubscribeHealth.
+//
+// All methods on HealthMonitor are safe to call on a nil HealthMonitor, in
+// which case they are no-ops.
+type HealthMonitor struct {
+	maxMissedPings int
+
+	lock             sync.Mutex
+	state            models.HealthState
+	lastError        *string
+	lastPacketTime   *time.Time
+	pingSentTime     time.Time
+	missedPings      int
+	pingRTT          *float64
+	windowStart      time.Time
+	windowPackets    int
+	packetsPerSecond float64
+	discardedBytes   int
+
+	transitions chan models.Health
+}
+
+// NewHealthMonitor returns a new HealthMonitor in the CONNECTING state. The
+// connection is declared stale and reported as DEGRADED once nothing has been
+// received from the hook for maxMissedPings pings in a row. The connection is
+// never declared stale if maxMissedPings is 0.
+func NewHealthMonitor(maxMissedPings int) *HealthMonitor {
+	return &HealthMonitor{
+		maxMissedPings: maxMissedPings,
+
+		state:       models.HealthStateConnecting,
+		windowStart: time.Now(),
+
+		transitions: make(chan models.Health, 16),
+	}
+}
+
+// PayloadReceived records the receipt of a payload from the hook. Any payload
+// shows that the hook is alive, so it clears the count of missed pings. Ping
+// responses are used to measure the round trip time of the connection, and
+// network packets are used to measure the packet rate.
+func (m *HealthMonitor) PayloadReceived(p Payload) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	m.missedPings = 0
+	defer func() {
+		if m.state == models.HealthStateConnecting || m.state == models.HealthStateDegraded {
+			m.setState(models.HealthStateConnected)
+		}
+	}()
+
+	switch p.Op {
+	case OpPing:
+		// Pings on other channels are sent by the Commander
+		if p.Channel == 0 && !m.pingSentTime.IsZero() {
+			rtt := float64(now.Sub(m.pingSentTime)) / float64(time.Millisecond)
+			m.pingRTT = &rtt
+			m.pingSentTime = time.Time{}
+		}
+	case OpRecv, OpSend:
+		m.lastPacketTime = &now
+		m.rollWindow(now)
+		m.windowPackets++
+	}
+}
+
+// BytesDiscarded records that n bytes of corrupted data were discarded from
+// the connection.
+func (m *HealthMonitor) BytesDiscarded(n int) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.discardedBytes += n
+}
+
+// PingSent records that a ping was just sent to the hook. If the previous
+// ping has not been answered, it is counted as a missed ping. It returns true
+// if this ping causes the connection to be declared stale.
+func (m *HealthMonitor) PingSent() bool {
+	if m == nil {
+		return false
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	stale := false
+	if !m.pingSentTime.IsZero() {
+		m.missedPings++
+		if m.maxMissedPings > 0 && m.missedPings == m.maxMissedPings &&
+			m.state == models.HealthStateConnected {
+			errStr := fmt.Sprintf("hook missed %d pings in a row", m.missedPings)
+			m.lastError = &errStr
+			m.setState(models.HealthStateDegraded)
+			stale = true
+		}
+	}
+	m.pingSentTime = time.Now()
+	return stale
+}
+
+// MissedPings returns the number of pings in a row the hook has failed to
+// answer.
+func (m *HealthMonitor) MissedPings() int {
+	if m == nil {
+		return 0
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.missedPings
+}
+
+// SetState overrides the state of the connection.
+func (m *HealthMonitor) SetState(state models.HealthState) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if state != models.HealthStateConnected && state != models.HealthStateDegraded {
+		// Pings sent before the connection was interrupted will never be
+		// answered
+		m.pingSentTime = time.Time{}
+		m.missedPings = 0
+	}
+	m.setState(state)
+}
+
+// SetError records the last error encountered on the connection.
+func (m *HealthMonitor) SetError(err error) {
+	if m == nil || err == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	errStr := err.Error()
+	m.lastError = &errStr
+}
+
+// Health returns a snapshot of the health of the connection.
+func (m *HealthMonitor) Health() models.Health {
+	if m == nil {
+		return models.Health{State: models.HealthStateClosed}
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.rollWindow(time.Now())
+	return m.snapshot()
+}
+
+// SubscribeHealth returns a channel on which a snapshot of the health of the
+// connection is sent whenever its state changes. Snapshots are dropped if
+// nobody is receiving from the channel.
+func (m *HealthMonitor) SubscribeHealth() <-chan models.Health {
+	if m == nil {
+		return nil
+	}
+	return m.transitions
+}
+
+// setState changes the state of the connection and notifies the subscriber
+// if the state has changed.
+// It is expected to be used inside a critical section
+func (m *HealthMonitor) setState(state models.HealthState) {
+	if m.state == state {
+		return
+	}
+	m.state = state
+	select {
+	case m.transitions <- m.snapshot():
+	default:
+	}
+}
+
+// snapshot is expected to be used inside a critical section
+func (m *HealthMonitor) snapshot() models.Health {
+	return models.Health{
+		State:            m.state,
+		LastError:        m.lastError,
+		LastPacketTime:   m.lastPacketTime,
+		PingRtt:          m.pingRTT,
+		PacketsPerSecond: m.packetsPerSecond,
+		DiscardedBytes:   m.discardedBytes,
+	}
+}
+
+// rollWindow computes the packet rate over the last measurement window once
+// at least a second has elapsed, and starts a new window.
+// It is expected to be used inside a critical section
+func (m *HealthMonitor) rollWindow(now time.Time) {
+	elapsed := now.Sub(m.windowStart)
+	if elapsed < time.Second {
+		return
+	}
+	m.packetsPerSecond = float64(m.windowPackets) / elapsed.Seconds()
+	m.windowPackets = 0
+	m.windowStart = now
+}
@@ -0,0 +1,164 @@
+package hook_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HealthMonitor", func() {
+	var health *hook.HealthMonitor
+
+	BeforeEach(func() {
+		health = hook.NewHealthMonitor(3)
+	})
+
+	It("starts in the connecting state", func() {
+		Expect(health.Health()).To(Equal(models.Health{State: models.HealthStateConnecting}))
+	})
+
+	It("is connected once a payload is received", func() {
+		health.PayloadReceived(hook.Payload{Op: hook.OpDebug})
+		Expect(health.Health().State).To(Equal(models.HealthStateConnected))
+		Expect(health.Health().LastPacketTime).To(BeNil())
+	})
+
+	It("records the time of the last network packet", func() {
+		before := time.Now()
+		health.PayloadReceived(hook.Payload{Op: hook.OpRecv})
+		Expect(health.Health().LastPacketTime).To(HaveValue(BeTemporally(">=", before)))
+	})
+
+	It("measures the packet rate", func() {
+		for i := 0; i < 10; i++ {
+			health.PayloadReceived(hook.Payload{Op: hook.OpSend})
+		}
+		Eventually(func() float64 {
+			return health.Health().PacketsPerSecond
+		}, 2*time.Second).Should(BeNumerically("~", 10, 1))
+	})
+
+	It("measures the round trip time of a ping", func() {
+		health.PingSent()
+		time.Sleep(10 * time.Millisecond)
+		health.PayloadReceived(hook.Payload{Op: hook.OpPing})
+		Expect(health.Health().PingRtt).To(HaveValue(BeNumerically(">=", 10)))
+	})
+
+	It("ignores pings that were not sent by the StreamPinger", func() {
+		health.PingSent()
+		health.PayloadReceived(hook.Payload{Op: hook.OpPing, Channel: 5})
+		Expect(health.Health().PingRtt).To(BeNil())
+	})
+
+	Describe("missed pings", func() {
+		BeforeEach(func() {
+			health.PayloadReceived(hook.Payload{Op: hook.OpDebug})
+		})
+
+		It("counts pings that were not answered before the next ping", func() {
+			Expect(health.PingSent()).To(BeFalse())
+			Expect(health.PingSent()).To(BeFalse())
+			Expect(health.MissedPings()).To(Equal(1))
+
+			health.PayloadReceived(hook.Payload{Op: hook.OpPing})
+			Expect(health.MissedPings()).To(BeZero())
+		})
+
+		It("is degraded once too many pings in a row were missed", func() {
+			for i := 0; i < 3; i++ {
+				Expect(health.PingSent()).To(BeFalse())
+			}
+			Expect(health.PingSent()).To(BeTrue())
+			Expect(health.Health().State).To(Equal(models.HealthStateDegraded))
+			Expect(health.Health().LastError).To(HaveValue(Equal("hook missed 3 pings in a row")))
+
+			By("recovering once anything is received from the hook")
+			health.PayloadReceived(hook.Payload{Op: hook.OpRecv})
+			Expect(health.Health().State).To(Equal(models.HealthStateConnected))
+			Expect(health.MissedPings()).To(BeZero())
+		})
+
+		It("treats any payload from the hook as a sign of life", func() {
+			Expect(health.PingSent()).To(BeFalse())
+			Expect(health.PingSent()).To(BeFalse())
+			Expect(health.MissedPings()).To(Equal(1))
+
+			health.PayloadReceived(hook.Payload{Op: hook.OpRecv})
+			Expect(health.MissedPings()).To(BeZero())
+			Expect(health.Health().PingRtt).To(BeNil())
+
+			for i := 0; i < 5; i++ {
+				Expect(health.PingSent()).To(BeFalse())
+				health.PayloadReceived(hook.Payload{Op: hook.OpRecv})
+			}
+			Expect(health.Health().State).To(Equal(models.HealthStateConnected))
+		})
+
+		It("is never degraded if the maximum is 0", func() {
+			health = hook.NewHealthMonitor(0)
+			health.PayloadReceived(hook.Payload{Op: hook.OpDebug})
+			for i := 0; i < 10; i++ {
+				Expect(health.PingSent()).To(BeFalse())
+			}
+			Expect(health.Health().State).To(Equal(models.HealthStateConnected))
+		})
+
+		It("forgets unanswered pings when the connection is interrupted", func() {
+			health.PingSent()
+			health.PingSent()
+			health.SetState(models.HealthStateReconnecting)
+			Expect(health.MissedPings()).To(BeZero())
+		})
+	})
+
+	Describe("SubscribeHealth", func() {
+		It("notifies the subscriber of every change of state", func() {
+			health.PayloadReceived(hook.Payload{Op: hook.OpDebug})
+			health.PayloadReceived(hook.Payload{Op: hook.OpDebug})
+			health.SetError(errors.New("Boom"))
+			health.SetState(models.HealthStateReconnecting)
+
+			Expect(health.SubscribeHealth()).To(Receive(HaveField("State", models.HealthStateConnected)))
+			var h models.Health
+			Expect(health.SubscribeHealth()).To(Receive(&h))
+			Expect(h.State).To(Equal(models.HealthStateReconnecting))
+			Expect(h.LastError).To(HaveValue(Equal("Boom")))
+			Expect(health.SubscribeHealth()).ToNot(Receive())
+		})
+
+		It("does not block when nobody is subscribed", func() {
+			for i := 0; i < 100; i++ {
+				health.SetState(models.HealthStateConnected)
+				health.SetState(models.HealthStateDegraded)
+			}
+		})
+	})
+
+	It("records the last error", func() {
+		health.SetError(errors.New("Boom"))
+		Expect(health.Health().LastError).To(HaveValue(Equal("Boom")))
+	})
+
+	It("does not leave the closed state when a payload is received", func() {
+		health.SetState(models.HealthStateClosed)
+		health.PayloadReceived(hook.Payload{Op: hook.OpRecv})
+		Expect(health.Health().State).To(Equal(models.HealthStateClosed))
+	})
+
+	It("is safe to use when nil", func() {
+		var nilHealth *hook.HealthMonitor
+		Expect(nilHealth.PingSent()).To(BeFalse())
+		Expect(nilHealth.MissedPings()).To(BeZero())
+		Expect(nilHealth.SubscribeHealth()).To(BeNil())
+		nilHealth.PayloadReceived(hook.Payload{})
+		nilHealth.SetState(models.HealthStateConnected)
+		nilHealth.SetError(errors.New("Boom"))
+		Expect(nilHealth.Health().State).To(Equal(models.HealthStateClosed))
+	})
+})
@@ -9,6 +9,16 @@ import (
 )
 
 type FakeStream struct {
+	HandshakeStub        func() <-chan error
+	handshakeMutex       sync.RWMutex
+	handshakeArgsForCall []struct {
+	}
+	handshakeReturns struct {
+		result1 <-chan error
+	}
+	handshakeReturnsOnCall map[int]struct {
+		result1 <-chan error
+	}
 	SendRequestStub        func([]byte) ([]byte, error)
 	sendRequestMutex       sync.RWMutex
 	sendRequestArgsForCall []struct {
@@ -74,6 +84,59 @@ type FakeStream struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStream) Handshake() <-chan error {
+	fake.handshakeMutex.Lock()
+	ret, specificReturn := fake.handshakeReturnsOnCall[len(fake.handshakeArgsForCall)]
+	fake.handshakeArgsForCall = append(fake.handshakeArgsForCall, struct {
+	}{})
+	stub := fake.HandshakeStub
+	fakeReturns := fake.handshakeReturns
+	fake.recordInvocation("Handshake", []interface{}{})
+	fake.handshakeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStream) HandshakeCallCount() int {
+	fake.handshakeMutex.RLock()
+	defer fake.handshakeMutex.RUnlock()
+	return len(fake.handshakeArgsForCall)
+}
+
+func (fake *FakeStream) HandshakeCalls(stub func() <-chan error) {
+	fake.handshakeMutex.Lock()
+	defer fake.handshakeMutex.Unlock()
+	fake.HandshakeStub = stub
+}
+
+func (fake *FakeStream) HandshakeReturns(result1 <-chan error) {
+	fake.handshakeMutex.Lock()
+	defer fake.handshakeMutex.Unlock()
+	fake.HandshakeStub = nil
+	fake.handshakeReturns = struct {
+		result1 <-chan error
+	}{result1}
+}
+
+func (fake *FakeStream) HandshakeReturnsOnCall(i int, result1 <-chan error) {
+	fake.handshakeMutex.Lock()
+	defer fake.handshakeMutex.Unlock()
+	fake.HandshakeStub = nil
+	if fake.handshakeReturnsOnCall == nil {
+		fake.handshakeReturnsOnCall = make(map[int]struct {
+			result1 <-chan error
+		})
+	}
+	fake.handshakeReturnsOnCall[i] = struct {
+		result1 <-chan error
+	}{result1}
+}
+
 func (fake *FakeStream) SendRequest(arg1 []byte) ([]byte, error) {
 	var arg1Copy []byte
 	if arg1 != nil {
@@ -85,15 +148,16 @@ func (fake *FakeStream) SendRequest(arg1 []byte) ([]byte, error) {
 	fake.sendRequestArgsForCall = append(fake.sendRequestArgsForCall, struct {
 		arg1 []byte
 	}{arg1Copy})
+	stub := fake.SendRequestStub
+	fakeReturns := fake.sendRequestReturns
 	fake.recordInvocation("SendRequest", []interface{}{arg1Copy})
 	fake.sendRequestMutex.Unlock()
-	if fake.SendRequestStub != nil {
-		return fake.SendRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.sendRequestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -146,9 +210,10 @@ func (fake *FakeStream) Serve() {
 	fake.serveMutex.Lock()
 	fake.serveArgsForCall = append(fake.serveArgsForCall, struct {
 	}{})
+	stub := fake.ServeStub
 	fake.recordInvocation("Serve", []interface{}{})
 	fake.serveMutex.Unlock()
-	if fake.ServeStub != nil {
+	if stub != nil {
 		fake.ServeStub()
 	}
 }
@@ -169,9 +234,10 @@ func (fake *FakeStream) Stop() {
 	fake.stopMutex.Lock()
 	fake.stopArgsForCall = append(fake.stopArgsForCall, struct {
 	}{})
+	stub := fake.StopStub
 	fake.recordInvocation("Stop", []interface{}{})
 	fake.stopMutex.Unlock()
-	if fake.StopStub != nil {
+	if stub != nil {
 		fake.StopStub()
 	}
 }
@@ -193,15 +259,16 @@ func (fake *FakeStream) StreamID() int {
 	ret, specificReturn := fake.streamIDReturnsOnCall[len(fake.streamIDArgsForCall)]
 	fake.streamIDArgsForCall = append(fake.streamIDArgsForCall, struct {
 	}{})
+	stub := fake.StreamIDStub
+	fakeReturns := fake.streamIDReturns
 	fake.recordInvocation("StreamID", []interface{}{})
 	fake.streamIDMutex.Unlock()
-	if fake.StreamIDStub != nil {
-		return fake.StreamIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.streamIDReturns
 	return fakeReturns.result1
 }
 
@@ -245,15 +312,16 @@ func (fake *FakeStream) String() string {
 	ret, specificReturn := fake.stringReturnsOnCall[len(fake.stringArgsForCall)]
 	fake.stringArgsForCall = append(fake.stringArgsForCall, struct {
 	}{})
+	stub := fake.StringStub
+	fakeReturns := fake.stringReturns
 	fake.recordInvocation("String", []interface{}{})
 	fake.stringMutex.Unlock()
-	if fake.StringStub != nil {
-		return fake.StringStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stringReturns
 	return fakeReturns.result1
 }
 
@@ -297,15 +365,16 @@ func (fake *FakeStream) SubscribeEgress() <-chan *xivnet.Block {
 	ret, specificReturn := fake.subscribeEgressReturnsOnCall[len(fake.subscribeEgressArgsForCall)]
 	fake.subscribeEgressArgsForCall = append(fake.subscribeEgressArgsForCall, struct {
 	}{})
+	stub := fake.SubscribeEgressStub
+	fakeReturns := fake.subscribeEgressReturns
 	fake.recordInvocation("SubscribeEgress", []interface{}{})
 	fake.subscribeEgressMutex.Unlock()
-	if fake.SubscribeEgressStub != nil {
-		return fake.SubscribeEgressStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.subscribeEgressReturns
 	return fakeReturns.result1
 }
 
@@ -349,15 +418,16 @@ func (fake *FakeStream) SubscribeIngress() <-chan *xivnet.Block {
 	ret, specificReturn := fake.subscribeIngressReturnsOnCall[len(fake.subscribeIngressArgsForCall)]
 	fake.subscribeIngressArgsForCall = append(fake.subscribeIngressArgsForCall, struct {
 	}{})
+	stub := fake.SubscribeIngressStub
+	fakeReturns := fake.subscribeIngressReturns
 	fake.recordInvocation("SubscribeIngress", []interface{}{})
 	fake.subscribeIngressMutex.Unlock()
-	if fake.SubscribeIngressStub != nil {
-		return fake.SubscribeIngressStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.subscribeIngressReturns
 	return fakeReturns.result1
 }
 
@@ -399,6 +469,8 @@ func (fake *FakeStream) SubscribeIngressReturnsOnCall(i int, result1 <-chan *xiv
 func (fake *FakeStream) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.handshakeMutex.RLock()
+	defer fake.handshakeMutex.RUnlock()
 	fake.sendRequestMutex.RLock()
 	defer fake.sendRequestMutex.RUnlock()
 	fake.serveMutex.RLock()
@@ -5,6 +5,10 @@ import (
 	"encoding/hex"
 	"time"
 
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
 	"github.com/ff14wed/xivnet/v3"
 	"github.com/ff14wed/xivnet/v3/datatypes"
 	"go.uber.org/zap"
@@ -15,31 +19,43 @@ import (
 type IPCReader struct {
 	streamID     uint32
 	payloadsChan <-chan Payload
+	resetChan    <-chan struct{}
 	logger       *zap.Logger
 
-	zoneUsesPDK bool
-	pdk         byte
+	opcodes    *opcodes.Registry
+	transforms *transform.Pipeline
+	messages   *MessageLog
 
 	ingressBlocksChan chan *xivnet.Block
 	egressBlocksChan  chan *xivnet.Block
 
-	lastEgressMoveTimestamp time.Time
-
 	stop     chan struct{}
 	stopDone chan struct{}
 }
 
-// NewIPCReader creates a new IPCReader provided a data source and a
-// factory to create a new IPC decoder
+// NewIPCReader creates a new IPCReader provided a data source, the opcode
+// registry used to parse the blocks, and the transform registry used to
+// create the stream's transform pipeline. If resetChan is not nil, a
+// stream.ResetBlock is sent on both block channels whenever it receives, in
+// order with the blocks decoded from the payloads.
 func NewIPCReader(
 	streamID uint32,
 	payloadsChan <-chan Payload,
+	resetChan <-chan struct{},
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
 	logger *zap.Logger,
 ) *IPCReader {
+	logger = logger.Named("ipc-reader")
 	return &IPCReader{
 		streamID:     streamID,
 		payloadsChan: payloadsChan,
-		logger:       logger.Named("ipc-reader"),
+		resetChan:    resetChan,
+		logger:       logger,
+
+		opcodes:    opcodeRegistry,
+		transforms: transformRegistry.NewPipeline(logger),
+		messages:   NewMessageLog(DefaultMessageLogSize),
 
 		ingressBlocksChan: make(chan *xivnet.Block, 5000),
 		egressBlocksChan:  make(chan *xivnet.Block, 5000),
@@ -65,14 +81,20 @@ func (d *IPCReader) Serve() {
 			switch e.Op {
 			case OpDebug:
 				d.logger.Debug("Hook Message", zap.Uint32("channel", e.Channel), zap.ByteString("data", e.Data))
+				d.messages.Add(ParseHookMessage(time.Now(), e.Data))
 			case OpRecv:
 				d.decodeDataAndSendBlock(e.Channel, e.Data, false)
 			case OpSend:
 				d.decodeDataAndSendBlock(e.Channel, e.Data, true)
-			case OpPing:
 			case OpExit:
+				// The connection itself is handled by the StreamReader
+				d.messages.Add(exitMessage(time.Now(), e.Data))
+			case OpPing:
+				// Handled by the HealthMonitor
 			default:
 			}
+		case <-d.resetChan:
+			d.sendReset()
 		case <-d.stop:
 			d.logger.Info("Stopping...")
 			return
@@ -80,6 +102,18 @@ func (d *IPCReader) Serve() {
 	}
 }
 
+// sendReset sends the reset marker on both block channels, after every block
+// decoded from the earlier payloads
+func (d *IPCReader) sendReset() {
+	for _, blocksChan := range []chan *xivnet.Block{d.egressBlocksChan, d.ingressBlocksChan} {
+		select {
+		case blocksChan <- stream.ResetBlock:
+		case <-d.stop:
+			return
+		}
+	}
+}
+
 // Stop will shutdown this service and wait on it to stop before returning.
 func (d *IPCReader) Stop() {
 	close(d.stop)
@@ -92,6 +126,18 @@ func (d *IPCReader) SubscribeIngress() <-chan *xivnet.Block {
 	return d.ingressBlocksChan
 }
 
+// Messages returns the most recent messages sent by the hook, from oldest to
+// newest.
+func (d *IPCReader) Messages() []models.HookMessage {
+	return d.messages.Messages()
+}
+
+// SubscribeMessages provides a channel on which consumers can listen for
+// messages sent by the hook.
+func (d *IPCReader) SubscribeMessages() <-chan models.HookMessage {
+	return d.messages.SubscribeMessages()
+}
+
 // SubscribeIngress provides a channel on which consumers can listen for
 // processed egress frames decoded from the payloads.
 func (d *IPCReader) SubscribeEgress() <-chan *xivnet.Block {
@@ -131,9 +177,9 @@ func (d *IPCReader) decodeDataAndSendBlock(
 	var bd xivnet.BlockData
 
 	if channel == 1 {
-		bd = datatypes.NewBlockData(block.Opcode, isEgress)
+		bd = d.opcodes.NewBlockData(opcodes.Zone, block.Opcode, isEgress)
 	} else if channel == 2 {
-		bd = datatypes.NewChatBlockData(block.Opcode, isEgress)
+		bd = d.opcodes.NewBlockData(opcodes.Chat, block.Opcode, isEgress)
 	}
 
 	if bd == nil {
@@ -152,53 +198,11 @@ func (d *IPCReader) decodeDataAndSendBlock(
 
 	block.Data = bd
 
-	d.runPacketHooks(&block)
+	d.transforms.Apply(&block)
 
 	if isEgress {
-		switch block.Data.(type) {
-		case *datatypes.EgressMovement:
-			if block.Time != d.lastEgressMoveTimestamp {
-				d.lastEgressMoveTimestamp = block.Time
-				d.egressBlocksChan <- &block
-			}
-		case *datatypes.EgressInstanceMovement:
-			if block.Time != d.lastEgressMoveTimestamp {
-				d.lastEgressMoveTimestamp = block.Time
-				d.egressBlocksChan <- &block
-			}
-		default:
-			d.egressBlocksChan <- &block
-		}
-
+		d.egressBlocksChan <- &block
 	} else {
 		d.ingressBlocksChan <- &block
 	}
 }
-
-func (d *IPCReader) runPacketHooks(parsedBlock *xivnet.Block) {
-	if z, ok := parsedBlock.Data.(*datatypes.InitZone); ok {
-		d.pdk = 0
-		switch z.TerritoryTypeID {
-		case 946, 947, 948, 949:
-		default:
-			d.zoneUsesPDK = false
-			return
-		}
-		d.zoneUsesPDK = true
-	}
-
-	if d.zoneUsesPDK {
-		if d.pdk == 0 {
-			if z, ok := parsedBlock.Data.(*datatypes.Casting); ok {
-				d.pdk = byte(z.ActionID - uint32(z.ActionIDName))
-				d.logger.Info("Detected PDK for current zone", zap.Uint8("pdk", d.pdk))
-			}
-		}
-		if z, ok := parsedBlock.Data.(*datatypes.Control); ok {
-			if z.Type == 0x22 && d.pdk > 0 {
-				z.P1 = z.P1 - uint32(d.pdk)
-				return
-			}
-		}
-	}
-}
@@ -7,7 +7,11 @@ import (
 	"sync"
 
 	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
 	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/ff14wed/aetherometer/core/transform"
 	"github.com/ff14wed/xivnet/v3"
 	"github.com/ff14wed/xivnet/v3/datatypes"
 	"github.com/thejerf/suture"
@@ -20,8 +24,10 @@ import (
 
 var _ = Describe("IPCReader", func() {
 	var (
-		ir           *hook.IPCReader
-		payloadsChan chan hook.Payload
+		ir             *hook.IPCReader
+		payloadsChan   chan hook.Payload
+		resetChan      chan struct{}
+		opcodeRegistry *opcodes.Registry
 
 		logBuf *testhelpers.LogBuffer
 		once   sync.Once
@@ -50,7 +56,11 @@ var _ = Describe("IPCReader", func() {
 
 		payloadsChan = make(chan hook.Payload)
 
-		ir = hook.NewIPCReader(123, payloadsChan, logger)
+		opcodeRegistry = opcodes.NewRegistry()
+		transformRegistry := transform.NewRegistry()
+		transformRegistry.Register("pdk", transform.NewPDKTransformer)
+		resetChan = make(chan struct{})
+		ir = hook.NewIPCReader(123, payloadsChan, resetChan, opcodeRegistry, transformRegistry, logger)
 
 		supervisor = suture.New("test-ipcreader", suture.Spec{
 			Log: func(line string) {
@@ -83,6 +93,15 @@ var _ = Describe("IPCReader", func() {
 			Consistently(ir.SubscribeIngress()).ShouldNot(Receive())
 			Consistently(ir.SubscribeEgress()).ShouldNot(Receive())
 		})
+
+		It("records the message and notifies subscribers", func() {
+			payloadsChan <- hook.Payload{Op: hook.OpDebug, Channel: 123, Data: []byte("[WARN] Unknown game build")}
+			var msg models.HookMessage
+			Eventually(ir.SubscribeMessages()).Should(Receive(&msg))
+			Expect(msg.Severity).To(Equal(models.HookMessageSeverityWarn))
+			Expect(msg.Message).To(Equal("Unknown game build"))
+			Expect(ir.Messages()).To(Equal([]models.HookMessage{msg}))
+		})
 	})
 
 	Context("when receiving OpPing payloads", func() {
@@ -93,12 +112,36 @@ var _ = Describe("IPCReader", func() {
 		})
 	})
 
+	Context("when the stream is reset", func() {
+		It("sends the reset marker on both channels after the blocks of the earlier payloads", func() {
+			payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: payloadForIPCBlock(1234, 5678, datatypes.MovementOpcode, movementBlockBytes)}
+			resetChan <- struct{}{}
+
+			var b *xivnet.Block
+			Eventually(ir.SubscribeEgress()).Should(Receive(&b))
+			Expect(b).To(BeIdenticalTo(stream.ResetBlock))
+			Eventually(ir.SubscribeIngress()).Should(Receive(&b))
+			Expect(b.Data).To(Equal(expectedMovementBlockData))
+			Eventually(ir.SubscribeIngress()).Should(Receive(&b))
+			Expect(b).To(BeIdenticalTo(stream.ResetBlock))
+		})
+	})
+
 	Context("when receiving OpExit payloads", func() {
-		It("does nothing", func() {
-			payloadsChan <- hook.Payload{Op: hook.OpExit, Channel: 0, Data: []byte("Hello")}
+		It("records the reason as an error message", func() {
+			payloadsChan <- hook.Payload{Op: hook.OpExit, Channel: 0, Data: []byte("Unload")}
+			var msg models.HookMessage
+			Eventually(ir.SubscribeMessages()).Should(Receive(&msg))
+			Expect(msg.Severity).To(Equal(models.HookMessageSeverityError))
+			Expect(msg.Message).To(Equal("Hook exited: Unload"))
 			Consistently(ir.SubscribeIngress()).ShouldNot(Receive())
 			Consistently(ir.SubscribeEgress()).ShouldNot(Receive())
 		})
+
+		It("records a missing reason", func() {
+			payloadsChan <- hook.Payload{Op: hook.OpExit, Channel: 0}
+			Eventually(ir.SubscribeMessages()).Should(Receive(HaveField("Message", "Hook exited: no reason given")))
+		})
 	})
 
 	Context("when receiving payloads of other Ops", func() {
@@ -138,6 +181,28 @@ var _ = Describe("IPCReader", func() {
 			Consistently(ir.SubscribeIngress()).ShouldNot(Receive())
 		})
 
+		Context("when the opcodes are overridden", func() {
+			BeforeEach(func() {
+				t, err := opcodes.NewTable([]opcodes.Mapping{
+					{Direction: opcodes.Ingress, Opcode: 0x1234, Datatype: "Movement"},
+					{Direction: opcodes.Ingress, Opcode: datatypes.MovementOpcode, Datatype: opcodes.GenericDatatype},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				opcodeRegistry.SetTable(t)
+			})
+
+			It("parses the blocks using the overridden opcodes", func() {
+				payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: payloadForIPCBlock(1234, 5678, 0x1234, movementBlockBytes)}
+				payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: payloadForIPCBlock(1234, 5678, datatypes.MovementOpcode, movementBlockBytes)}
+
+				var b1, b2 *xivnet.Block
+				Eventually(ir.SubscribeIngress()).Should(Receive(&b1))
+				Eventually(ir.SubscribeIngress()).Should(Receive(&b2))
+				Expect(b1.Data).To(Equal(expectedMovementBlockData))
+				Expect(b2.Data).To(BeAssignableToTypeOf(xivnet.GenericBlockData{}))
+			})
+		})
+
 		Context("when handling data in a zone with a PDK", func() {
 			BeforeEach(func() {
 				payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: payloadForIPCBlock(2345, 5678, datatypes.InitZoneOpcode, initZoneBlockBytes)}
@@ -191,6 +256,14 @@ var _ = Describe("IPCReader", func() {
 
 			Consistently(ir.SubscribeEgress()).ShouldNot(Receive())
 		})
+
+		It("leaves repeated egress movement blocks to the stream's throttle", func() {
+			payloadsChan <- hook.Payload{Op: hook.OpSend, Channel: 1, Data: payloadForIPCBlock(5678, 5678, datatypes.EgressMovementOpcode, egressMovementBlockBytes)}
+			payloadsChan <- hook.Payload{Op: hook.OpSend, Channel: 1, Data: payloadForIPCBlock(5678, 5678, datatypes.EgressMovementOpcode, egressMovementBlockBytes)}
+
+			Eventually(ir.SubscribeEgress()).Should(Receive())
+			Eventually(ir.SubscribeEgress()).Should(Receive())
+		})
 	})
 })
 
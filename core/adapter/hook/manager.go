@@ -1,40 +1,84 @@
 package hook
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/thejerf/suture"
 	"go.uber.org/zap"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/process"
 )
 
 // Manager is responsible for starting up new hook streams whenever it detects
 // that a new instance of a watched process is created. It is also responsible
 // for shutting down those streams when a watched process is closed.
 // Additionally, the Manager notifies the StreamUp and StreamDown channels when
-// the watched process is created and closed, respectively.
+// the watched process is created and closed, respectively. A stream is only
+// sent on the StreamUp channel once its handshake with the hook has
+// completed, and it is shut down if the handshake fails. A process whose
+// handshake failed is not retried until it is attached again.
+// Streams are built outside of the Serve loop since building a stream injects
+// the hook into the process, which may take a while.
 type Manager struct {
 	cfg AdapterConfig
 
-	addProcEventChan <-chan uint32
+	addProcEventChan <-chan process.AddEvent
 	remProcEventChan <-chan uint32
+	handshakeChan    chan handshakeResult
+	builtChan        chan buildResult
+	requests         chan func()
 
 	streamBuilder    func(streamID uint32) Stream
 	streamSupervisor *suture.Supervisor
-	streamTokens     map[uint32]suture.ServiceToken
+	streams          map[uint32]*managedStream
+	// building keeps track of the processes whose streams are being built,
+	// along with the number of the build so that a stale build is discarded
+	building map[uint32]uint64
+	builds   uint64
+	// detached keeps track of the streams that were detached manually or
+	// whose handshake failed, whose processes are still running
+	detached map[uint32]struct{}
 
+	health *HealthMonitor
 	logger *zap.Logger
 
 	stop     chan struct{}
 	stopDone chan struct{}
 }
 
-// NewManager creates a new hook Stream Manager
+// managedStream keeps track of a stream started by the Manager
+type managedStream struct {
+	Stream
+	token suture.ServiceToken
+
+	// up is true once the stream has been sent on the StreamUp channel
+	up bool
+}
+
+type buildResult struct {
+	streamID uint32
+	build    uint64
+	stream   Stream
+	done     chan<- error
+}
+
+type handshakeResult struct {
+	streamID uint32
+	stream   Stream
+	err      error
+}
+
+// NewManager creates a new hook Stream Manager. The result of each handshake
+// is recorded in the provided HealthMonitor, which may be nil.
 func NewManager(
 	cfg AdapterConfig,
-	addProcEventChan <-chan uint32,
+	addProcEventChan <-chan process.AddEvent,
 	remProcEventChan <-chan uint32,
 	streamBuilder func(streamID uint32) Stream,
 	streamSupervisor *suture.Supervisor,
+	health *HealthMonitor,
 	logger *zap.Logger,
 ) *Manager {
 	return &Manager{
@@ -42,11 +86,17 @@ func NewManager(
 
 		addProcEventChan: addProcEventChan,
 		remProcEventChan: remProcEventChan,
+		handshakeChan:    make(chan handshakeResult),
+		builtChan:        make(chan buildResult),
+		requests:         make(chan func()),
 
 		streamBuilder:    streamBuilder,
 		streamSupervisor: streamSupervisor,
-		streamTokens:     make(map[uint32]suture.ServiceToken),
+		streams:          make(map[uint32]*managedStream),
+		building:         make(map[uint32]uint64),
+		detached:         make(map[uint32]struct{}),
 
+		health: health,
 		logger: logger.Named("hook-manager"),
 
 		stop:     make(chan struct{}),
@@ -62,10 +112,18 @@ func (m *Manager) Serve() {
 	m.logger.Info("Running")
 	for {
 		select {
-		case streamID := <-m.addProcEventChan:
-			m.handleProcessAdd(streamID)
+		case e := <-m.addProcEventChan:
+			if !m.isAttached(e.PID) {
+				m.handleProcessAdd(e.PID, nil)
+			}
 		case streamID := <-m.remProcEventChan:
 			m.handleProcessRemove(streamID)
+		case r := <-m.builtChan:
+			m.handleBuilt(r)
+		case r := <-m.handshakeChan:
+			m.handleHandshake(r)
+		case f := <-m.requests:
+			f()
 		case <-m.stop:
 			m.logger.Info("Stopping...")
 			return
@@ -79,24 +137,215 @@ func (m *Manager) Stop() {
 	<-m.stopDone
 }
 
-func (m *Manager) handleProcessAdd(streamID uint32) {
+// Attach starts a stream for the process identified by pid, in the same way
+// as when the process is found by the scanner. It returns an error if the
+// process is already attached or if the stream could not be created.
+func (m *Manager) Attach(pid uint32) error {
+	var err error
+	done := make(chan error, 1)
+	ok := m.do(func() {
+		if m.isAttached(pid) {
+			err = fmt.Errorf("process %d is already attached", pid)
+			return
+		}
+		delete(m.detached, pid)
+		m.handleProcessAdd(pid, done)
+	})
+	if !ok {
+		return errors.New("hook manager is not running")
+	}
+	if err != nil {
+		return err
+	}
+	select {
+	case err = <-done:
+		return err
+	case <-m.stop:
+		return errors.New("hook manager is not running")
+	}
+}
+
+// Detach shuts down the stream identified by streamID, in the same way as
+// when its process exits. The stream is not started again until the process
+// is attached again or it is restarted.
+func (m *Manager) Detach(streamID uint32) error {
+	var err error
+	ok := m.do(func() {
+		if !m.isAttached(streamID) {
+			err = fmt.Errorf("stream %d is not attached", streamID)
+			return
+		}
+		m.handleProcessRemove(streamID)
+		m.detached[streamID] = struct{}{}
+	})
+	if !ok {
+		return errors.New("hook manager is not running")
+	}
+	return err
+}
+
+// AttachedStreams returns the IDs of the streams that are currently running.
+func (m *Manager) AttachedStreams() []uint32 {
+	var streamIDs []uint32
+	m.do(func() {
+		for streamID := range m.streams {
+			streamIDs = append(streamIDs, streamID)
+		}
+	})
+	return streamIDs
+}
+
+// do runs f on the Serve goroutine and waits for it to complete. It returns
+// false if the Manager stopped before f could run.
+func (m *Manager) do(f func()) bool {
+	done := make(chan struct{})
+	select {
+	case m.requests <- func() {
+		f()
+		close(done)
+	}:
+	case <-m.stop:
+		return false
+	}
+	<-done
+	return true
+}
+
+// isAttached returns whether the process has a stream or its stream is being
+// built
+func (m *Manager) isAttached(streamID uint32) bool {
+	_, found := m.streams[streamID]
+	_, building := m.building[streamID]
+	return found || building
+}
+
+// handleProcessAdd starts building the stream for the process. If done is
+// not nil, it receives the error once the stream is built, or nil if the
+// stream was started.
+func (m *Manager) handleProcessAdd(streamID uint32, done chan<- error) {
+	m.builds++
+	m.building[streamID] = m.builds
+	go m.buildStream(streamID, m.builds, done)
+}
+
+// buildStream builds the stream and forwards it to Serve
+func (m *Manager) buildStream(streamID uint32, build uint64, done chan<- error) {
 	s := m.streamBuilder(streamID)
-	if s != nil {
-		m.streamTokens[streamID] = m.streamSupervisor.Add(s)
-		m.cfg.StreamUp <- s
+	select {
+	case m.builtChan <- buildResult{streamID: streamID, build: build, stream: s, done: done}:
+	case <-m.stop:
+	}
+}
+
+func (m *Manager) handleBuilt(r buildResult) {
+	var err error
+	defer func() {
+		if r.done != nil {
+			r.done <- err
+		}
+	}()
+	if build, building := m.building[r.streamID]; !building || build != r.build {
+		// The process was removed while the stream was being built. The
+		// stream is started and shut down right away so that it closes its
+		// connection to the hook.
+		if r.stream != nil {
+			token := m.streamSupervisor.Add(r.stream)
+			if err := m.streamSupervisor.Remove(token); err != nil {
+				m.logger.Error("Error removing process group", zap.Uint32("streamID", r.streamID), zap.Error(err))
+			}
+		}
+		err = fmt.Errorf("process %d was removed while attaching", r.streamID)
+		return
+	}
+	delete(m.building, r.streamID)
+	if r.stream == nil {
+		err = fmt.Errorf("unable to attach to process %d", r.streamID)
+		return
+	}
+	m.streams[r.streamID] = &managedStream{
+		Stream: r.stream,
+		token:  m.streamSupervisor.Add(r.stream),
+	}
+	go m.awaitHandshake(r.streamID, r.stream)
+}
+
+// awaitHandshake forwards the result of the stream's handshake to Serve
+func (m *Manager) awaitHandshake(streamID uint32, s Stream) {
+	select {
+	case err := <-s.Handshake():
+		select {
+		case m.handshakeChan <- handshakeResult{streamID: streamID, stream: s, err: err}:
+		case <-m.stop:
+		}
+	case <-m.stop:
+	}
+}
+
+func (m *Manager) handleHandshake(r handshakeResult) {
+	ms, found := m.streams[r.streamID]
+	if !found || ms.Stream != r.stream {
+		// The process was removed before the handshake completed
+		return
+	}
+	if r.err != nil {
+		m.logger.Error("Hook handshake failed, shutting down stream",
+			zap.Uint32("streamID", r.streamID),
+			zap.Error(r.err),
+		)
+		m.health.SetState(models.HealthStateDegraded)
+		m.health.SetError(r.err)
+		if err := m.streamSupervisor.Remove(ms.token); err != nil {
+			m.logger.Error("Error removing process group", zap.Uint32("streamID", r.streamID), zap.Error(err))
+		}
+		delete(m.streams, r.streamID)
+		m.detached[r.streamID] = struct{}{}
+		return
+	}
+	m.health.SetState(models.HealthStateConnected)
+	ms.up = true
+	select {
+	case m.cfg.StreamUp <- ms.Stream:
+	case <-m.stop:
 	}
 }
 
 func (m *Manager) handleProcessRemove(streamID uint32) {
-	err := m.streamSupervisor.Remove(m.streamTokens[streamID])
+	if _, building := m.building[streamID]; building {
+		// The stream is shut down once it is built
+		delete(m.building, streamID)
+		return
+	}
+	ms, found := m.streams[streamID]
+	if !found {
+		if _, detached := m.detached[streamID]; detached {
+			delete(m.detached, streamID)
+			return
+		}
+		if m.cfg.HookConfig.DisableAutoAttach {
+			// Processes are only attached on request, so most of them were
+			// never attached
+			return
+		}
+		m.logger.Error("Error removing process group",
+			zap.Uint32("streamID", streamID),
+			zap.Error(errors.New("stream not found")),
+		)
+		return
+	}
+	delete(m.streams, streamID)
+	err := m.streamSupervisor.Remove(ms.token)
 	if err != nil {
 		m.logger.Error("Error removing process group",
 			zap.Uint32("streamID", streamID),
-			zap.String("token", fmt.Sprintf("%#v", m.streamTokens[streamID])),
+			zap.String("token", fmt.Sprintf("%#v", ms.token)),
 			zap.Error(err),
 		)
 		return
 	}
-	m.cfg.StreamDown <- int(streamID)
-	delete(m.streamTokens, streamID)
+	if ms.up {
+		select {
+		case m.cfg.StreamDown <- int(streamID):
+		case <-m.stop:
+		}
+	}
 }
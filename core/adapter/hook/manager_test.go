@@ -1,11 +1,14 @@
 package hook_test
 
 import (
+	"errors"
 	"net/url"
 	"sync"
 
 	"github.com/ff14wed/aetherometer/core/adapter/hook"
 	"github.com/ff14wed/aetherometer/core/adapter/hook/hookfakes"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/process"
 	"github.com/ff14wed/aetherometer/core/stream"
 	"github.com/ff14wed/aetherometer/core/testhelpers"
 	"github.com/onsi/gomega/gbytes"
@@ -23,10 +26,12 @@ var _ = Describe("Manager", func() {
 		cfg              hook.AdapterConfig
 		streamUp         chan stream.Provider
 		streamDown       chan int
-		addProcEventChan chan uint32
+		addProcEventChan chan process.AddEvent
 		remProcEventChan chan uint32
 
-		fakeStream *hookfakes.FakeStream
+		fakeStream    *hookfakes.FakeStream
+		handshakeChan chan error
+		health        *hook.HealthMonitor
 
 		logBuf *testhelpers.LogBuffer
 		once   sync.Once
@@ -50,7 +55,7 @@ var _ = Describe("Manager", func() {
 
 		streamUp = make(chan stream.Provider, 10)
 		streamDown = make(chan int, 10)
-		addProcEventChan = make(chan uint32, 10)
+		addProcEventChan = make(chan process.AddEvent, 10)
 		remProcEventChan = make(chan uint32, 10)
 
 		cfg = hook.AdapterConfig{
@@ -67,6 +72,10 @@ var _ = Describe("Manager", func() {
 
 		fakeStream.StringReturns("fake-stream")
 
+		handshakeChan = make(chan error, 1)
+		fakeStream.HandshakeReturns(handshakeChan)
+		health = hook.NewHealthMonitor(0)
+
 		var closeOnce sync.Once
 		fakeStream.StopStub = func() {
 			closeOnce.Do(func() {
@@ -91,6 +100,7 @@ var _ = Describe("Manager", func() {
 			remProcEventChan,
 			streamBuilder,
 			supervisor,
+			health,
 			logger,
 		)
 
@@ -112,22 +122,180 @@ var _ = Describe("Manager", func() {
 	})
 
 	Context("when a process is added", func() {
-		It("creates the stream and sends it on the StreamUp channel", func() {
-			addProcEventChan <- 1234
+		It("creates the stream and sends it on the StreamUp channel once the handshake completes", func() {
+			addProcEventChan <- process.AddEvent{PID: 1234}
+			Consistently(streamUp).ShouldNot(Receive())
+
+			handshakeChan <- nil
 			Eventually(streamUp).Should(Receive(Equal(fakeStream)))
+			Expect(health.Health().State).To(Equal(models.HealthStateConnected))
 		})
 
 		It("creates the stream and runs it in the provided supervisor", func() {
-			addProcEventChan <- 1234
+			addProcEventChan <- process.AddEvent{PID: 1234}
 
 			Eventually(fakeStream.ServeCallCount).Should(Equal(1))
 			Consistently(fakeStream.StopCallCount).Should(BeZero())
 		})
+
+		Context("when the handshake fails", func() {
+			BeforeEach(func() {
+				addProcEventChan <- process.AddEvent{PID: 1234}
+				Eventually(fakeStream.ServeCallCount).Should(Equal(1))
+				handshakeChan <- errors.New("Boom")
+			})
+
+			It("logs the error and shuts down the stream without sending it on the StreamUp channel", func() {
+				Eventually(logBuf).Should(gbytes.Say("hook-manager.*Hook handshake failed, shutting down stream.*Boom"))
+				Eventually(fakeStream.StopCallCount).Should(Equal(1))
+				Consistently(streamUp).ShouldNot(Receive())
+			})
+
+			It("reports the adapter as degraded", func() {
+				Eventually(func() models.HealthState {
+					return health.Health().State
+				}).Should(Equal(models.HealthStateDegraded))
+				Expect(health.Health().LastError).To(HaveValue(Equal("Boom")))
+			})
+
+			It("does not send anything on the StreamDown channel when the process is removed", func() {
+				Eventually(fakeStream.StopCallCount).Should(Equal(1))
+				remProcEventChan <- 1234
+				Consistently(streamDown).ShouldNot(Receive())
+				Expect(logBuf.Buffer().Contents()).ToNot(ContainSubstring("Error removing process group"))
+			})
+
+			It("allows the process to be attached again", func() {
+				Eventually(fakeStream.StopCallCount).Should(Equal(1))
+				Eventually(mgr.AttachedStreams).Should(BeEmpty())
+
+				Expect(mgr.Attach(1234)).To(Succeed())
+				handshakeChan <- nil
+				Eventually(streamUp).Should(Receive(Equal(fakeStream)))
+				Expect(fakeStream.ServeCallCount()).To(BeNumerically(">", 1))
+			})
+		})
+
+		Context("when nothing receives the stream on the StreamUp channel", func() {
+			BeforeEach(func() {
+				supervisor.Stop()
+
+				cfg.StreamUp = make(chan stream.Provider)
+				supervisor = suture.New("test-hookmanager", suture.Spec{FailureThreshold: 1})
+				mgr = hook.NewManager(
+					cfg,
+					addProcEventChan,
+					remProcEventChan,
+					func(uint32) hook.Stream { return fakeStream },
+					supervisor,
+					health,
+					zap.NewNop(),
+				)
+				supervisor.ServeBackground()
+				_ = supervisor.Add(mgr)
+			})
+
+			It("stops without waiting for the stream to be received", func() {
+				addProcEventChan <- process.AddEvent{PID: 1234}
+				handshakeChan <- nil
+				Eventually(health.Health).Should(HaveField("State", models.HealthStateConnected))
+
+				stopped := make(chan struct{})
+				go func() {
+					supervisor.Stop()
+					close(stopped)
+				}()
+				Eventually(stopped).Should(BeClosed())
+			})
+		})
+
+		Context("when the process is removed before the handshake completes", func() {
+			It("shuts down the stream without notifying the StreamUp or StreamDown channels", func() {
+				addProcEventChan <- process.AddEvent{PID: 1234}
+				Eventually(fakeStream.ServeCallCount).Should(Equal(1))
+				remProcEventChan <- 1234
+				Eventually(fakeStream.StopCallCount).Should(Equal(1))
+
+				handshakeChan <- hook.ErrHandshakeAborted
+				Consistently(streamUp).ShouldNot(Receive())
+				Consistently(streamDown).ShouldNot(Receive())
+			})
+		})
+	})
+
+	Context("when streams take a while to build", func() {
+		var (
+			buildsStarted chan uint32
+			builtStreams  chan hook.Stream
+		)
+
+		BeforeEach(func() {
+			supervisor.Stop()
+
+			buildsStarted = make(chan uint32, 10)
+			builtStreams = make(chan hook.Stream)
+			supervisor = suture.New("test-hookmanager", suture.Spec{FailureThreshold: 1})
+			mgr = hook.NewManager(
+				cfg,
+				addProcEventChan,
+				remProcEventChan,
+				func(streamID uint32) hook.Stream {
+					buildsStarted <- streamID
+					return <-builtStreams
+				},
+				supervisor,
+				health,
+				zap.NewNop(),
+			)
+			supervisor.ServeBackground()
+			_ = supervisor.Add(mgr)
+		})
+
+		AfterEach(func() {
+			close(builtStreams)
+		})
+
+		It("keeps handling requests while a stream is being built", func() {
+			addProcEventChan <- process.AddEvent{PID: 1234}
+			Eventually(buildsStarted).Should(Receive())
+			Consistently(mgr.AttachedStreams).Should(BeEmpty())
+			Expect(mgr.Attach(1234)).To(MatchError("process 1234 is already attached"))
+
+			builtStreams <- fakeStream
+			handshakeChan <- nil
+			Eventually(streamUp).Should(Receive(Equal(fakeStream)))
+			Expect(mgr.AttachedStreams()).To(ConsistOf(uint32(1234)))
+		})
+
+		It("shuts down the stream if the process is removed while it is being built", func() {
+			addProcEventChan <- process.AddEvent{PID: 1234}
+			Eventually(buildsStarted).Should(Receive())
+			remProcEventChan <- 1234
+			Consistently(mgr.AttachedStreams).Should(BeEmpty())
+
+			builtStreams <- fakeStream
+			Eventually(fakeStream.StopCallCount).Should(Equal(1))
+			Expect(mgr.AttachedStreams()).To(BeEmpty())
+			handshakeChan <- nil
+			Consistently(streamUp).ShouldNot(Receive())
+			Consistently(streamDown).ShouldNot(Receive())
+		})
+
+		It("returns an error from Attach if the stream could not be built", func() {
+			attached := make(chan error)
+			go func() {
+				attached <- mgr.Attach(1234)
+			}()
+			builtStreams <- nil
+			Eventually(attached).Should(Receive(MatchError("unable to attach to process 1234")))
+			Expect(mgr.AttachedStreams()).To(BeEmpty())
+		})
 	})
 
 	Context("when a process is removed", func() {
 		BeforeEach(func() {
-			addProcEventChan <- 1234
+			addProcEventChan <- process.AddEvent{PID: 1234}
+			handshakeChan <- nil
 
 			Consistently(streamDown).ShouldNot(Receive())
 			Consistently(fakeStream.StopCallCount).Should(BeZero())
@@ -154,11 +322,99 @@ var _ = Describe("Manager", func() {
 
 				Eventually(logBuf).Should(gbytes.Say("hook-manager.*Error removing process group"))
 
-				addProcEventChan <- 4567
+				addProcEventChan <- process.AddEvent{PID: 4567}
+				handshakeChan <- nil
 				Eventually(streamUp).Should(Receive(Equal(fakeStream)))
 				Eventually(fakeStream.ServeCallCount).Should(BeNumerically(">", 1))
 			})
 		})
 	})
 
+	Describe("Attach", func() {
+		It("starts a stream for the process", func() {
+			Expect(mgr.Attach(1234)).To(Succeed())
+			handshakeChan <- nil
+			Eventually(streamUp).Should(Receive(Equal(fakeStream)))
+			Expect(mgr.AttachedStreams()).To(ConsistOf(uint32(1234)))
+		})
+
+		It("returns an error if the process is already attached", func() {
+			Expect(mgr.Attach(1234)).To(Succeed())
+			Expect(mgr.Attach(1234)).To(MatchError("process 1234 is already attached"))
+		})
+
+		It("does not start a second stream when the scanner finds the attached process", func() {
+			Expect(mgr.Attach(1234)).To(Succeed())
+			addProcEventChan <- process.AddEvent{PID: 1234}
+			Eventually(fakeStream.ServeCallCount).Should(Equal(1))
+			Consistently(fakeStream.ServeCallCount).Should(Equal(1))
+		})
+
+		It("returns an error if the manager is not running", func() {
+			supervisor.Stop()
+			Expect(mgr.Attach(1234)).To(MatchError("hook manager is not running"))
+		})
+	})
+
+	Describe("Detach", func() {
+		BeforeEach(func() {
+			addProcEventChan <- process.AddEvent{PID: 1234}
+			handshakeChan <- nil
+			Eventually(streamUp).Should(Receive())
+		})
+
+		It("shuts down the stream and sends its ID on the StreamDown channel", func() {
+			Expect(mgr.Detach(1234)).To(Succeed())
+			Eventually(streamDown).Should(Receive(Equal(1234)))
+			Eventually(fakeStream.StopCallCount).Should(Equal(1))
+			Expect(mgr.AttachedStreams()).To(BeEmpty())
+		})
+
+		It("returns an error if the stream is not attached", func() {
+			Expect(mgr.Detach(4567)).To(MatchError("stream 4567 is not attached"))
+		})
+
+		It("does not log an error when the process of the detached stream exits", func() {
+			Expect(mgr.Detach(1234)).To(Succeed())
+			remProcEventChan <- 1234
+			Consistently(logBuf).ShouldNot(gbytes.Say("Error removing process group"))
+		})
+
+		It("allows the process to be attached again", func() {
+			Expect(mgr.Detach(1234)).To(Succeed())
+			Expect(mgr.Attach(1234)).To(Succeed())
+			Eventually(fakeStream.ServeCallCount).Should(BeNumerically(">", 1))
+			Expect(mgr.AttachedStreams()).To(ConsistOf(uint32(1234)))
+		})
+	})
+
+	Context("when auto attach is disabled", func() {
+		BeforeEach(func() {
+			supervisor.Stop()
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"hookmanagertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			cfg.HookConfig.DisableAutoAttach = true
+			supervisor = suture.New("test-hookmanager", suture.Spec{FailureThreshold: 1})
+			mgr = hook.NewManager(
+				cfg,
+				addProcEventChan,
+				remProcEventChan,
+				func(uint32) hook.Stream { return fakeStream },
+				supervisor,
+				health,
+				logger,
+			)
+			supervisor.ServeBackground()
+			_ = supervisor.Add(mgr)
+		})
+
+		It("does not log an error when a process that was never attached exits", func() {
+			remProcEventChan <- 4567
+			Consistently(logBuf).ShouldNot(gbytes.Say("Error removing process group"))
+		})
+	})
 })
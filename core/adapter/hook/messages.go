@@ -0,0 +1,116 @@
+package hook
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// DefaultMessageLogSize is the number of hook messages kept for each stream
+const DefaultMessageLogSize = 100
+
+// ParseHookMessage parses a debug message sent by the hook into its severity
+// and its text. The hook may prefix the text with its severity in square
+// brackets, such as "[WARN] Signature scan failed". Messages without a
+// recognized prefix are treated as informational.
+func ParseHookMessage(t time.Time, data []byte) models.HookMessage {
+	text := strings.TrimSpace(strings.TrimRight(string(data), "\x00"))
+	msg := models.HookMessage{
+		Time:     t,
+		Severity: models.HookMessageSeverityInfo,
+		Message:  text,
+	}
+	if !strings.HasPrefix(text, "[") {
+		return msg
+	}
+	end := strings.Index(text, "]")
+	if end < 0 {
+		return msg
+	}
+	switch strings.ToUpper(text[1:end]) {
+	case "DEBUG", "TRACE":
+		msg.Severity = models.HookMessageSeverityDebug
+	case "INFO":
+		msg.Severity = models.HookMessageSeverityInfo
+	case "WARN", "WARNING":
+		msg.Severity = models.HookMessageSeverityWarn
+	case "ERROR", "FATAL":
+		msg.Severity = models.HookMessageSeverityError
+	default:
+		return msg
+	}
+	msg.Message = strings.TrimSpace(text[end+1:])
+	return msg
+}
+
+// exitMessage records the reason given by the hook when it exits as an error
+func exitMessage(t time.Time, data []byte) models.HookMessage {
+	reason := strings.TrimSpace(strings.TrimRight(string(data), "\x00"))
+	if reason == "" {
+		reason = "no reason given"
+	}
+	return models.HookMessage{
+		Time:     t,
+		Severity: models.HookMessageSeverityError,
+		Message:  "Hook exited: " + reason,
+	}
+}
+
+// MessageLog keeps the most recent messages sent by the hook in a bounded
+// ring buffer, and notifies a subscriber of every new message.
+type MessageLog struct {
+	lock     sync.Mutex
+	messages []models.HookMessage
+	next     int
+	full     bool
+
+	notifyChan chan models.HookMessage
+}
+
+// NewMessageLog returns a new MessageLog that keeps at most size messages.
+func NewMessageLog(size int) *MessageLog {
+	if size <= 0 {
+		size = DefaultMessageLogSize
+	}
+	return &MessageLog{
+		messages:   make([]models.HookMessage, size),
+		notifyChan: make(chan models.HookMessage, 16),
+	}
+}
+
+// Add records the message, evicting the oldest message if the log is full.
+// Subscribers are notified without blocking, so a slow subscriber may miss
+// messages, but they are still recorded in the log.
+func (l *MessageLog) Add(msg models.HookMessage) {
+	l.lock.Lock()
+	l.messages[l.next] = msg
+	l.next = (l.next + 1) % len(l.messages)
+	if l.next == 0 {
+		l.full = true
+	}
+	l.lock.Unlock()
+
+	select {
+	case l.notifyChan <- msg:
+	default:
+	}
+}
+
+// Messages returns the recorded messages from oldest to newest.
+func (l *MessageLog) Messages() []models.HookMessage {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if !l.full {
+		return append([]models.HookMessage{}, l.messages[:l.next]...)
+	}
+	messages := make([]models.HookMessage, 0, len(l.messages))
+	messages = append(messages, l.messages[l.next:]...)
+	return append(messages, l.messages[:l.next]...)
+}
+
+// SubscribeMessages returns a channel on which every new message is sent.
+func (l *MessageLog) SubscribeMessages() <-chan models.HookMessage {
+	return l.notifyChan
+}
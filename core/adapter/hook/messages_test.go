@@ -0,0 +1,70 @@
+package hook_test
+
+import (
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseHookMessage", func() {
+	t := time.Unix(10, 0)
+
+	DescribeTable("parses the severity and the text of the message",
+		func(data string, severity models.HookMessageSeverity, text string) {
+			Expect(hook.ParseHookMessage(t, []byte(data))).To(Equal(models.HookMessage{
+				Time:     t,
+				Severity: severity,
+				Message:  text,
+			}))
+		},
+		Entry("debug", "[DEBUG] Hooked recv", models.HookMessageSeverityDebug, "Hooked recv"),
+		Entry("info", "[INFO] Hook loaded", models.HookMessageSeverityInfo, "Hook loaded"),
+		Entry("warning", "[warning] Unknown game build", models.HookMessageSeverityWarn, "Unknown game build"),
+		Entry("error", "[ERROR] Signature scan failed\x00", models.HookMessageSeverityError, "Signature scan failed"),
+		Entry("no prefix", "Hello\n", models.HookMessageSeverityInfo, "Hello"),
+		Entry("unknown prefix", "[Zone] Hello", models.HookMessageSeverityInfo, "[Zone] Hello"),
+		Entry("unterminated prefix", "[WARN Hello", models.HookMessageSeverityInfo, "[WARN Hello"),
+	)
+})
+
+var _ = Describe("MessageLog", func() {
+	var log *hook.MessageLog
+
+	message := func(text string) models.HookMessage {
+		return models.HookMessage{Severity: models.HookMessageSeverityInfo, Message: text}
+	}
+
+	BeforeEach(func() {
+		log = hook.NewMessageLog(3)
+	})
+
+	It("returns the recorded messages from oldest to newest", func() {
+		Expect(log.Messages()).To(BeEmpty())
+		log.Add(message("a"))
+		log.Add(message("b"))
+		Expect(log.Messages()).To(Equal([]models.HookMessage{message("a"), message("b")}))
+	})
+
+	It("evicts the oldest messages once it is full", func() {
+		for _, text := range []string{"a", "b", "c", "d", "e"} {
+			log.Add(message(text))
+		}
+		Expect(log.Messages()).To(Equal([]models.HookMessage{message("c"), message("d"), message("e")}))
+	})
+
+	It("notifies the subscriber of every new message", func() {
+		log.Add(message("a"))
+		Expect(log.SubscribeMessages()).To(Receive(Equal(message("a"))))
+	})
+
+	It("does not block when the subscriber is not listening", func() {
+		for i := 0; i < 100; i++ {
+			log.Add(message("a"))
+		}
+		Expect(log.Messages()).To(HaveLen(3))
+	})
+})
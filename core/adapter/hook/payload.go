@@ -52,23 +52,59 @@ func (e Payload) Encode() []byte {
 // stream and we have potentially faulty data.
 var ErrInvalidLength = errors.New("invalid length encountered in byte stream")
 
+// ErrInvalidOp is returned in resync mode whenever a payload with an unknown
+// op is encountered in the byte stream.
+var ErrInvalidOp = errors.New("invalid op encountered in byte stream")
+
+// payloadHeaderSize is the size of the Length, Op, and Channel fields
+const payloadHeaderSize = 9
+
+// isKnownOp returns true if op is one of the ops defined for a Payload
+func isKnownOp(op byte) bool {
+	return op <= OpOption
+}
+
 // Decoder is responsible for reading bytes from the provided reader
 // and decoding the data into payloads
 type Decoder struct {
 	reader *bufio.Reader
+
+	resync         bool
+	maxLength      uint32
+	discardedBytes uint64
 }
 
 // NewDecoder creates a new decoder instance given an io.Reader and a buffer
 // size. Data read from the Reader will be buffered to store partial data
 // as it comes in.
 func NewDecoder(r io.Reader, bufSize int) *Decoder {
+	reader := bufio.NewReaderSize(r, bufSize)
 	return &Decoder{
-		reader: bufio.NewReaderSize(r, bufSize),
+		reader:    reader,
+		maxLength: uint32(reader.Size()),
 	}
 }
 
+// NewResyncDecoder creates a new decoder instance in resync mode. In this
+// mode, the decoder validates the Length and Op of every payload, where the
+// Length may not exceed the buffer size. If a payload fails validation, the
+// decoder scans forward through the byte stream and discards bytes until it
+// finds the next plausible payload boundary.
+func NewResyncDecoder(r io.Reader, bufSize int) *Decoder {
+	d := NewDecoder(r, bufSize)
+	d.resync = true
+	return d
+}
+
 func (d *Decoder) consumeBytes(numBytes uint32) {
-	_, _ = d.reader.Discard(int(numBytes))
+	n, _ := d.reader.Discard(int(numBytes))
+	d.discardedBytes += uint64(n)
+}
+
+// DiscardedBytes returns the total number of bytes discarded by the decoder
+// due to invalid data.
+func (d *Decoder) DiscardedBytes() uint64 {
+	return d.discardedBytes
 }
 
 // NextPayload consumes an some amount of data on the Reader and returns
@@ -78,9 +114,14 @@ func (d *Decoder) consumeBytes(numBytes uint32) {
 // chance of a failure happening is really low.
 //
 // If the length is at least readable, but it's too small, it will discard
-// the faulty data and continue attempting to read Payloads. However,
-// there is no recovery path if the data is corrupted in other ways, and
-// subsequent calls to NextPayload will return the same thing.
+// the faulty data and continue attempting to read Payloads. However, unless
+// the decoder is in resync mode, there is no recovery path if the data is
+// corrupted in other ways, and subsequent calls to NextPayload will return the
+// same thing.
+//
+// In resync mode, NextPayload returns ErrInvalidLength or ErrInvalidOp after
+// discarding the bytes up to the next plausible payload boundary, so that
+// subsequent calls to NextPayload may continue reading Payloads.
 func (d *Decoder) NextPayload() (Payload, error) {
 	lengthBytes, err := d.reader.Peek(4)
 	if err != nil {
@@ -89,10 +130,14 @@ func (d *Decoder) NextPayload() (Payload, error) {
 
 	length := binary.LittleEndian.Uint32(lengthBytes)
 
+	if d.resync && (length < payloadHeaderSize || length > d.maxLength) {
+		return Payload{}, d.scanForward(ErrInvalidLength)
+	}
+
 	if length < 4 {
 		length = 4
 	}
-	if length < 9 {
+	if length < payloadHeaderSize {
 		d.consumeBytes(length)
 		return Payload{}, ErrInvalidLength
 	}
@@ -102,7 +147,60 @@ func (d *Decoder) NextPayload() (Payload, error) {
 		return Payload{}, err
 	}
 
+	if d.resync && !isKnownOp(envBytes[4]) {
+		return Payload{}, d.scanForward(ErrInvalidOp)
+	}
+
 	env := DecodePayload(envBytes)
-	d.consumeBytes(length)
+	_, _ = d.reader.Discard(int(length))
 	return env, nil
 }
+
+// scanForward discards bytes until the start of the byte stream looks like
+// the header of a valid payload. It returns cause once the next plausible
+// payload boundary is found, or the read error if the reader fails before
+// then.
+func (d *Decoder) scanForward(cause error) error {
+	d.consumeBytes(1)
+	for {
+		if _, err := d.reader.Peek(payloadHeaderSize); err != nil {
+			return err
+		}
+		buffered, _ := d.reader.Peek(d.reader.Buffered())
+		if offset, found := d.findBoundary(buffered); found {
+			d.consumeBytes(uint32(offset))
+			return cause
+		}
+		// Keep the bytes that could still be the start of a header once more
+		// data is read
+		d.consumeBytes(uint32(len(buffered) - payloadHeaderSize + 1))
+	}
+}
+
+// findBoundary returns the offset of the most plausible payload boundary in
+// the buffered data. The first plausible header whose payload is entirely
+// buffered is chosen. Otherwise, the header that requires the least amount of
+// data to complete its payload is chosen, since the bytes right before a real
+// boundary can easily be mistaken for a header with a much larger length.
+func (d *Decoder) findBoundary(buf []byte) (int, bool) {
+	best, bestEnd := -1, 0
+	for i := 0; i+payloadHeaderSize <= len(buf); i++ {
+		if !d.isPlausibleHeader(buf[i:]) {
+			continue
+		}
+		end := i + int(binary.LittleEndian.Uint32(buf[i:i+4]))
+		if end <= len(buf) {
+			return i, true
+		}
+		if best < 0 || end < bestEnd {
+			best, bestEnd = i, end
+		}
+	}
+	return best, best >= 0
+}
+
+// isPlausibleHeader returns true if header begins with a valid Length and Op
+func (d *Decoder) isPlausibleHeader(header []byte) bool {
+	length := binary.LittleEndian.Uint32(header[0:4])
+	return length >= payloadHeaderSize && length <= d.maxLength && isKnownOp(header[4])
+}
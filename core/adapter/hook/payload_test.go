@@ -124,4 +124,100 @@ var _ = Describe("Payload", func() {
 			Expect(env).To(Equal(expectedPayload))
 		})
 	})
+
+	Describe("Decoder in resync mode", func() {
+		It("decodes payloads like the normal decoder", func() {
+			buf := bytes.NewBuffer(append(expectedPayloadBytes, expectedPayloadBytes...))
+			d := hook.NewResyncDecoder(buf, 1024)
+			for i := 0; i < 2; i++ {
+				env, err := d.NextPayload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(env).To(Equal(expectedPayload))
+			}
+			Expect(d.DiscardedBytes()).To(BeZero())
+		})
+
+		It("does not consume bytes if there is not enough data in the reader to read all {length} bytes", func() {
+			buf := bytes.NewBuffer(append([]byte{14}, expectedPayloadBytes[1:]...))
+			d := hook.NewResyncDecoder(buf, 1024)
+			_, err := d.NextPayload()
+			Expect(err).To(MatchError(io.EOF))
+			Expect(d.DiscardedBytes()).To(BeZero())
+		})
+
+		It("recovers from a length larger than the buffer size", func() {
+			garbage := []byte{0xFF, 0xFF, 0xFF, 0x7F, 1, 2, 3}
+			buf := bytes.NewBuffer(append(garbage, expectedPayloadBytes...))
+			d := hook.NewResyncDecoder(buf, 1024)
+			_, err := d.NextPayload()
+			Expect(err).To(MatchError(hook.ErrInvalidLength))
+			Expect(d.DiscardedBytes()).To(BeEquivalentTo(len(garbage)))
+
+			env, err := d.NextPayload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(env).To(Equal(expectedPayload))
+		})
+
+		It("recovers from a length that is too small", func() {
+			garbage := []byte{8, 0, 0, 0, 1, 2, 3, 4}
+			buf := bytes.NewBuffer(append(garbage, expectedPayloadBytes...))
+			d := hook.NewResyncDecoder(buf, 1024)
+			_, err := d.NextPayload()
+			Expect(err).To(MatchError(hook.ErrInvalidLength))
+			Expect(d.DiscardedBytes()).To(BeEquivalentTo(len(garbage)))
+
+			env, err := d.NextPayload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(env).To(Equal(expectedPayload))
+		})
+
+		It("recovers from an unknown op", func() {
+			garbage := []byte{13, 0, 0, 0, 200, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+			buf := bytes.NewBuffer(append(garbage, expectedPayloadBytes...))
+			d := hook.NewResyncDecoder(buf, 1024)
+			_, err := d.NextPayload()
+			Expect(err).To(MatchError(hook.ErrInvalidOp))
+			Expect(d.DiscardedBytes()).To(BeEquivalentTo(len(garbage)))
+
+			env, err := d.NextPayload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(env).To(Equal(expectedPayload))
+		})
+
+		It("does not mistake the bytes right before a payload for the start of a larger payload", func() {
+			garbage := []byte{0xFF, 0xFF, 0xFF, 0xFF}
+			buf := bytes.NewBuffer(append(garbage, expectedPayloadBytes...))
+			d := hook.NewResyncDecoder(buf, 262144)
+			_, err := d.NextPayload()
+			Expect(err).To(MatchError(hook.ErrInvalidLength))
+
+			env, err := d.NextPayload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(env).To(Equal(expectedPayload))
+		})
+
+		It("keeps a running count of the discarded bytes", func() {
+			data := []byte{0xFF, 0xFF}
+			data = append(data, expectedPayloadBytes...)
+			data = append(data, 0xFF, 0xFF, 0xFF)
+			data = append(data, expectedPayloadBytes...)
+			d := hook.NewResyncDecoder(bytes.NewBuffer(data), 1024)
+
+			for i := 0; i < 2; i++ {
+				_, err := d.NextPayload()
+				Expect(err).To(MatchError(hook.ErrInvalidLength))
+				env, err := d.NextPayload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(env).To(Equal(expectedPayload))
+			}
+			Expect(d.DiscardedBytes()).To(BeEquivalentTo(5))
+		})
+
+		It("returns the read error if the reader ends before the next payload boundary", func() {
+			buf := bytes.NewBuffer([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0, 0, 0})
+			d := hook.NewResyncDecoder(buf, 1024)
+			_, err := d.NextPayload()
+			Expect(err).To(MatchError(io.EOF))
+		})
+	})
 })
@@ -0,0 +1,245 @@
+package hook
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultRecordMaxSize is the size in bytes at which session files are rotated
+// if no size is configured.
+const DefaultRecordMaxSize = 100 * 1024 * 1024
+
+// recordFlushInterval is how often compressed session data is flushed to the
+// session file, so that a session can be read while it is being recorded and
+// little is lost if the process exits without closing the file.
+const recordFlushInterval = time.Second
+
+// Recorder sits between the StreamReader and the IPCReader and forwards
+// all payloads it receives. While recording is enabled, it also writes every
+// payload to a session file on disk.
+//
+// Session files are named after the stream ID and the time the recording
+// started. Once a session file exceeds the maximum size, it is closed and
+// recording continues in a new file.
+type Recorder struct {
+	streamID     uint32
+	dir          string
+	compress     bool
+	maxSize      int64
+	payloadsChan <-chan Payload
+	logger       *zap.Logger
+
+	outChan chan Payload
+
+	lock      sync.Mutex
+	file      *os.File
+	counter   *countingWriter
+	writer    *SessionWriter
+	startTime time.Time
+	part      int
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewRecorder creates a new Recorder that writes session files to dir.
+// maxSize determines the size in bytes at which session files are rotated.
+func NewRecorder(
+	streamID uint32,
+	dir string,
+	compress bool,
+	maxSize int64,
+	payloadsChan <-chan Payload,
+	logger *zap.Logger,
+) *Recorder {
+	if maxSize <= 0 {
+		maxSize = DefaultRecordMaxSize
+	}
+	return &Recorder{
+		streamID:     streamID,
+		dir:          dir,
+		compress:     compress,
+		maxSize:      maxSize,
+		payloadsChan: payloadsChan,
+		logger:       logger.Named("recorder"),
+
+		outChan: make(chan Payload),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for recording and forwarding payloads.
+func (r *Recorder) Serve() {
+	defer close(r.stopDone)
+	r.logger.Info("Running")
+
+	t := time.NewTicker(recordFlushInterval)
+	defer t.Stop()
+
+	payloadsChan := r.payloadsChan
+	for {
+		select {
+		case p, ok := <-payloadsChan:
+			if !ok {
+				payloadsChan = nil
+				continue
+			}
+			r.record(p)
+			select {
+			case r.outChan <- p:
+			case <-r.stop:
+				r.shutdown()
+				return
+			}
+		case <-t.C:
+			r.flush()
+		case <-r.stop:
+			r.shutdown()
+			return
+		}
+	}
+}
+
+func (r *Recorder) flush() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.writer == nil {
+		return
+	}
+	if err := r.writer.Flush(); err != nil {
+		r.logger.Error("flushing session file", zap.Error(err))
+	}
+}
+
+func (r *Recorder) shutdown() {
+	r.logger.Info("Stopping...")
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.closeFile()
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (r *Recorder) Stop() {
+	close(r.stop)
+	<-r.stopDone
+}
+
+// ReceivedPayloadsListener returns a channel on which consumers can listen
+// for payloads forwarded by the Recorder.
+func (r *Recorder) ReceivedPayloadsListener() <-chan Payload {
+	return r.outChan
+}
+
+// IsRecording returns whether or not the Recorder is currently writing
+// payloads to disk.
+func (r *Recorder) IsRecording() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.writer != nil
+}
+
+// SetRecording starts or stops recording. It returns the path of the current
+// session file if recording is enabled.
+func (r *Recorder) SetRecording(enabled bool) (string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !enabled {
+		r.closeFile()
+		return "", nil
+	}
+	if r.writer != nil {
+		return r.file.Name(), nil
+	}
+	r.startTime = time.Now()
+	r.part = 0
+	if err := r.openFile(); err != nil {
+		return "", err
+	}
+	return r.file.Name(), nil
+}
+
+func (r *Recorder) openFile() error {
+	if r.dir == "" {
+		return errors.New("no recording directory configured")
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("stream-%d-%s-%03d.aethsess",
+		r.streamID,
+		r.startTime.Format("20060102-150405"),
+		r.part,
+	)
+	f, err := os.Create(filepath.Join(r.dir, name))
+	if err != nil {
+		return err
+	}
+	counter := &countingWriter{w: f}
+	sw, err := NewSessionWriter(counter, r.streamID, r.compress)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file = f
+	r.counter = counter
+	r.writer = sw
+	r.logger.Info("Recording session", zap.String("file", f.Name()))
+	return nil
+}
+
+func (r *Recorder) closeFile() {
+	if r.writer == nil {
+		return
+	}
+	if err := r.writer.Close(); err != nil {
+		r.logger.Error("closing session writer", zap.Error(err))
+	}
+	if err := r.file.Close(); err != nil {
+		r.logger.Error("closing session file", zap.Error(err))
+	}
+	r.logger.Info("Stopped recording session", zap.String("file", r.file.Name()))
+	r.file = nil
+	r.counter = nil
+	r.writer = nil
+}
+
+func (r *Recorder) record(p Payload) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.writer == nil {
+		return
+	}
+	if r.counter.n >= r.maxSize {
+		r.closeFile()
+		r.part++
+		if err := r.openFile(); err != nil {
+			r.logger.Error("rotating session file", zap.Error(err))
+			return
+		}
+	}
+	err := r.writer.WriteRecord(SessionRecord{Time: time.Now(), Payload: p})
+	if err != nil {
+		r.logger.Error("writing session record", zap.Error(err))
+		r.closeFile()
+	}
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,213 @@
+package hook_test
+
+import (
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+func readSessionFile(path string) (hook.SessionHeader, []hook.Payload) {
+	f, err := os.Open(path)
+	Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+	sr, err := hook.NewSessionReader(f)
+	Expect(err).ToNot(HaveOccurred())
+	defer sr.Close()
+	var payloads []hook.Payload
+	for {
+		r, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ToNot(HaveOccurred())
+		payloads = append(payloads, r.Payload)
+	}
+	return sr.Header, payloads
+}
+
+var _ = Describe("Recorder", func() {
+	var (
+		recorder     *hook.Recorder
+		payloadsChan chan hook.Payload
+		recordDir    string
+		compress     bool
+		maxSize      int64
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+		logger *zap.Logger
+
+		supervisor *suture.Supervisor
+	)
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("recordertest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"recordertest://"}
+		var err error
+		logger, err = zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		payloadsChan = make(chan hook.Payload)
+		recordDir = filepath.Join(GinkgoT().TempDir(), "recordings")
+		compress = false
+		maxSize = 0
+	})
+
+	JustBeforeEach(func() {
+		recorder = hook.NewRecorder(1234, recordDir, compress, maxSize, payloadsChan, logger)
+
+		supervisor = suture.New("test-recorder", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(recorder)
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It(`logs "Running" on startup`, func() {
+		Eventually(logBuf).Should(gbytes.Say("recorder.*Running"))
+	})
+
+	It(`logs "Stopping..." on shutdown`, func() {
+		supervisor.Stop()
+		Eventually(logBuf).Should(gbytes.Say("recorder.*Stopping..."))
+	})
+
+	It("forwards payloads without recording them by default", func() {
+		payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: []byte{1, 2, 3}}
+		var p hook.Payload
+		Eventually(recorder.ReceivedPayloadsListener()).Should(Receive(&p))
+		Expect(p.Data).To(Equal([]byte{1, 2, 3}))
+		Expect(recorder.IsRecording()).To(BeFalse())
+		Expect(recordDir).ToNot(BeADirectory())
+	})
+
+	Context("when recording is enabled", func() {
+		var file string
+
+		JustBeforeEach(func() {
+			var err error
+			file, err = recorder.SetRecording(true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorder.IsRecording()).To(BeTrue())
+		})
+
+		It("writes the forwarded payloads to a session file for the stream", func() {
+			Expect(filepath.Base(file)).To(MatchRegexp(`^stream-1234-\d{8}-\d{6}-000\.aethsess$`))
+
+			payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: []byte{1, 2, 3}}
+			Eventually(recorder.ReceivedPayloadsListener()).Should(Receive())
+			payloadsChan <- hook.Payload{Op: hook.OpDebug, Channel: 9000, Data: []byte("hi")}
+			Eventually(recorder.ReceivedPayloadsListener()).Should(Receive())
+
+			_, err := recorder.SetRecording(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorder.IsRecording()).To(BeFalse())
+
+			header, payloads := readSessionFile(file)
+			Expect(header.StreamID).To(BeEquivalentTo(1234))
+			Expect(payloads).To(HaveLen(2))
+			Expect(payloads[0].Data).To(Equal([]byte{1, 2, 3}))
+			Expect(payloads[1].Op).To(BeEquivalentTo(hook.OpDebug))
+		})
+
+		It("closes the session file on shutdown", func() {
+			payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: []byte{1, 2, 3}}
+			Eventually(recorder.ReceivedPayloadsListener()).Should(Receive())
+			supervisor.Stop()
+
+			_, payloads := readSessionFile(file)
+			Expect(payloads).To(HaveLen(1))
+		})
+
+		Context("when the session file is compressed", func() {
+			BeforeEach(func() {
+				compress = true
+			})
+
+			It("periodically flushes the recorded payloads to the session file", func() {
+				payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: []byte{1, 2, 3}}
+				Eventually(recorder.ReceivedPayloadsListener()).Should(Receive())
+
+				readRecords := func() int {
+					f, err := os.Open(file)
+					Expect(err).ToNot(HaveOccurred())
+					defer f.Close()
+					sr, err := hook.NewSessionReader(f)
+					if err != nil {
+						return 0
+					}
+					defer sr.Close()
+					n := 0
+					for {
+						if _, err := sr.Next(); err != nil {
+							return n
+						}
+						n++
+					}
+				}
+				Eventually(readRecords, 3*time.Second).Should(Equal(1))
+				Expect(recorder.IsRecording()).To(BeTrue())
+			})
+		})
+
+		Context("when the session file exceeds the maximum size", func() {
+			BeforeEach(func() {
+				maxSize = 64
+			})
+
+			It("rotates to a new session file", func() {
+				for i := 0; i < 3; i++ {
+					payloadsChan <- hook.Payload{Op: hook.OpRecv, Channel: 1, Data: make([]byte, 40)}
+					Eventually(recorder.ReceivedPayloadsListener()).Should(Receive())
+				}
+				_, err := recorder.SetRecording(false)
+				Expect(err).ToNot(HaveOccurred())
+
+				files, err := filepath.Glob(filepath.Join(recordDir, "stream-1234-*.aethsess"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(3))
+				_, payloads := readSessionFile(files[0])
+				Expect(payloads).To(HaveLen(1))
+			})
+		})
+	})
+
+	Context("when no recording directory is configured", func() {
+		BeforeEach(func() {
+			recordDir = ""
+		})
+
+		It("fails to enable recording", func() {
+			_, err := recorder.SetRecording(true)
+			Expect(err).To(MatchError("no recording directory configured"))
+			Expect(recorder.IsRecording()).To(BeFalse())
+		})
+	})
+})
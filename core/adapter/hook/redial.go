@@ -0,0 +1,209 @@
+package hook
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"go.uber.org/zap"
+)
+
+// reconnector is implemented by connections to the hook that are able to
+// reconnect on their own
+type reconnector interface {
+	Reconnect(reason error)
+}
+
+// maxRedialInterval is the longest the RedialConn waits between attempts to
+// re-dial the hook
+const maxRedialInterval = 30 * time.Second
+
+// errConnectionLost is reported when the connection to the hook is lost
+// without any other explanation
+var errConnectionLost = errors.New("connection to the hook was closed")
+
+// RedialConn is a connection to the hook that transparently re-dials the hook
+// whenever the connection is lost, until it is closed. Reads block while the
+// connection is being re-established, so consumers of the connection only
+// see io.EOF once the RedialConn itself is closed.
+//
+// The health of the connection is reported as RECONNECTING while the hook is
+// being re-dialed and as CONNECTING once the connection is re-established.
+type RedialConn struct {
+	dial          func() (io.ReadWriteCloser, error)
+	retryInterval time.Duration
+	health        *HealthMonitor
+	logger        *zap.Logger
+
+	lock        sync.Mutex
+	conn        io.ReadWriteCloser
+	reason      error
+	closed      bool
+	onReconnect func()
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewRedialConn wraps an established connection to the hook. Once the
+// connection is lost, the dial function is called after retryInterval, and
+// the wait is doubled after every failed attempt, up to maxRedialInterval,
+// until the connection is re-established.
+func NewRedialConn(
+	conn io.ReadWriteCloser,
+	dial func() (io.ReadWriteCloser, error),
+	retryInterval time.Duration,
+	health *HealthMonitor,
+	logger *zap.Logger,
+) *RedialConn {
+	return &RedialConn{
+		dial:          dial,
+		retryInterval: retryInterval,
+		health:        health,
+		logger:        logger.Named("redial-conn"),
+
+		conn: conn,
+
+		closing: make(chan struct{}),
+	}
+}
+
+// OnReconnect sets the function that is called every time the connection is
+// re-established.
+func (c *RedialConn) OnReconnect(f func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.onReconnect = f
+}
+
+// Read implements the Read interface of an io.Reader. It re-dials the hook
+// if the connection is lost, and it only returns io.EOF once the RedialConn
+// is closed.
+func (c *RedialConn) Read(p []byte) (int, error) {
+	for {
+		conn, reconnecting, closed := c.current()
+		if closed {
+			return 0, io.EOF
+		}
+		if !reconnecting {
+			n, err := conn.Read(p)
+			if err == nil || (n > 0 && err == io.EOF) {
+				return n, nil
+			}
+			conn, reconnecting, closed = c.current()
+			if closed {
+				return n, io.EOF
+			}
+			if err != io.EOF && !reconnecting {
+				return n, err
+			}
+		}
+		if !c.redial(conn) {
+			return 0, io.EOF
+		}
+	}
+}
+
+// Write implements the Write interface of an io.Writer. Writes fail while
+// the connection is being re-established.
+func (c *RedialConn) Write(p []byte) (int, error) {
+	conn, _, closed := c.current()
+	if closed {
+		return 0, io.EOF
+	}
+	return conn.Write(p)
+}
+
+// Reconnect closes the current connection to the hook, forcing it to be
+// re-dialed. The reason is reported as the last error of the connection.
+func (c *RedialConn) Reconnect(reason error) {
+	c.lock.Lock()
+	if c.closed {
+		c.lock.Unlock()
+		return
+	}
+	c.reason = reason
+	conn := c.conn
+	c.lock.Unlock()
+	_ = conn.Close()
+}
+
+// Close closes the connection to the hook and stops any attempt to re-dial
+// it. It is safe to call Close() more than once.
+func (c *RedialConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.closing)
+		c.lock.Lock()
+		c.closed = true
+		conn := c.conn
+		c.lock.Unlock()
+		err = conn.Close()
+	})
+	return err
+}
+
+// current returns the current connection, whether a reconnect has been
+// requested, and whether the RedialConn has been closed
+func (c *RedialConn) current() (io.ReadWriteCloser, bool, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.conn, c.reason != nil, c.closed
+}
+
+// redial closes the lost connection and dials the hook until it succeeds,
+// backing off between attempts. It returns false if the RedialConn was closed
+// in the meantime.
+func (c *RedialConn) redial(lost io.ReadWriteCloser) bool {
+	_ = lost.Close()
+
+	c.lock.Lock()
+	reason := c.reason
+	c.reason = nil
+	c.lock.Unlock()
+	if reason == nil {
+		reason = errConnectionLost
+	}
+
+	c.health.SetError(reason)
+	c.health.SetState(models.HealthStateReconnecting)
+	c.logger.Warn("Connection to hook lost, reconnecting", zap.Error(reason))
+
+	interval := c.retryInterval
+	for {
+		select {
+		case <-time.After(interval):
+		case <-c.closing:
+			return false
+		}
+
+		conn, err := c.dial()
+		if err == nil {
+			c.lock.Lock()
+			if c.closed {
+				c.lock.Unlock()
+				_ = conn.Close()
+				return false
+			}
+			c.conn = conn
+			onReconnect := c.onReconnect
+			c.lock.Unlock()
+
+			c.health.SetState(models.HealthStateConnecting)
+			c.logger.Info("Reconnected to hook")
+			if onReconnect != nil {
+				onReconnect()
+			}
+			return true
+		}
+		if interval < maxRedialInterval {
+			interval *= 2
+			if interval > maxRedialInterval {
+				interval = maxRedialInterval
+			}
+		}
+		c.logger.Debug("Failed to reconnect to hook", zap.Error(err), zap.Duration("retryIn", interval))
+	}
+}
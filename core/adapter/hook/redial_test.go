@@ -0,0 +1,202 @@
+package hook_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/onsi/gomega/gbytes"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RedialConn", func() {
+	type readResult struct {
+		data string
+		err  error
+	}
+
+	var (
+		rc     *hook.RedialConn
+		health *hook.HealthMonitor
+		remote net.Conn
+
+		dials        chan net.Conn
+		dialAttempts int32
+		reconnected  chan struct{}
+		reads        chan readResult
+		readerDone   chan struct{}
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+	)
+
+	// nextConn makes the next dial succeed and returns the remote end of the
+	// new connection
+	nextConn := func() net.Conn {
+		local, remote := net.Pipe()
+		dials <- local
+		return remote
+	}
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("redialconntest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"redialconntest://"}
+		logger, err := zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		dials = make(chan net.Conn, 1)
+		dialAttempts = 0
+		dial := func() (io.ReadWriteCloser, error) {
+			atomic.AddInt32(&dialAttempts, 1)
+			select {
+			case conn := <-dials:
+				return conn, nil
+			default:
+				return nil, errors.New("pipe not found")
+			}
+		}
+
+		var local net.Conn
+		local, remote = net.Pipe()
+		health = hook.NewHealthMonitor(0)
+		health.SetState(models.HealthStateConnected)
+		rc = hook.NewRedialConn(local, dial, 10*time.Millisecond, health, logger)
+
+		reconnected = make(chan struct{}, 1)
+		rc.OnReconnect(func() {
+			reconnected <- struct{}{}
+		})
+
+		reads = make(chan readResult, 1)
+		readerDone = make(chan struct{})
+		go func(rc *hook.RedialConn, reads chan<- readResult, done chan<- struct{}) {
+			defer close(done)
+			buf := make([]byte, 16)
+			for {
+				n, err := rc.Read(buf)
+				reads <- readResult{data: string(buf[:n]), err: err}
+				if err != nil {
+					return
+				}
+			}
+		}(rc, reads, readerDone)
+	})
+
+	AfterEach(func() {
+		_ = rc.Close()
+		// The reader re-dials the hook, so it has to exit before the log
+		// buffer is reset by the next test
+		Eventually(func() bool {
+			select {
+			case <-reads:
+			default:
+			}
+			select {
+			case <-readerDone:
+				return true
+			default:
+				return false
+			}
+		}).Should(BeTrue())
+	})
+
+	It("reads and writes through the connection", func() {
+		go func() {
+			_, _ = remote.Write([]byte("hello"))
+		}()
+		Eventually(reads).Should(Receive(Equal(readResult{data: "hello"})))
+
+		go func() {
+			_, _ = rc.Write([]byte("world"))
+		}()
+		buf := make([]byte, 16)
+		n, err := remote.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(buf[:n])).To(Equal("world"))
+	})
+
+	Context("when the connection is lost", func() {
+		It("re-dials the hook until it succeeds", func() {
+			Expect(remote.Close()).To(Succeed())
+			Eventually(health.Health).Should(HaveField("State", models.HealthStateReconnecting))
+			Expect(health.Health().LastError).To(HaveValue(Equal("connection to the hook was closed")))
+			Eventually(logBuf).Should(gbytes.Say(`WARN.*redial-conn.*Connection to hook lost, reconnecting`))
+			Consistently(reconnected).ShouldNot(Receive())
+
+			remote = nextConn()
+			Eventually(reconnected).Should(Receive())
+			Expect(health.Health().State).To(Equal(models.HealthStateConnecting))
+			Eventually(logBuf).Should(gbytes.Say("redial-conn.*Reconnected to hook"))
+
+			go func() {
+				_, _ = remote.Write([]byte("hello"))
+			}()
+			Eventually(reads).Should(Receive(Equal(readResult{data: "hello"})))
+		})
+
+		It("backs off between attempts to re-dial the hook", func() {
+			Expect(remote.Close()).To(Succeed())
+			Eventually(health.Health).Should(HaveField("State", models.HealthStateReconnecting))
+
+			// Without backing off, the hook would be dialed 30 times
+			time.Sleep(300 * time.Millisecond)
+			Expect(atomic.LoadInt32(&dialAttempts)).To(BeNumerically("<=", 5))
+		})
+
+		It("stops re-dialing once it is closed", func() {
+			Expect(remote.Close()).To(Succeed())
+			Eventually(health.Health).Should(HaveField("State", models.HealthStateReconnecting))
+
+			Expect(rc.Close()).To(Succeed())
+			Eventually(reads).Should(Receive(Equal(readResult{err: io.EOF})))
+			Expect(reconnected).ToNot(Receive())
+		})
+	})
+
+	Describe("Reconnect", func() {
+		It("closes the connection and re-dials the hook", func() {
+			rc.Reconnect(errors.New("hook exited: Unload"))
+			Eventually(health.Health).Should(HaveField("State", models.HealthStateReconnecting))
+			Expect(health.Health().LastError).To(HaveValue(Equal("hook exited: Unload")))
+
+			_, err := remote.Write([]byte("hello"))
+			Expect(err).To(HaveOccurred())
+
+			remote = nextConn()
+			Eventually(reconnected).Should(Receive())
+			go func() {
+				_, _ = remote.Write([]byte("hello"))
+			}()
+			Eventually(reads).Should(Receive(Equal(readResult{data: "hello"})))
+		})
+	})
+
+	Describe("Close", func() {
+		It("closes the connection and returns EOF to readers", func() {
+			Expect(rc.Close()).To(Succeed())
+			Eventually(reads).Should(Receive(Equal(readResult{err: io.EOF})))
+
+			_, err := rc.Write([]byte("hello"))
+			Expect(err).To(Equal(io.EOF))
+			Expect(rc.Close()).To(Succeed())
+		})
+	})
+})
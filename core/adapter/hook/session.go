@@ -0,0 +1,188 @@
+package hook
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// SessionMagic identifies the start of a recorded session file.
+const SessionMagic = "AETHSESS"
+
+// SessionVersion is the current version of the session file format.
+const SessionVersion = 1
+
+// Flags for the flags field in a SessionHeader
+const (
+	SessionFlagGzip uint16 = 1 << iota
+)
+
+const sessionHeaderSize = 20
+
+// SessionHeader describes the fixed size header written at the beginning of
+// every session file. The header itself is never compressed.
+//
+// The layout of the header is as follows:
+//   - 8 bytes: SessionMagic
+//   - 2 bytes: format version
+//   - 2 bytes: flags
+//   - 4 bytes: stream ID of the recorded stream
+//   - 4 bytes: reserved
+type SessionHeader struct {
+	Version  uint16
+	Flags    uint16
+	StreamID uint32
+}
+
+// SessionRecord is a single payload from the hook, along with the time it was
+// received by the adapter.
+//
+// A record is stored as an 8 byte receive time (nanoseconds since the Unix
+// epoch) followed by the encoded Payload, which is itself length-prefixed.
+type SessionRecord struct {
+	Time    time.Time
+	Payload Payload
+}
+
+// ErrInvalidSession is returned whenever the data being read does not look
+// like a session file.
+var ErrInvalidSession = errors.New("invalid session file")
+
+// SessionWriter encodes session records to the underlying writer.
+type SessionWriter struct {
+	w  io.Writer
+	gz *gzip.Writer
+}
+
+// NewSessionWriter writes the session header to w and returns a writer
+// for the session records. If compress is true, the records are gzip
+// compressed.
+func NewSessionWriter(w io.Writer, streamID uint32, compress bool) (*SessionWriter, error) {
+	var flags uint16
+	if compress {
+		flags |= SessionFlagGzip
+	}
+	header := make([]byte, sessionHeaderSize)
+	copy(header[0:8], SessionMagic)
+	binary.LittleEndian.PutUint16(header[8:10], SessionVersion)
+	binary.LittleEndian.PutUint16(header[10:12], flags)
+	binary.LittleEndian.PutUint32(header[12:16], streamID)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	sw := &SessionWriter{w: w}
+	if compress {
+		sw.gz = gzip.NewWriter(w)
+		sw.w = sw.gz
+	}
+	return sw, nil
+}
+
+// WriteRecord encodes the record to the session.
+func (s *SessionWriter) WriteRecord(r SessionRecord) error {
+	payloadBytes := r.Payload.Encode()
+	buf := make([]byte, 8+len(payloadBytes))
+	binary.LittleEndian.PutUint64(buf[0:8], uint64(r.Time.UnixNano()))
+	copy(buf[8:], payloadBytes)
+	_, err := s.w.Write(buf)
+	return err
+}
+
+// Flush flushes any buffered compressed data to the underlying writer.
+func (s *SessionWriter) Flush() error {
+	if s.gz != nil {
+		return s.gz.Flush()
+	}
+	return nil
+}
+
+// Close finishes writing the session. It does not close the underlying
+// writer.
+func (s *SessionWriter) Close() error {
+	if s.gz != nil {
+		return s.gz.Close()
+	}
+	return nil
+}
+
+// SessionReader decodes session records from the underlying reader.
+type SessionReader struct {
+	Header SessionHeader
+
+	r  *bufio.Reader
+	gz *gzip.Reader
+}
+
+// NewSessionReader reads and validates the session header from r and
+// returns a reader for the session records.
+func NewSessionReader(r io.Reader) (*SessionReader, error) {
+	header := make([]byte, sessionHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("%w: reading header: %s", ErrInvalidSession, err)
+	}
+	if string(header[0:8]) != SessionMagic {
+		return nil, fmt.Errorf("%w: bad magic", ErrInvalidSession)
+	}
+	sr := &SessionReader{
+		Header: SessionHeader{
+			Version:  binary.LittleEndian.Uint16(header[8:10]),
+			Flags:    binary.LittleEndian.Uint16(header[10:12]),
+			StreamID: binary.LittleEndian.Uint32(header[12:16]),
+		},
+	}
+	if sr.Header.Version != SessionVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidSession, sr.Header.Version)
+	}
+
+	if sr.Header.Flags&SessionFlagGzip != 0 {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSession, err)
+		}
+		sr.gz = gz
+		r = gz
+	}
+	sr.r = bufio.NewReader(r)
+	return sr, nil
+}
+
+// Next returns the next record in the session. It returns io.EOF when there
+// are no records left. If the session ends in the middle of a record,
+// io.ErrUnexpectedEOF is returned.
+func (s *SessionReader) Next() (SessionRecord, error) {
+	head := make([]byte, 12)
+	if _, err := io.ReadFull(s.r, head); err != nil {
+		return SessionRecord{}, err
+	}
+	length := binary.LittleEndian.Uint32(head[8:12])
+	if length < 9 {
+		return SessionRecord{}, ErrInvalidLength
+	}
+	payloadBytes := make([]byte, length)
+	copy(payloadBytes, head[8:12])
+	if _, err := io.ReadFull(s.r, payloadBytes[4:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return SessionRecord{}, err
+	}
+	nsec := int64(binary.LittleEndian.Uint64(head[0:8]))
+	return SessionRecord{
+		Time:    time.Unix(0, nsec),
+		Payload: DecodePayload(payloadBytes),
+	}, nil
+}
+
+// Close releases any resources held by the reader. It does not close the
+// underlying reader.
+func (s *SessionReader) Close() error {
+	if s.gz != nil {
+		return s.gz.Close()
+	}
+	return nil
+}
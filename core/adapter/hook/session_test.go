@@ -0,0 +1,95 @@
+package hook_test
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Session", func() {
+	var (
+		buf     *bytes.Buffer
+		records []hook.SessionRecord
+	)
+
+	BeforeEach(func() {
+		buf = new(bytes.Buffer)
+		records = []hook.SessionRecord{
+			{
+				Time:    time.Unix(10, 1234),
+				Payload: hook.Payload{Length: 13, Op: hook.OpRecv, Channel: 1, Data: []byte{1, 2, 3, 4}},
+			},
+			{
+				Time:    time.Unix(11, 5678),
+				Payload: hook.Payload{Length: 11, Op: hook.OpSend, Channel: 2, Data: []byte{5, 6}},
+			},
+		}
+	})
+
+	writeSession := func(compress bool) {
+		sw, err := hook.NewSessionWriter(buf, 1234, compress)
+		Expect(err).ToNot(HaveOccurred())
+		for _, r := range records {
+			Expect(sw.WriteRecord(r)).To(Succeed())
+		}
+		Expect(sw.Close()).To(Succeed())
+	}
+
+	readSession := func() (hook.SessionHeader, []hook.SessionRecord) {
+		sr, err := hook.NewSessionReader(buf)
+		Expect(err).ToNot(HaveOccurred())
+		defer sr.Close()
+		var read []hook.SessionRecord
+		for {
+			r, err := sr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			read = append(read, r)
+		}
+		return sr.Header, read
+	}
+
+	It("round trips uncompressed records", func() {
+		writeSession(false)
+		Expect(buf.Bytes()[0:8]).To(Equal([]byte(hook.SessionMagic)))
+		header, read := readSession()
+		Expect(header).To(Equal(hook.SessionHeader{Version: hook.SessionVersion, StreamID: 1234}))
+		Expect(read).To(HaveLen(2))
+		for i := range records {
+			Expect(read[i].Time.Equal(records[i].Time)).To(BeTrue())
+			Expect(read[i].Payload).To(Equal(records[i].Payload))
+		}
+	})
+
+	It("round trips gzip compressed records", func() {
+		writeSession(true)
+		header, read := readSession()
+		Expect(header.Flags & hook.SessionFlagGzip).ToNot(BeZero())
+		Expect(header.StreamID).To(BeEquivalentTo(1234))
+		Expect(read).To(HaveLen(2))
+		Expect(read[1].Payload).To(Equal(records[1].Payload))
+	})
+
+	It("errors on data that is not a session file", func() {
+		buf.WriteString("definitely not a session file")
+		_, err := hook.NewSessionReader(buf)
+		Expect(err).To(MatchError(hook.ErrInvalidSession))
+	})
+
+	It("errors on a truncated record", func() {
+		writeSession(false)
+		truncated := bytes.NewBuffer(buf.Bytes()[:buf.Len()-1])
+		sr, err := hook.NewSessionReader(truncated)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = sr.Next()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = sr.Next()
+		Expect(err).To(MatchError(io.ErrUnexpectedEOF))
+	})
+})
@@ -6,10 +6,15 @@ import (
 	"io"
 	"net"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
 	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
 	"github.com/ff14wed/xivnet/v3"
 	"github.com/thejerf/suture"
 	"go.uber.org/zap"
@@ -24,24 +29,88 @@ type Stream interface {
 	suture.Service
 	stream.Provider
 	fmt.Stringer
+
+	// Handshake returns a channel that receives nil once the handshake with
+	// the hook has completed, or the error if the handshake failed. The
+	// stream should not be used until the handshake has completed.
+	Handshake() <-chan error
 }
 
 type hookStream struct {
-	streamID uint32
+	streamID  uint32
+	sourceKey string
 	*suture.Supervisor
 
-	sender    *StreamSender
-	ipcReader *IPCReader
+	sender     *StreamSender
+	handshaker *Handshaker
+	commander  *Commander
+	recorder   *Recorder
+	ipcReader  *IPCReader
+	health     *HealthMonitor
 }
 
 // NewStream creates a new hook Stream
 func NewStream(streamID uint32, cfg AdapterConfig, logger *zap.Logger) Stream {
+	s, err := newStream(streamID, cfg, logger)
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+// newStream creates a new hook Stream, returning the error if the hook could
+// not be initialized
+func newStream(streamID uint32, cfg AdapterConfig, logger *zap.Logger) (Stream, error) {
+	hookConn, err := InitializeHook(streamID, cfg)
+	if err != nil {
+		logger.Named(fmt.Sprintf("stream-%d", streamID)).Error("Failed to initialize hook", zap.Error(err))
+		return nil, err
+	}
+
+	// Reconnecting to the hook only requires dialing the pipe again since the
+	// hook is already injected
+	dial := func() (io.ReadWriteCloser, error) {
+		return dialHook(streamID, cfg.RemoteProcessProvider)
+	}
+	return newConnStream(streamID, strconv.Itoa(int(streamID)), hookConn, dial, cfg.HookConfig, cfg.OpcodeRegistry, cfg.TransformRegistry, logger), nil
+}
+
+// NewConnStream creates a new hook Stream from an already established
+// connection to the hook. This allows adapters other than the hook adapter to
+// communicate with the hook over other transports. The sourceKey describes
+// where the connection came from, such as a process ID or an endpoint.
+func NewConnStream(
+	streamID uint32,
+	sourceKey string,
+	hookConn io.ReadWriteCloser,
+	hookCfg config.HookConfig,
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
+	logger *zap.Logger,
+) Stream {
+	return newConnStream(streamID, sourceKey, hookConn, nil, hookCfg, opcodeRegistry, transformRegistry, logger)
+}
+
+// newConnStream creates a new hook Stream from an already established
+// connection to the hook. If dial is not nil, it is used to reconnect to the
+// hook whenever the connection is lost.
+func newConnStream(
+	streamID uint32,
+	sourceKey string,
+	hookConn io.ReadWriteCloser,
+	dial func() (io.ReadWriteCloser, error),
+	hookCfg config.HookConfig,
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
+	logger *zap.Logger,
+) *hookStream {
 	streamName := fmt.Sprintf("stream-%d", streamID)
 	streamLogger := logger.Named(streamName)
 	supervisorLogger := streamLogger.Named("supervisor")
 
 	s := &hookStream{
-		streamID: streamID,
+		streamID:  streamID,
+		sourceKey: sourceKey,
 		Supervisor: suture.New(streamName, suture.Spec{
 			Log: func(line string) {
 				supervisorLogger.Info(line)
@@ -49,40 +118,74 @@ func NewStream(streamID uint32, cfg AdapterConfig, logger *zap.Logger) Stream {
 		}),
 	}
 
-	hookConn, err := InitializeHook(streamID, cfg)
-	if err != nil {
-		streamLogger.Error("Failed to initialize hook", zap.Error(err))
-		return nil
+	pingInterval := 1 * time.Second
+	if hookCfg.PingInterval > 0 {
+		pingInterval = time.Duration(hookCfg.PingInterval)
 	}
 
-	pingInterval := 1 * time.Second
-	if cfg.HookConfig.PingInterval > 0 {
-		pingInterval = time.Duration(cfg.HookConfig.PingInterval)
+	handshakeTimeout := 10 * time.Second
+	if hookCfg.HandshakeTimeout > 0 {
+		handshakeTimeout = time.Duration(hookCfg.HandshakeTimeout)
+	}
+
+	maxMissedPings := 3
+	if hookCfg.MaxMissedPings > 0 {
+		maxMissedPings = hookCfg.MaxMissedPings
+	}
+
+	health := NewHealthMonitor(maxMissedPings)
+
+	var redialConn *RedialConn
+	if dial != nil {
+		redialConn = NewRedialConn(hookConn, dial, dialRetryInterval(hookCfg), health, streamLogger)
+		hookConn = redialConn
 	}
 
 	ss := NewStreamSender(hookConn, streamLogger)
-	sp := NewStreamPinger(ss, pingInterval, streamLogger)
-	sr := NewStreamReader(hookConn, streamLogger)
+	sp := NewStreamPinger(ss, hookConn, pingInterval, health, streamLogger)
+	sr := NewStreamReader(hookConn, health, streamLogger)
+	hs := NewHandshaker(ss, sr.ReceivedPayloadsListener(), handshakeTimeout, health, streamLogger)
+	if redialConn != nil {
+		redialConn.OnReconnect(hs.Restart)
+	}
+	cmd := NewCommander(ss, hs.ReceivedPayloadsListener(), 5*time.Second, streamLogger)
+	rec := NewRecorder(
+		streamID,
+		hookCfg.RecordDir,
+		hookCfg.RecordCompress,
+		hookCfg.RecordMaxSize,
+		cmd.ReceivedPayloadsListener(),
+		streamLogger,
+	)
 	fr := NewIPCReader(
 		streamID,
-		sr.ReceivedPayloadsListener(),
+		rec.ReceivedPayloadsListener(),
+		nil,
+		opcodeRegistry,
+		transformRegistry,
 		streamLogger,
 	)
 
+	if hookCfg.Record {
+		if _, err := rec.SetRecording(true); err != nil {
+			streamLogger.Error("Failed to start recording", zap.Error(err))
+		}
+	}
+
 	s.Add(sr)
 	s.Add(ss)
 	s.Add(sp)
+	s.Add(hs)
+	s.Add(cmd)
+	s.Add(rec)
 	s.Add(fr)
 
 	s.sender = ss
+	s.handshaker = hs
+	s.commander = cmd
+	s.recorder = rec
 	s.ipcReader = fr
-
-	// Configure Deucalion to send Zone and Chat for both directions
-	go func() {
-		time.Sleep(1 * time.Second)
-		s.sender.Send(OpDebug, 9000, []byte("Aetherometer"))
-		s.sender.Send(OpOption, 54, nil)
-	}()
+	s.health = health
 
 	return s
 }
@@ -92,6 +195,11 @@ func (s *hookStream) StreamID() int {
 	return int(s.streamID)
 }
 
+// SourceKey returns the source of this stream's connection to the hook
+func (s *hookStream) SourceKey() string {
+	return s.sourceKey
+}
+
 // SubscribeIngress provides parsed ingress frames read from the hook
 func (s *hookStream) SubscribeIngress() <-chan *xivnet.Block {
 	return s.ipcReader.SubscribeIngress()
@@ -102,16 +210,55 @@ func (s *hookStream) SubscribeEgress() <-chan *xivnet.Block {
 	return s.ipcReader.SubscribeEgress()
 }
 
-// SendRequest sends a request directly to this stream's hook
+// Handshake reports the result of the handshake with this stream's hook
+func (s *hookStream) Handshake() <-chan error {
+	return s.handshaker.Result()
+}
+
+// Health returns the health of this stream's connection to the hook
+func (s *hookStream) Health() models.Health {
+	return s.health.Health()
+}
+
+// SubscribeHealth provides the health of this stream's connection to the hook
+// whenever its state changes
+func (s *hookStream) SubscribeHealth() <-chan models.Health {
+	return s.health.SubscribeHealth()
+}
+
+// Messages returns the most recent messages sent by the hook
+func (s *hookStream) Messages() []models.HookMessage {
+	return s.ipcReader.Messages()
+}
+
+// SubscribeMessages provides the messages sent by the hook as they arrive
+func (s *hookStream) SubscribeMessages() <-chan models.HookMessage {
+	return s.ipcReader.SubscribeMessages()
+}
+
+// commandRequest identifies the command in a request
+type commandRequest struct {
+	Command *string `json:"command"`
+}
+
+// SendRequest sends a request directly to this stream's hook. Requests of the
+// form {"command": "<name>", ...} run one of the commands listed by Commands
+// and return the JSON-encoded response to the command.
 func (s *hookStream) SendRequest(req []byte) ([]byte, error) {
-	// This particular implementation of SendRequest requires that the request
-	// bytes must be directly marshalable to an Payload
+	// Otherwise, this particular implementation of SendRequest requires that
+	// the request bytes must be directly marshalable to an Payload
 	// Length does not need to be provided
 	var env Payload
 	err := json.Unmarshal(req, &env)
 	if err != nil {
 		return nil, fmt.Errorf("cannot unmarshal data to payload: %s", err)
 	}
+
+	var cr commandRequest
+	if err := json.Unmarshal(req, &cr); err == nil && cr.Command != nil {
+		return s.runCommand(*cr.Command, req)
+	}
+
 	s.sender.Send(env.Op, env.Channel, env.Data)
 	return []byte(`OK`), nil
 }
@@ -122,10 +269,7 @@ func (s *hookStream) SendRequest(req []byte) ([]byte, error) {
 func InitializeHook(streamID uint32, cfg AdapterConfig) (net.Conn, error) {
 	dllPath := cfg.HookConfig.DLLPath
 	rpp := cfg.RemoteProcessProvider
-	retryInterval := 500 * time.Millisecond
-	if cfg.HookConfig.DialRetryInterval > 0 {
-		retryInterval = time.Duration(cfg.HookConfig.DialRetryInterval)
-	}
+	retryInterval := dialRetryInterval(cfg.HookConfig)
 
 	if _, err := os.Stat(dllPath); err != nil {
 		return nil, err
@@ -138,13 +282,10 @@ func InitializeHook(streamID uint32, cfg AdapterConfig) (net.Conn, error) {
 	}
 
 	var conn net.Conn
-	pipeName := fmt.Sprintf(`\\.\pipe\deucalion-%d`, streamID)
-	dialTimeout := 5 * time.Second
-
 	for i := 0; i < 5; i++ {
-		conn, err = rpp.DialPipe(pipeName, &dialTimeout)
+		conn, err = dialHook(streamID, rpp)
 		if err == nil {
-			return &hookConn{Conn: conn, rpp: rpp}, nil
+			return conn, nil
 		}
 		// If we got some sort of error connecting to the pipe, that means
 		// the hook hasn't started the pipe server yet. We need to retry in
@@ -155,6 +296,25 @@ func InitializeHook(streamID uint32, cfg AdapterConfig) (net.Conn, error) {
 	return nil, err
 }
 
+// dialHook makes a single attempt to connect to the pipe of an already
+// injected hook.
+func dialHook(streamID uint32, rpp RemoteProcessProvider) (net.Conn, error) {
+	pipeName := fmt.Sprintf(`\\.\pipe\deucalion-%d`, streamID)
+	dialTimeout := 5 * time.Second
+	conn, err := rpp.DialPipe(pipeName, &dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &hookConn{Conn: conn, rpp: rpp}, nil
+}
+
+func dialRetryInterval(cfg config.HookConfig) time.Duration {
+	if cfg.DialRetryInterval > 0 {
+		return time.Duration(cfg.DialRetryInterval)
+	}
+	return 500 * time.Millisecond
+}
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . net.Conn
 
 type hookConn struct {
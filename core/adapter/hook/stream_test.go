@@ -1,18 +1,25 @@
 package hook_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/ff14wed/aetherometer/core/adapter/hook"
 	"github.com/ff14wed/aetherometer/core/adapter/hook/hookfakes"
 	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
 	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/ff14wed/aetherometer/core/transform"
 	"github.com/ff14wed/xivnet/v3"
 	"github.com/thejerf/suture"
 	"go.uber.org/zap"
@@ -39,6 +46,8 @@ var _ = Describe("InitializeHook", func() {
 		cfg.RemoteProcessProvider = rpp
 		cfg.HookConfig.DLLPath = dllPath
 		cfg.HookConfig.DialRetryInterval = config.Duration(1 * time.Millisecond)
+		cfg.OpcodeRegistry = opcodes.NewRegistry()
+		cfg.TransformRegistry = transform.NewRegistry()
 	})
 
 	It("injects the provided DLL path into the process whose pid is the streamID", func() {
@@ -289,7 +298,8 @@ var _ = Describe("Stream", func() {
 		conn         *hookfakes.FakeConn
 		fakeDataChan chan readData
 
-		cfg hook.AdapterConfig
+		cfg       hook.AdapterConfig
+		recordDir string
 
 		hookStream hook.Stream
 		logBuf     *testhelpers.LogBuffer
@@ -330,6 +340,10 @@ var _ = Describe("Stream", func() {
 		cfg.HookConfig.DLLPath = dllPath
 		cfg.HookConfig.DialRetryInterval = config.Duration(1 * time.Millisecond)
 		cfg.HookConfig.PingInterval = config.Duration(1 * time.Hour)
+		cfg.OpcodeRegistry = opcodes.NewRegistry()
+		cfg.TransformRegistry = transform.NewRegistry()
+		recordDir = GinkgoT().TempDir()
+		cfg.HookConfig.RecordDir = recordDir
 
 		once.Do(func() {
 			logBuf = new(testhelpers.LogBuffer)
@@ -378,6 +392,12 @@ var _ = Describe("Stream", func() {
 		})
 	})
 
+	Describe("SourceKey", func() {
+		It("returns the process ID of the stream", func() {
+			Expect(hookStream.(stream.SourceKeyer).SourceKey()).To(Equal(strconv.Itoa(int(streamID))))
+		})
+	})
+
 	Describe("String", func() {
 		It("returns the string representation of the stream", func() {
 			Expect(hookStream.String()).To(Equal(fmt.Sprintf("stream-%d", int(streamID))))
@@ -406,12 +426,16 @@ var _ = Describe("Stream", func() {
 			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("stream-sender"))
 			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("stream-pinger"))
 			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("stream-reader"))
+			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("handshaker"))
+			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("commander"))
+			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("recorder"))
 			Eventually(logBuf.Buffer().Contents).Should(ContainSubstring("ipc-reader"))
 
 			Eventually(logBuf).Should(gbytes.Say("Running"))
 			Eventually(logBuf).Should(gbytes.Say("Running"))
 			Eventually(logBuf).Should(gbytes.Say("Running"))
 			Eventually(logBuf).Should(gbytes.Say("Running"))
+			Eventually(logBuf).Should(gbytes.Say("Running"))
 		})
 
 		It("initializes the hook on startup", func() {
@@ -490,6 +514,243 @@ var _ = Describe("Stream", func() {
 			})
 		})
 
+		Describe("Handshake", func() {
+			It("completes once the options are requested in response to the hook's hello", func() {
+				Consistently(hookStream.Handshake()).ShouldNot(Receive())
+
+				fakeDataChan <- readData{data: hook.Payload{
+					Op:   hook.OpDebug,
+					Data: []byte("SERVER HELLO. VERSION: 0.9.3."),
+				}.Encode()}
+
+				Eventually(conn.WriteCallCount).Should(Equal(2))
+				Expect(conn.WriteArgsForCall(1)).To(Equal(hook.Payload{
+					Op:      hook.OpOption,
+					Channel: 54,
+				}.Encode()))
+				Eventually(hookStream.Handshake()).Should(Receive(BeNil()))
+			})
+		})
+
+		Context("when the hook exits", func() {
+			var reconnectedDataChan chan readData
+
+			BeforeEach(func() {
+				reconnectedDataChan = make(chan readData)
+				reconnectedConn := new(hookfakes.FakeConn)
+				reconnectedConn.WriteStub = func(d []byte) (int, error) {
+					return len(d), nil
+				}
+				reconnectedConn.ReadStub = func(p []byte) (n int, err error) {
+					d, ok := <-reconnectedDataChan
+					if !ok {
+						return 0, io.EOF
+					}
+					copy(p, d.data)
+					return len(d.data), d.err
+				}
+				reconnectedConn.CloseStub = func() error {
+					close(reconnectedDataChan)
+					return nil
+				}
+				rpp.DialPipeReturnsOnCall(1, reconnectedConn, nil)
+			})
+
+			It("reconnects to the hook without injecting it again", func() {
+				healthChan := hookStream.(stream.HealthNotifier).SubscribeHealth()
+
+				fakeDataChan <- readData{data: hook.Payload{
+					Op:   hook.OpExit,
+					Data: []byte("Unload"),
+				}.Encode()}
+
+				Eventually(healthChan).Should(Receive(HaveField("State", models.HealthStateConnected)))
+				var status models.Health
+				Eventually(healthChan).Should(Receive(&status))
+				Expect(status.State).To(Equal(models.HealthStateReconnecting))
+				Expect(status.LastError).To(HaveValue(Equal("hook exited: Unload")))
+				Eventually(healthChan).Should(Receive(HaveField("State", models.HealthStateConnecting)))
+
+				Expect(rpp.InjectDLLCallCount()).To(Equal(1))
+				Expect(rpp.DialPipeCallCount()).To(Equal(2))
+				pipeName, _ := rpp.DialPipeArgsForCall(1)
+				Expect(pipeName).To(Equal(`\\.\pipe\deucalion-1234`))
+
+				By("negotiating the options with the hook again")
+				reconnectedDataChan <- readData{data: hook.Payload{
+					Op:   hook.OpDebug,
+					Data: []byte("SERVER HELLO. VERSION: 0.9.3."),
+				}.Encode()}
+				Eventually(healthChan).Should(Receive(HaveField("State", models.HealthStateConnected)))
+				Eventually(logBuf).Should(gbytes.Say("handshaker.*Restarting handshake"))
+			})
+		})
+
+		Describe("Commands", func() {
+			It("lists the commands supported by the stream", func() {
+				lister, ok := hookStream.(stream.CommandLister)
+				Expect(ok).To(BeTrue())
+				var names []string
+				for _, c := range lister.Commands() {
+					names = append(names, c.Name)
+					Expect(json.Valid([]byte(c.Schema))).To(BeTrue())
+				}
+				Expect(names).To(Equal([]string{
+					"setOptions", "enableChannels", "disableChannels", "version", "ping", "stats", "record",
+				}))
+			})
+
+			It("generates the schema of each command from its request", func() {
+				lister := hookStream.(stream.CommandLister)
+				schemas := make(map[string]string)
+				for _, c := range lister.Commands() {
+					schemas[c.Name] = c.Schema
+				}
+				Expect(schemas["setOptions"]).To(MatchJSON(`{
+					"type": "object",
+					"properties": {
+						"command": {"const": "setOptions"},
+						"options": {"type": "integer", "minimum": 0, "maximum": 126}
+					},
+					"required": ["command", "options"],
+					"additionalProperties": false
+				}`))
+				Expect(schemas["enableChannels"]).To(MatchJSON(`{
+					"type": "object",
+					"properties": {
+						"command": {"const": "enableChannels"},
+						"channels": {
+							"type": "array",
+							"minItems": 1,
+							"items": {"enum": ["recvZone", "recvChat", "recvLobby", "sendZone", "sendChat", "sendLobby"]}
+						}
+					},
+					"required": ["command", "channels"],
+					"additionalProperties": false
+				}`))
+				Expect(schemas["ping"]).To(MatchJSON(`{
+					"type": "object",
+					"properties": {"command": {"const": "ping"}},
+					"required": ["command"],
+					"additionalProperties": false
+				}`))
+				Expect(schemas["record"]).To(MatchJSON(`{
+					"type": "object",
+					"properties": {
+						"command": {"const": "record"},
+						"enabled": {"type": "boolean"}
+					},
+					"required": ["command", "enabled"],
+					"additionalProperties": false
+				}`))
+			})
+		})
+
+		Describe("SendRequest with a command", func() {
+			// replyToWrite waits for the nth write to the connection and
+			// replies with the payload returned by makeReply
+			replyToWrite := func(n int, makeReply func(written hook.Payload) hook.Payload) {
+				go func() {
+					defer GinkgoRecover()
+					Eventually(conn.WriteCallCount).Should(BeNumerically(">", n))
+					written := hook.DecodePayload(conn.WriteArgsForCall(n))
+					fakeDataChan <- readData{data: makeReply(written).Encode()}
+				}()
+			}
+
+			It("sets the options and responds with the options applied by the hook", func() {
+				replyToWrite(0, func(written hook.Payload) hook.Payload {
+					return hook.Payload{Op: hook.OpOption, Channel: written.Channel}
+				})
+				resp, err := hookStream.SendRequest([]byte(`{"command": "setOptions", "options": 6}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp).To(MatchJSON(`{"options": 6, "channels": ["recvZone", "recvChat"]}`))
+			})
+
+			It("enables and disables channels relative to the applied options", func() {
+				fakeDataChan <- readData{data: hook.Payload{Op: hook.OpOption, Channel: 54}.Encode()}
+				Eventually(func() ([]byte, error) {
+					return hookStream.SendRequest([]byte(`{"command": "stats"}`))
+				}).Should(ContainSubstring(`"options":54`))
+
+				replyToWrite(0, func(written hook.Payload) hook.Payload {
+					return hook.Payload{Op: hook.OpOption, Channel: written.Channel}
+				})
+				resp, err := hookStream.SendRequest([]byte(`{"command": "disableChannels", "channels": ["recvChat", "sendChat"]}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp).To(MatchJSON(`{"options": 18, "channels": ["recvZone", "sendZone"]}`))
+			})
+
+			It("responds with the version of the hook", func() {
+				fakeDataChan <- readData{data: hook.Payload{
+					Op:   hook.OpDebug,
+					Data: []byte("SERVER HELLO. VERSION: 0.9.3."),
+				}.Encode()}
+				Eventually(func() ([]byte, error) {
+					return hookStream.SendRequest([]byte(`{"command": "version"}`))
+				}).Should(MatchJSON(`{"version": "0.9.3"}`))
+			})
+
+			It("pings the hook and responds with the round trip time", func() {
+				replyToWrite(0, func(written hook.Payload) hook.Payload {
+					return hook.Payload{Op: hook.OpPing, Channel: written.Channel}
+				})
+				resp, err := hookStream.SendRequest([]byte(`{"command": "ping"}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(resp)).To(MatchRegexp(`^{"rtt":[0-9.e-]+}$`))
+			})
+
+			It("responds with the stats of the stream", func() {
+				resp, err := hookStream.SendRequest([]byte(`{"command": "stats"}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(resp)).To(ContainSubstring(`"recording":false`))
+				Expect(string(resp)).To(ContainSubstring(`"state":"CONNECTING"`))
+			})
+
+			It("starts and stops recording without sending anything to the hook", func() {
+				resp, err := hookStream.SendRequest([]byte(`{"command": "record", "enabled": true}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(resp)).To(MatchRegexp(`{"recording":true,"file":".*stream-1234-.*\.aethsess"}`))
+
+				resp, err = hookStream.SendRequest([]byte(`{"command": "stats"}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(resp)).To(ContainSubstring(`"recording":true`))
+
+				resp, err = hookStream.SendRequest([]byte(`{"command": "record", "enabled": false}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(resp)).To(Equal(`{"recording":false}`))
+
+				Consistently(conn.WriteCallCount).Should(Equal(0))
+				files, err := filepath.Glob(filepath.Join(recordDir, "*.aethsess"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+			})
+
+			It("rejects requests that cannot be decoded into the command", func() {
+				_, err := hookStream.SendRequest([]byte(`{"command": "ping", "foo": 1}`))
+				Expect(err).To(MatchError(`invalid ping command: json: unknown field "foo"`))
+
+				_, err = hookStream.SendRequest([]byte(`{"command": "setOptions"}`))
+				Expect(err).To(MatchError("invalid setOptions command: options is required"))
+
+				_, err = hookStream.SendRequest([]byte(`{"command": "setOptions", "options": 129}`))
+				Expect(err).To(MatchError("invalid setOptions command: unknown options 0b10000001"))
+
+				_, err = hookStream.SendRequest([]byte(`{"command": "enableChannels", "channels": ["lobby"]}`))
+				Expect(err).To(MatchError(`invalid enableChannels command: unknown channel "lobby"`))
+
+				_, err = hookStream.SendRequest([]byte(`{"command": "record"}`))
+				Expect(err).To(MatchError("invalid record command: enabled is required"))
+
+				Expect(conn.WriteCallCount()).To(BeZero())
+			})
+
+			It("rejects unknown commands", func() {
+				_, err := hookStream.SendRequest([]byte(`{"command": "bogus"}`))
+				Expect(err).To(MatchError(`unknown command "bogus"`))
+			})
+		})
+
 		Describe("SendRequest", func() {
 			It("sends a JSON-encoded request as an payload on the connection", func() {
 				// Byte arrays can be represented as base64 in JSON
@@ -1,6 +1,8 @@
 package hook
 
 import (
+	"fmt"
+	"io"
 	"time"
 
 	"go.uber.org/zap"
@@ -15,10 +17,16 @@ type HookDataSender interface {
 }
 
 // StreamPinger sends a ping through the hook connection to make sure it's still
-// alive
+// alive. The time each ping is sent is reported to the HealthMonitor so that
+// the round trip time can be measured and unanswered pings can be detected.
+//
+// When the HealthMonitor declares the connection stale, the StreamPinger asks
+// the connection to reconnect if it is able to.
 type StreamPinger struct {
 	hds          HookDataSender
+	hookConn     io.Closer
 	pingInterval time.Duration
+	health       *HealthMonitor
 	logger       *zap.Logger
 
 	stop     chan struct{}
@@ -26,10 +34,18 @@ type StreamPinger struct {
 }
 
 // NewStreamPinger returns a new StreamPinger
-func NewStreamPinger(hds HookDataSender, pingInterval time.Duration, logger *zap.Logger) *StreamPinger {
+func NewStreamPinger(
+	hds HookDataSender,
+	hookConn io.Closer,
+	pingInterval time.Duration,
+	health *HealthMonitor,
+	logger *zap.Logger,
+) *StreamPinger {
 	return &StreamPinger{
 		hds:          hds,
+		hookConn:     hookConn,
 		pingInterval: pingInterval,
+		health:       health,
 		logger:       logger.Named("stream-pinger"),
 
 		stop:     make(chan struct{}),
@@ -47,6 +63,9 @@ func (p *StreamPinger) Serve() {
 	for {
 		select {
 		case <-t.C:
+			if p.health.PingSent() {
+				p.handleStale()
+			}
 			p.hds.Send(OpPing, 0, nil)
 		case <-p.stop:
 			p.logger.Info("Stopping...")
@@ -55,6 +74,17 @@ func (p *StreamPinger) Serve() {
 	}
 }
 
+// handleStale handles the hook no longer answering pings
+func (p *StreamPinger) handleStale() {
+	missedPings := p.health.MissedPings()
+	p.logger.Warn("Hook stopped responding to pings",
+		zap.Int("missedPings", missedPings),
+	)
+	if rc, ok := p.hookConn.(reconnector); ok {
+		rc.Reconnect(fmt.Errorf("hook missed %d pings in a row", missedPings))
+	}
+}
+
 // Stop will shutdown this service and wait on it to stop before returning.
 func (p *StreamPinger) Stop() {
 	close(p.stop)
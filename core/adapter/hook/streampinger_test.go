@@ -1,12 +1,14 @@
 package hook_test
 
 import (
+	"io"
 	"net/url"
 	"sync"
 	"time"
 
 	"github.com/ff14wed/aetherometer/core/adapter/hook"
 	"github.com/ff14wed/aetherometer/core/adapter/hook/hookfakes"
+	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/testhelpers"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/thejerf/suture"
@@ -18,8 +20,11 @@ import (
 
 var _ = Describe("StreamPinger", func() {
 	var (
-		sp  *hook.StreamPinger
-		hds *hookfakes.FakeHookDataSender
+		sp     *hook.StreamPinger
+		hds    *hookfakes.FakeHookDataSender
+		conn   io.Closer
+		health *hook.HealthMonitor
+		logger *zap.Logger
 
 		logBuf *testhelpers.LogBuffer
 		once   sync.Once
@@ -38,11 +43,17 @@ var _ = Describe("StreamPinger", func() {
 		logBuf.Reset()
 		zapCfg := zap.NewDevelopmentConfig()
 		zapCfg.OutputPaths = []string{"streampingertest://"}
-		logger, err := zapCfg.Build()
+		var err error
+		logger, err = zapCfg.Build()
 		Expect(err).ToNot(HaveOccurred())
 
 		hds = new(hookfakes.FakeHookDataSender)
-		sp = hook.NewStreamPinger(hds, 100*time.Millisecond, logger)
+		conn = new(hookfakes.FakeReadCloser)
+		health = nil
+	})
+
+	JustBeforeEach(func() {
+		sp = hook.NewStreamPinger(hds, conn, 100*time.Millisecond, health, logger)
 
 		supervisor = suture.New("test-streampinger", suture.Spec{
 			Log: func(line string) {
@@ -82,4 +93,39 @@ var _ = Describe("StreamPinger", func() {
 		sendCallCount := hds.SendCallCount()
 		Consistently(hds.SendCallCount).Should(Equal(sendCallCount))
 	})
+
+	Context("when the hook does not answer the pings", func() {
+		BeforeEach(func() {
+			health = hook.NewHealthMonitor(2)
+			health.PayloadReceived(hook.Payload{Op: hook.OpDebug})
+		})
+
+		It("logs that the hook stopped responding", func() {
+			Eventually(logBuf).Should(gbytes.Say(`stream-pinger.*Hook stopped responding to pings.*"missedPings": 2`))
+			Expect(health.Health().State).To(Equal(models.HealthStateDegraded))
+		})
+
+		It("does not close a connection that is unable to reconnect", func() {
+			Eventually(health.Health).Should(HaveField("State", models.HealthStateDegraded))
+			Consistently(conn.(*hookfakes.FakeReadCloser).CloseCallCount).Should(BeZero())
+		})
+
+		Context("when the connection is able to reconnect", func() {
+			var reasons chan error
+
+			BeforeEach(func() {
+				reasons = make(chan error, 1)
+				conn = reconnectingConn{FakeReadCloser: new(hookfakes.FakeReadCloser), reasons: reasons}
+			})
+
+			It("asks the connection to reconnect after the pings are missed", func() {
+				Eventually(reasons).Should(Receive(MatchError("hook missed 2 pings in a row")))
+				Expect(health.MissedPings()).To(Equal(2))
+				Expect(conn.(reconnectingConn).CloseCallCount()).To(BeZero())
+
+				By("not asking again while the connection is being re-established")
+				Consistently(reasons).ShouldNot(Receive())
+			})
+		})
+	})
 })
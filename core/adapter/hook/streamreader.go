@@ -1,17 +1,27 @@
 package hook
 
 import (
+	"fmt"
 	"io"
 
+	"github.com/ff14wed/aetherometer/core/models"
+
 	"go.uber.org/zap"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . io.ReadCloser
 
 // StreamReader reads data from the hook connection and decodes it into
-// payloads
+// payloads. The decoder runs in resync mode so that the StreamReader is able
+// to recover from corrupted data. Every payload and read error, along with
+// any corrupted data that was discarded, is also reported to the
+// HealthMonitor.
+//
+// When the hook announces that it is exiting, the StreamReader asks the
+// connection to reconnect if it is able to, and closes it otherwise.
 type StreamReader struct {
 	hookConn io.ReadCloser
+	health   *HealthMonitor
 	logger   *zap.Logger
 
 	recvChan chan Payload
@@ -19,9 +29,10 @@ type StreamReader struct {
 }
 
 // NewStreamReader creates a new StreamReader
-func NewStreamReader(hookConn io.ReadCloser, logger *zap.Logger) *StreamReader {
+func NewStreamReader(hookConn io.ReadCloser, health *HealthMonitor, logger *zap.Logger) *StreamReader {
 	return &StreamReader{
 		hookConn: hookConn,
+		health:   health,
 		logger:   logger.Named("stream-reader"),
 
 		recvChan: make(chan Payload),
@@ -36,21 +47,53 @@ func (r *StreamReader) Serve() {
 	defer close(r.stopDone)
 
 	r.logger.Info("Running")
-	d := NewDecoder(r.hookConn, 262144)
+	d := NewResyncDecoder(r.hookConn, 262144)
+	var discardedBytes uint64
 
 	for {
 		env, err := d.NextPayload()
+		if d.DiscardedBytes() > discardedBytes {
+			n := d.DiscardedBytes() - discardedBytes
+			discardedBytes = d.DiscardedBytes()
+			r.health.BytesDiscarded(int(n))
+			r.logger.Warn("Discarded corrupted data from conn",
+				zap.Uint64("discardedBytes", n),
+				zap.Uint64("totalDiscardedBytes", discardedBytes),
+			)
+		}
 		if err == nil {
+			r.health.PayloadReceived(env)
 			r.recvChan <- env
+			if env.Op == OpExit {
+				r.handleExit(env)
+			}
 		} else if err == io.EOF {
+			r.health.SetState(models.HealthStateClosed)
 			r.logger.Info("Stopping...")
 			return
 		} else {
+			r.health.SetError(err)
 			r.logger.Error("reading data from conn", zap.Error(err))
 		}
 	}
 }
 
+// handleExit handles the hook's announcement that it is exiting
+func (r *StreamReader) handleExit(p Payload) {
+	reason := string(p.Data)
+	if reason == "" {
+		reason = "no reason given"
+	}
+	r.logger.Warn("Hook exited", zap.String("reason", reason))
+	err := fmt.Errorf("hook exited: %s", reason)
+	r.health.SetError(err)
+	if rc, ok := r.hookConn.(reconnector); ok {
+		rc.Reconnect(err)
+		return
+	}
+	r.hookConn.Close()
+}
+
 // Complete lets the supervisor know it's okay for this process to shut down
 // on its own (if the pipe connection shuts down).
 func (r *StreamReader) Complete() bool {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ff14wed/aetherometer/core/adapter/hook"
 	"github.com/ff14wed/aetherometer/core/adapter/hook/hookfakes"
+	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/testhelpers"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/thejerf/suture"
@@ -17,6 +18,16 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+// reconnectingConn is a hook connection that is able to reconnect on its own
+type reconnectingConn struct {
+	*hookfakes.FakeReadCloser
+	reasons chan error
+}
+
+func (c reconnectingConn) Reconnect(reason error) {
+	c.reasons <- reason
+}
+
 var _ = Describe("StreamReader", func() {
 	type readData struct {
 		data []byte
@@ -24,7 +35,8 @@ var _ = Describe("StreamReader", func() {
 	}
 
 	var (
-		sr *hook.StreamReader
+		sr     *hook.StreamReader
+		health *hook.HealthMonitor
 
 		hookConn     *hookfakes.FakeReadCloser
 		sendFakeData func(readData)
@@ -64,12 +76,16 @@ var _ = Describe("StreamReader", func() {
 			copy(p, d.data)
 			return len(d.data), d.err
 		}
+		var closeOnce sync.Once
 		hookConn.CloseStub = func() error {
-			close(fakeDataChan)
+			closeOnce.Do(func() {
+				close(fakeDataChan)
+			})
 			return nil
 		}
 
-		sr = hook.NewStreamReader(hookConn, logger)
+		health = hook.NewHealthMonitor(0)
+		sr = hook.NewStreamReader(hookConn, health, logger)
 
 		supervisor = suture.New("test-streamreader", suture.Spec{
 			Log: func(line string) {
@@ -103,7 +119,7 @@ var _ = Describe("StreamReader", func() {
 		sendFakeData(readData{
 			data: append([]byte{
 				20, 0, 0, 0, // Length
-				200,          // Op
+				hook.OpDebug, // Op
 				210, 4, 0, 0, // Channel
 			}, []byte("Hello World")...),
 		})
@@ -111,22 +127,58 @@ var _ = Describe("StreamReader", func() {
 		var e hook.Payload
 		Eventually(sr.ReceivedPayloadsListener()).Should(Receive(&e))
 		Expect(e).To(Equal(hook.Payload{
-			Length: 20, Op: 200, Channel: 1234, Data: []byte("Hello World"),
+			Length: 20, Op: hook.OpDebug, Channel: 1234, Data: []byte("Hello World"),
 		}))
+		Expect(health.Health().State).To(Equal(models.HealthStateConnected))
 	})
 
 	Context("when the reader returns some sort of non-fatal error", func() {
 		It("logs the error and continues running", func() {
 			sendFakeData(readData{err: errors.New("Boom")})
 			Eventually(logBuf).Should(gbytes.Say("ERROR.*stream-reader.*reading data from conn.*Boom"))
+			Expect(health.Health().LastError).To(HaveValue(Equal("Boom")))
 
 			sendFakeData(readData{
-				data: []byte{9, 0, 0, 0, 200, 210, 4, 0, 0},
+				data: []byte{9, 0, 0, 0, hook.OpDebug, 210, 4, 0, 0},
 			})
 
 			var e hook.Payload
 			Eventually(sr.ReceivedPayloadsListener()).Should(Receive(&e))
-			Expect(e).To(Equal(hook.Payload{Length: 9, Op: 200, Channel: 1234, Data: []byte{}}))
+			Expect(e).To(Equal(hook.Payload{Length: 9, Op: hook.OpDebug, Channel: 1234, Data: []byte{}}))
+		})
+	})
+
+	Context("when the data on the hook connection is corrupted", func() {
+		It("discards the corrupted data and reports it", func() {
+			sendFakeData(readData{
+				data: []byte{
+					0xFF, 0xFF, 0xFF, 0xFF, // Garbage
+					9, 0, 0, 0, hook.OpDebug, 210, 4, 0, 0,
+				},
+			})
+
+			var e hook.Payload
+			Eventually(sr.ReceivedPayloadsListener()).Should(Receive(&e))
+			Expect(e).To(Equal(hook.Payload{Length: 9, Op: hook.OpDebug, Channel: 1234, Data: []byte{}}))
+
+			Expect(logBuf).To(gbytes.Say(`WARN.*stream-reader.*Discarded corrupted data from conn.*"discardedBytes": 4`))
+			Expect(health.Health().DiscardedBytes).To(Equal(4))
+			Expect(health.Health().LastError).To(HaveValue(Equal(hook.ErrInvalidLength.Error())))
+		})
+	})
+
+	Context("when the hook exits", func() {
+		It("closes the hook connection", func() {
+			sendFakeData(readData{
+				data: append([]byte{15, 0, 0, 0, hook.OpExit, 0, 0, 0, 0}, []byte("Unload")...),
+			})
+			Eventually(sr.ReceivedPayloadsListener()).Should(Receive(
+				HaveField("Op", BeEquivalentTo(hook.OpExit)),
+			))
+			Eventually(logBuf).Should(gbytes.Say(`WARN.*stream-reader.*Hook exited.*"reason": "Unload"`))
+			Eventually(hookConn.CloseCallCount).Should(BeNumerically(">=", 1))
+			Eventually(logBuf).Should(gbytes.Say("stream-reader.*Stopping..."))
+			Expect(health.Health().LastError).To(HaveValue(Equal("hook exited: Unload")))
 		})
 	})
 
@@ -135,6 +187,58 @@ var _ = Describe("StreamReader", func() {
 			sendFakeData(readData{err: io.EOF})
 			Eventually(logBuf).Should(gbytes.Say("stream-reader.*Stopping..."))
 			Expect(sr.Complete()).To(BeTrue())
+			Expect(health.Health().State).To(Equal(models.HealthStateClosed))
+		})
+	})
+
+	Context("when the hook connection is able to reconnect", func() {
+		var reasons chan error
+
+		BeforeEach(func() {
+			supervisor.Stop()
+			reasons = make(chan error, 1)
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"streamreadertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			hookConn = new(hookfakes.FakeReadCloser)
+			fakeDataChan := make(chan readData)
+			sendFakeData = func(d readData) {
+				fakeDataChan <- d
+			}
+			hookConn.ReadStub = func(p []byte) (n int, err error) {
+				d := <-fakeDataChan
+				copy(p, d.data)
+				return len(d.data), d.err
+			}
+
+			sr = hook.NewStreamReader(reconnectingConn{FakeReadCloser: hookConn, reasons: reasons}, health, logger)
+			supervisor = suture.New("test-streamreader", suture.Spec{
+				Log: func(line string) {
+					_, _ = GinkgoWriter.Write([]byte(line))
+				},
+				FailureThreshold: 1,
+			})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(sr)
+		})
+
+		It("asks the connection to reconnect when the hook exits", func() {
+			sendFakeData(readData{
+				data: []byte{9, 0, 0, 0, hook.OpExit, 0, 0, 0, 0},
+			})
+			Eventually(sr.ReceivedPayloadsListener()).Should(Receive())
+			Eventually(reasons).Should(Receive(MatchError("hook exited: no reason given")))
+			Expect(hookConn.CloseCallCount()).To(BeZero())
+
+			By("continuing to read from the connection")
+			sendFakeData(readData{
+				data: []byte{9, 0, 0, 0, hook.OpDebug, 0, 0, 0, 0},
+			})
+			Eventually(sr.ReceivedPayloadsListener()).Should(Receive())
+			sendFakeData(readData{err: io.EOF})
 		})
 	})
 })
@@ -2,12 +2,23 @@ package adapter
 
 import (
 	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/adapter/replay"
+	"github.com/ff14wed/aetherometer/core/adapter/simulator"
+	"github.com/ff14wed/aetherometer/core/adapter/socket"
+	"github.com/ff14wed/aetherometer/core/opcodes"
 	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
 )
 
 // Inventory enumerates the adapters that are compatible with Windows.
-func Inventory() []stream.AdapterInfo {
+func Inventory(
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
+) []stream.AdapterInfo {
 	return []stream.AdapterInfo{
-		hook.GetInfo(),
+		hook.GetInfo(opcodeRegistry, transformRegistry),
+		replay.GetInfo(opcodeRegistry, transformRegistry),
+		socket.GetInfo(opcodeRegistry, transformRegistry),
+		simulator.GetInfo(),
 	}
 }
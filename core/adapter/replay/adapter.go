@@ -0,0 +1,59 @@
+package replay
+
+import (
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
+)
+
+// Adapter defines the implementation of the replay Adapter
+type Adapter struct {
+	*suture.Supervisor
+}
+
+// AdapterConfig provides commonly accessed configuration for the replay
+// to the services that make up the replay Adapter
+type AdapterConfig struct {
+	ReplayConfig config.ReplayConfig
+
+	StreamUp   chan<- stream.Provider
+	StreamDown chan<- int
+
+	OpcodeRegistry    *opcodes.Registry
+	TransformRegistry *transform.Registry
+}
+
+// NewAdapter creates a new instance of the replay Adapter
+func NewAdapter(cfg AdapterConfig, logger *zap.Logger) *Adapter {
+	replayLogger := logger.Named("replay-adapter")
+	supervisorLogger := replayLogger.Named("supervisor")
+	a := &Adapter{
+		Supervisor: suture.New("replay-adapter", suture.Spec{
+			Log: func(line string) {
+				supervisorLogger.Info(line)
+			},
+		}),
+	}
+
+	streamSupervisorLogger := replayLogger.Named("stream-supervisor")
+	streamSupervisor := suture.New("stream-supervisor", suture.Spec{
+		Log: func(line string) {
+			streamSupervisorLogger.Info(line)
+		},
+	})
+
+	streamBuilder := func(file string) (Stream, error) {
+		return NewStream(file, cfg, replayLogger)
+	}
+
+	manager := NewManager(cfg, streamBuilder, streamSupervisor, replayLogger)
+
+	a.Add(streamSupervisor)
+	a.Add(manager)
+
+	return a
+}
@@ -0,0 +1,139 @@
+package replay_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/adapter/replay"
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Adapter", func() {
+	var (
+		adapter    *replay.Adapter
+		supervisor *suture.Supervisor
+
+		streamUp chan stream.Provider
+		files    []string
+	)
+
+	BeforeEach(func() {
+		tmpDir := GinkgoT().TempDir()
+		files = []string{
+			writeSessionFile(tmpDir, 1234,
+				ipcPayload(hook.OpRecv, 1, 1000),
+				ipcPayload(hook.OpSend, 2, 1001),
+			),
+			filepath.Join(tmpDir, "does-not-exist"),
+			writeSessionFile(tmpDir, 5678, ipcPayload(hook.OpRecv, 3, 2000)),
+		}
+		streamUp = make(chan stream.Provider, 10)
+	})
+
+	JustBeforeEach(func() {
+		adapter = replay.NewAdapter(replay.AdapterConfig{
+			ReplayConfig: config.ReplayConfig{
+				Enabled: true,
+				Files:   files,
+				Pacing:  config.ReplayPacingFast,
+			},
+			StreamUp:   streamUp,
+			StreamDown: make(chan int, 10),
+
+			OpcodeRegistry:    opcodes.NewRegistry(),
+			TransformRegistry: transform.NewRegistry(),
+		}, zap.NewNop())
+
+		supervisor = suture.New("test-adapter", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(adapter)
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It("creates a stream for each valid session file using the recorded stream ID", func() {
+		var s1, s2 stream.Provider
+		Eventually(streamUp).Should(Receive(&s1))
+		Eventually(streamUp).Should(Receive(&s2))
+		Expect(s1.StreamID()).To(Equal(1234))
+		Expect(s2.StreamID()).To(Equal(5678))
+		Expect(s1.(stream.SourceKeyer).SourceKey()).To(Equal(files[0]))
+		Expect(s2.(stream.SourceKeyer).SourceKey()).To(Equal(files[2]))
+		Consistently(streamUp).ShouldNot(Receive())
+	})
+
+	It("parses the recorded payloads into blocks", func() {
+		var s stream.Provider
+		Eventually(streamUp).Should(Receive(&s))
+
+		var b *xivnet.Block
+		Eventually(s.SubscribeIngress()).Should(Receive(&b))
+		Expect(b.SubjectID).To(BeEquivalentTo(1))
+		Eventually(s.SubscribeEgress()).Should(Receive(&b))
+		Expect(b.SubjectID).To(BeEquivalentTo(2))
+	})
+
+	It("controls playback with requests", func() {
+		var s stream.Provider
+		Eventually(streamUp).Should(Receive(&s))
+		resp, err := s.SendRequest([]byte(`{"command": "pause"}`))
+		Expect(err).ToNot(HaveOccurred())
+		var status replay.Status
+		Expect(json.Unmarshal(resp, &status)).To(Succeed())
+		Expect(status.Paused).To(BeTrue())
+
+		_, err = s.SendRequest([]byte("foo"))
+		Expect(err).To(MatchError(ContainSubstring("cannot unmarshal data to command")))
+	})
+
+	It("resets the stream in order with the blocks when seeking backwards", func() {
+		var s stream.Provider
+		Eventually(streamUp).Should(Receive(&s))
+
+		var b *xivnet.Block
+		Eventually(s.SubscribeIngress()).Should(Receive(&b))
+		Eventually(s.SubscribeEgress()).Should(Receive(&b))
+
+		_, err := s.SendRequest([]byte(`{"command": "seek", "timestamp": 500}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(s.SubscribeIngress()).Should(Receive(&b))
+		Expect(b).To(BeIdenticalTo(stream.ResetBlock))
+		Eventually(s.SubscribeIngress()).Should(Receive(&b))
+		Expect(b.SubjectID).To(BeEquivalentTo(1))
+
+		Eventually(s.SubscribeEgress()).Should(Receive(&b))
+		Expect(b).To(BeIdenticalTo(stream.ResetBlock))
+		Eventually(s.SubscribeEgress()).Should(Receive(&b))
+		Expect(b.SubjectID).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("GetInfo", func() {
+	It("rejects an unknown pacing mode", func() {
+		info := replay.GetInfo(opcodes.NewRegistry(), transform.NewRegistry())
+		Expect(info.Name).To(Equal("Replay"))
+		cfg := config.Config{}
+		cfg.Adapters.Replay.Pacing = "slow"
+		Expect(info.Builder.LoadConfig(cfg)).To(MatchError(`unknown pacing "slow"`))
+		cfg.Adapters.Replay.Pacing = config.ReplayPacingFast
+		Expect(info.Builder.LoadConfig(cfg)).To(Succeed())
+	})
+})
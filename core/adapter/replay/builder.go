@@ -0,0 +1,62 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
+	"go.uber.org/zap"
+)
+
+// GetInfo returns the adapter's name and a builder for the adapter. The
+// registries are used to parse and transform the blocks of every replayed stream.
+func GetInfo(
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
+) stream.AdapterInfo {
+	return stream.AdapterInfo{
+		Name: "Replay",
+		Builder: &builder{
+			opcodes:    opcodeRegistry,
+			transforms: transformRegistry,
+		},
+	}
+}
+
+type builder struct {
+	cfg        config.Config
+	opcodes    *opcodes.Registry
+	transforms *transform.Registry
+}
+
+// LoadConfig loads the configuration for the adapter into the builder.
+func (b *builder) LoadConfig(cfg config.Config) error {
+	switch cfg.Adapters.Replay.Pacing {
+	case "", config.ReplayPacingRealtime, config.ReplayPacingFast:
+	default:
+		return fmt.Errorf("unknown pacing %q", cfg.Adapters.Replay.Pacing)
+	}
+	b.cfg = cfg
+	return nil
+}
+
+// Build returns a new instance of the replay adapter
+func (b *builder) Build(
+	streamUp chan<- stream.Provider,
+	streamDown chan<- int,
+	logger *zap.Logger,
+) stream.Adapter {
+	return NewAdapter(
+		AdapterConfig{
+			ReplayConfig: b.cfg.Adapters.Replay,
+			StreamUp:     streamUp,
+			StreamDown:   streamDown,
+
+			OpcodeRegistry:    b.opcodes,
+			TransformRegistry: b.transforms,
+		},
+		logger,
+	)
+}
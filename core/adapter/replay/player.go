@@ -0,0 +1,436 @@
+package replay
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"go.uber.org/zap"
+)
+
+// Commands accepted by the Player
+const (
+	CommandStatus = "status"
+	CommandPause  = "pause"
+	CommandResume = "resume"
+	CommandSpeed  = "speed"
+	CommandSeek   = "seek"
+	CommandStep   = "step"
+)
+
+// Command controls the playback of the session.
+//   - pause and resume toggle playback.
+//   - speed sets the playback speed multiplier (only used for real time
+//     pacing).
+//   - seek skips to the first block at or after Timestamp (in milliseconds
+//     since the Unix epoch). Seeking backwards rebuilds the stream state from
+//     the nearest preceding InitZone block.
+//   - step pauses playback and plays the next Count blocks (defaults to 1).
+//   - status only reports the current position.
+type Command struct {
+	Command   string  `json:"command"`
+	Speed     float64 `json:"speed,omitempty"`
+	Timestamp int64   `json:"timestamp,omitempty"`
+	Count     int     `json:"count,omitempty"`
+}
+
+// Status reports the current position of the Player in the session.
+type Status struct {
+	Paused   bool    `json:"paused"`
+	Speed    float64 `json:"speed"`
+	Position int     `json:"position"`
+	// Timestamp is the time of the last replayed block in milliseconds
+	// since the Unix epoch.
+	Timestamp int64 `json:"timestamp"`
+	Finished  bool  `json:"finished"`
+}
+
+type playerRequest struct {
+	cmd      Command
+	respChan chan playerResponse
+}
+
+type playerResponse struct {
+	status Status
+	err    error
+}
+
+// ErrPlayerStopped is returned when a command is sent to a Player that
+// is not running.
+var ErrPlayerStopped = errors.New("player is not running")
+
+// Player reads payloads from a recorded session file and emits them in the
+// order that they were recorded. Playback can be controlled with commands
+// sent via SendCommand.
+type Player struct {
+	file     string
+	realtime bool
+	opcodes  *opcodes.Registry
+	logger   *zap.Logger
+
+	payloadsChan chan hook.Payload
+	resetChan    chan struct{}
+	requestChan  chan playerRequest
+
+	f  *os.File
+	sr *hook.SessionReader
+
+	next       *hook.SessionRecord
+	position   int
+	lastBlock  time.Time
+	finished   bool
+	paused     bool
+	speed      float64
+	steps      int
+	seekTarget time.Time
+
+	wallAnchor  time.Time
+	blockAnchor time.Time
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewPlayer creates a new Player for the session file. If realtime is true,
+// the Player paces the payloads according to the timestamps of the recorded
+// blocks. Otherwise, the payloads are emitted as fast as they are consumed.
+// The opcode registry is used to find the InitZone blocks when seeking.
+func NewPlayer(file string, realtime bool, opcodeRegistry *opcodes.Registry, logger *zap.Logger) *Player {
+	return &Player{
+		file:     file,
+		realtime: realtime,
+		opcodes:  opcodeRegistry,
+		logger:   logger.Named("player"),
+
+		payloadsChan: make(chan hook.Payload),
+		resetChan:    make(chan struct{}),
+		requestChan:  make(chan playerRequest),
+
+		speed: 1,
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for reading the session file. Once
+// the end of the session is reached, the Player idles until it is stopped
+// or until it is asked to seek backwards.
+func (p *Player) Serve() {
+	defer close(p.stopDone)
+	defer p.closeSession()
+	p.logger.Info("Running")
+
+	if err := p.openSession(); err != nil {
+		p.logger.Error("opening session file", zap.Error(err))
+		p.finished = true
+	}
+
+	for {
+		p.readNext()
+		p.checkSeekTarget()
+
+		var outChan chan hook.Payload
+		var timer *time.Timer
+		var timerChan <-chan time.Time
+		var payload hook.Payload
+		if p.next != nil && (!p.paused || p.steps > 0 || p.seeking()) {
+			payload = p.next.Payload
+			if wait := p.pacingDelay(); wait > 0 {
+				timer = time.NewTimer(wait)
+				timerChan = timer.C
+			} else {
+				outChan = p.payloadsChan
+			}
+		}
+
+		select {
+		case req := <-p.requestChan:
+			status, err := p.handleCommand(req.cmd)
+			req.respChan <- playerResponse{status: status, err: err}
+		case <-timerChan:
+		case outChan <- payload:
+			p.advance()
+		case <-p.stop:
+			p.logger.Info("Stopping...")
+			return
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (p *Player) Stop() {
+	close(p.stop)
+	<-p.stopDone
+}
+
+// PayloadsListener returns a channel on which consumers can listen for
+// payloads replayed from the session.
+func (p *Player) PayloadsListener() <-chan hook.Payload {
+	return p.payloadsChan
+}
+
+// ResetListener returns a channel on which consumers are notified that the
+// stream must be reset before any subsequent payloads are applied. The channel
+// is unbuffered, so a consumer that receives the payloads and the resets in a
+// single goroutine sees them in the order they were sent.
+func (p *Player) ResetListener() <-chan struct{} {
+	return p.resetChan
+}
+
+// SendCommand sends a command to the Player and returns the Player's status
+// after the command has been applied.
+func (p *Player) SendCommand(cmd Command) (Status, error) {
+	respChan := make(chan playerResponse, 1)
+	select {
+	case p.requestChan <- playerRequest{cmd: cmd, respChan: respChan}:
+	case <-p.stopDone:
+		return Status{}, ErrPlayerStopped
+	}
+	resp := <-respChan
+	return resp.status, resp.err
+}
+
+func (p *Player) openSession() error {
+	f, err := os.Open(p.file)
+	if err != nil {
+		return err
+	}
+	sr, err := hook.NewSessionReader(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	p.f = f
+	p.sr = sr
+	p.next = nil
+	p.position = 0
+	p.finished = false
+	return nil
+}
+
+func (p *Player) closeSession() {
+	if p.sr != nil {
+		p.sr.Close()
+		p.f.Close()
+		p.sr = nil
+		p.f = nil
+	}
+}
+
+func (p *Player) readNext() {
+	if p.next != nil || p.finished {
+		return
+	}
+	rec, err := p.sr.Next()
+	if err == io.EOF {
+		p.logger.Info("Finished replaying session", zap.String("file", p.file))
+		p.finished = true
+		p.seekTarget = time.Time{}
+		return
+	} else if err != nil {
+		p.logger.Error("reading session record", zap.Error(err))
+		p.finished = true
+		return
+	}
+	p.next = &rec
+}
+
+func (p *Player) seeking() bool {
+	return !p.seekTarget.IsZero()
+}
+
+// checkSeekTarget ends the current seek once the next block is at or after
+// the seek target
+func (p *Player) checkSeekTarget() {
+	if p.next == nil || !p.seeking() {
+		return
+	}
+	if t, ok := blockTime(p.next.Payload); ok && !t.Before(p.seekTarget) {
+		p.seekTarget = time.Time{}
+		p.resetPacing()
+	}
+}
+
+// pacingDelay returns how long to wait before emitting the next payload
+func (p *Player) pacingDelay() time.Duration {
+	t, ok := blockTime(p.next.Payload)
+	if !ok {
+		return 0
+	}
+	if !p.realtime || p.steps > 0 || p.seeking() {
+		return 0
+	}
+	if p.wallAnchor.IsZero() {
+		p.wallAnchor = time.Now()
+		p.blockAnchor = t
+		return 0
+	}
+	offset := time.Duration(float64(t.Sub(p.blockAnchor)) / p.speed)
+	return time.Until(p.wallAnchor.Add(offset))
+}
+
+func (p *Player) resetPacing() {
+	p.wallAnchor = time.Time{}
+	p.blockAnchor = time.Time{}
+}
+
+func (p *Player) advance() {
+	if t, ok := blockTime(p.next.Payload); ok {
+		p.lastBlock = t
+		if p.steps > 0 && !p.seeking() {
+			p.steps--
+		}
+	}
+	p.position++
+	p.next = nil
+}
+
+func (p *Player) status() Status {
+	var timestamp int64
+	if !p.lastBlock.IsZero() {
+		timestamp = p.lastBlock.UnixNano() / int64(time.Millisecond)
+	}
+	return Status{
+		Paused:    p.paused,
+		Speed:     p.speed,
+		Position:  p.position,
+		Timestamp: timestamp,
+		Finished:  p.finished,
+	}
+}
+
+func (p *Player) handleCommand(cmd Command) (Status, error) {
+	switch cmd.Command {
+	case CommandStatus:
+	case CommandPause:
+		p.paused = true
+	case CommandResume:
+		p.paused = false
+		p.steps = 0
+		p.resetPacing()
+	case CommandSpeed:
+		if cmd.Speed <= 0 {
+			return p.status(), fmt.Errorf("invalid speed %v: speed must be positive", cmd.Speed)
+		}
+		p.speed = cmd.Speed
+		p.resetPacing()
+	case CommandStep:
+		count := cmd.Count
+		if count <= 0 {
+			count = 1
+		}
+		p.paused = true
+		p.steps = count
+	case CommandSeek:
+		target := time.Unix(0, 0).Add(time.Duration(cmd.Timestamp) * time.Millisecond)
+		if err := p.seek(target); err != nil {
+			return p.status(), err
+		}
+	default:
+		return p.status(), fmt.Errorf("unknown command %q", cmd.Command)
+	}
+	return p.status(), nil
+}
+
+// seek moves the Player to the first block at or after the target time.
+// Seeking forward simply replays all of the blocks up to the target as fast
+// as possible. Seeking backwards requires the stream to be reset, so the
+// Player restarts from the last InitZone block before the target.
+func (p *Player) seek(target time.Time) error {
+	p.steps = 0
+	if p.lastBlock.IsZero() || !target.Before(p.lastBlock) {
+		p.seekTarget = target
+		return nil
+	}
+
+	start, err := p.findInitZone(target)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case p.resetChan <- struct{}{}:
+	case <-p.stop:
+		return ErrPlayerStopped
+	}
+
+	p.closeSession()
+	if err := p.openSession(); err != nil {
+		p.finished = true
+		return err
+	}
+	for i := 0; i < start; i++ {
+		if _, err := p.sr.Next(); err != nil {
+			p.finished = true
+			return err
+		}
+	}
+	p.position = start
+	p.lastBlock = time.Time{}
+	p.seekTarget = target
+	return nil
+}
+
+// findInitZone returns the index of the last InitZone record before the target
+// time. If there is no such record, it returns 0.
+func (p *Player) findInitZone(target time.Time) (int, error) {
+	f, err := os.Open(p.file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	sr, err := hook.NewSessionReader(f)
+	if err != nil {
+		return 0, err
+	}
+	defer sr.Close()
+
+	start := 0
+	for i := 0; ; i++ {
+		rec, err := sr.Next()
+		if err == io.EOF {
+			return start, nil
+		} else if err != nil {
+			return 0, err
+		}
+		t, ok := blockTime(rec.Payload)
+		if ok && !t.Before(target) {
+			return start, nil
+		}
+		if p.isInitZone(rec.Payload) {
+			start = i
+		}
+	}
+}
+
+// blockTime returns the timestamp of the block contained in the payload, if
+// the payload contains one.
+func blockTime(p hook.Payload) (time.Time, bool) {
+	if (p.Op != hook.OpRecv && p.Op != hook.OpSend) || len(p.Data) < 16 {
+		return time.Time{}, false
+	}
+	timestamp := binary.LittleEndian.Uint64(p.Data[8:16])
+	msecSinceEpoch := time.Duration(timestamp) * time.Millisecond
+	return time.Unix(0, 0).Add(msecSinceEpoch), true
+}
+
+// isInitZone returns whether the payload contains an InitZone block, according
+// to the opcodes that are currently in use.
+func (p *Player) isInitZone(payload hook.Payload) bool {
+	if payload.Op != hook.OpRecv || payload.Channel != 1 || len(payload.Data) < 20 {
+		return false
+	}
+	opcode := binary.LittleEndian.Uint16(payload.Data[18:20])
+	_, ok := p.opcodes.NewBlockData(opcodes.Zone, opcode, false).(*datatypes.InitZone)
+	return ok
+}
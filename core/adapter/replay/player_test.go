@@ -0,0 +1,317 @@
+package replay_test
+
+import (
+	"encoding/binary"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/adapter/replay"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+func ipcPayload(op byte, subjectID uint32, timestampMs uint64) hook.Payload {
+	return ipcPayloadWithOpcode(op, subjectID, timestampMs, 0x0001)
+}
+
+func ipcPayloadWithOpcode(op byte, subjectID uint32, timestampMs uint64, opcode uint16) hook.Payload {
+	data := make([]byte, 48)
+	binary.LittleEndian.PutUint32(data[0:4], subjectID)
+	binary.LittleEndian.PutUint32(data[4:8], subjectID)
+	binary.LittleEndian.PutUint64(data[8:16], timestampMs)
+	binary.LittleEndian.PutUint16(data[16:18], 0x14)
+	binary.LittleEndian.PutUint16(data[18:20], opcode)
+	return hook.Payload{Op: op, Channel: 1, Data: data}
+}
+
+func blockTimestamp(p hook.Payload) uint64 {
+	return binary.LittleEndian.Uint64(p.Data[8:16])
+}
+
+func writeSessionFile(dir string, streamID uint32, payloads ...hook.Payload) string {
+	f, err := os.CreateTemp(dir, "session-*.aethsess")
+	Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+	sw, err := hook.NewSessionWriter(f, streamID, true)
+	Expect(err).ToNot(HaveOccurred())
+	for _, p := range payloads {
+		Expect(sw.WriteRecord(hook.SessionRecord{Time: time.Now(), Payload: p})).To(Succeed())
+	}
+	Expect(sw.Close()).To(Succeed())
+	return f.Name()
+}
+
+var _ = Describe("Player", func() {
+	var (
+		player         *replay.Player
+		supervisor     *suture.Supervisor
+		logger         *zap.Logger
+		opcodeRegistry *opcodes.Registry
+
+		tmpDir   string
+		file     string
+		realtime bool
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+	)
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("playertest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"playertest://"}
+		var err error
+		logger, err = zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		tmpDir = GinkgoT().TempDir()
+		file = writeSessionFile(tmpDir, 1234,
+			ipcPayload(hook.OpRecv, 1, 1000),
+			hook.Payload{Op: hook.OpDebug, Channel: 9000, Data: []byte("hello")},
+			ipcPayload(hook.OpSend, 2, 1300),
+		)
+		realtime = false
+		opcodeRegistry = opcodes.NewRegistry()
+	})
+
+	JustBeforeEach(func() {
+		player = replay.NewPlayer(file, realtime, opcodeRegistry, logger)
+
+		supervisor = suture.New("test-player", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(player)
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It(`logs "Running" on startup`, func() {
+		Eventually(logBuf).Should(gbytes.Say("player.*Running"))
+	})
+
+	It(`logs "Stopping..." on shutdown`, func() {
+		supervisor.Stop()
+		Eventually(logBuf).Should(gbytes.Say("player.*Stopping..."))
+	})
+
+	It("emits all of the recorded payloads in order", func() {
+		var p hook.Payload
+		Eventually(player.PayloadsListener()).Should(Receive(&p))
+		Expect(p.Op).To(BeEquivalentTo(hook.OpRecv))
+		Eventually(player.PayloadsListener()).Should(Receive(&p))
+		Expect(p.Data).To(Equal([]byte("hello")))
+		Eventually(player.PayloadsListener()).Should(Receive(&p))
+		Expect(p.Op).To(BeEquivalentTo(hook.OpSend))
+
+		Eventually(logBuf).Should(gbytes.Say("Finished replaying session"))
+		Consistently(player.PayloadsListener()).ShouldNot(Receive())
+	})
+
+	Context("when pacing in real time", func() {
+		BeforeEach(func() {
+			realtime = true
+		})
+
+		It("waits between blocks according to the block timestamps", func() {
+			Eventually(player.PayloadsListener()).Should(Receive())
+			start := time.Now()
+			Eventually(player.PayloadsListener()).Should(Receive())
+			Eventually(player.PayloadsListener()).Should(Receive())
+			Expect(time.Since(start)).To(BeNumerically(">=", 250*time.Millisecond))
+		})
+	})
+
+	Describe("SendCommand", func() {
+		BeforeEach(func() {
+			file = writeSessionFile(tmpDir, 1234,
+				ipcPayload(hook.OpRecv, 1, 1000),
+				ipcPayloadWithOpcode(hook.OpRecv, 2, 2000, datatypes.InitZoneOpcode),
+				ipcPayload(hook.OpRecv, 3, 3000),
+				ipcPayload(hook.OpRecv, 4, 4000),
+			)
+		})
+
+		It("reports the current position with the status command", func() {
+			Eventually(player.PayloadsListener()).Should(Receive())
+			Eventually(player.PayloadsListener()).Should(Receive())
+			status, err := player.SendCommand(replay.Command{Command: replay.CommandStatus})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(replay.Status{
+				Speed:     1,
+				Position:  2,
+				Timestamp: 2000,
+			}))
+		})
+
+		It("pauses and resumes playback", func() {
+			status, err := player.SendCommand(replay.Command{Command: replay.CommandPause})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Paused).To(BeTrue())
+			Consistently(player.PayloadsListener()).ShouldNot(Receive())
+
+			status, err = player.SendCommand(replay.Command{Command: replay.CommandResume})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Paused).To(BeFalse())
+			Eventually(player.PayloadsListener()).Should(Receive())
+		})
+
+		It("steps through the given number of blocks while paused", func() {
+			_, err := player.SendCommand(replay.Command{Command: replay.CommandPause})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = player.SendCommand(replay.Command{Command: replay.CommandStep, Count: 2})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(player.PayloadsListener()).Should(Receive())
+			Eventually(player.PayloadsListener()).Should(Receive())
+			Consistently(player.PayloadsListener()).ShouldNot(Receive())
+
+			status, err := player.SendCommand(replay.Command{Command: replay.CommandStatus})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Paused).To(BeTrue())
+			Expect(status.Position).To(Equal(2))
+		})
+
+		It("sets the playback speed", func() {
+			status, err := player.SendCommand(replay.Command{Command: replay.CommandSpeed, Speed: 4})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Speed).To(Equal(4.0))
+
+			_, err = player.SendCommand(replay.Command{Command: replay.CommandSpeed, Speed: -1})
+			Expect(err).To(MatchError("invalid speed -1: speed must be positive"))
+		})
+
+		It("errors on unknown commands", func() {
+			_, err := player.SendCommand(replay.Command{Command: "rewind"})
+			Expect(err).To(MatchError(`unknown command "rewind"`))
+		})
+
+		Context("when pacing in real time", func() {
+			BeforeEach(func() {
+				realtime = true
+			})
+
+			It("seeks forward by replaying the skipped blocks immediately", func() {
+				var p hook.Payload
+				Eventually(player.PayloadsListener()).Should(Receive(&p))
+				Expect(blockTimestamp(p)).To(BeEquivalentTo(1000))
+
+				_, err := player.SendCommand(replay.Command{Command: replay.CommandSeek, Timestamp: 3000})
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(player.PayloadsListener(), 200*time.Millisecond).Should(Receive(&p))
+				Expect(blockTimestamp(p)).To(BeEquivalentTo(2000))
+				Eventually(player.PayloadsListener(), 200*time.Millisecond).Should(Receive(&p))
+				Expect(blockTimestamp(p)).To(BeEquivalentTo(3000))
+				Consistently(player.PayloadsListener(), 500*time.Millisecond).ShouldNot(Receive())
+			})
+		})
+
+		It("seeks backwards by resetting the stream and replaying from the nearest InitZone", func() {
+			for i := 0; i < 4; i++ {
+				Eventually(player.PayloadsListener()).Should(Receive())
+			}
+			Eventually(logBuf).Should(gbytes.Say("Finished replaying session"))
+
+			statusChan := make(chan replay.Status, 1)
+			go func() {
+				defer GinkgoRecover()
+				status, err := player.SendCommand(replay.Command{Command: replay.CommandSeek, Timestamp: 3500})
+				Expect(err).ToNot(HaveOccurred())
+				statusChan <- status
+			}()
+
+			Consistently(statusChan).ShouldNot(Receive())
+			Eventually(player.ResetListener()).Should(Receive())
+
+			var status replay.Status
+			Eventually(statusChan).Should(Receive(&status))
+			Expect(status.Position).To(Equal(1))
+			Expect(status.Finished).To(BeFalse())
+
+			var p hook.Payload
+			Eventually(player.PayloadsListener()).Should(Receive(&p))
+			Expect(blockTimestamp(p)).To(BeEquivalentTo(2000))
+			Eventually(player.PayloadsListener()).Should(Receive(&p))
+			Expect(blockTimestamp(p)).To(BeEquivalentTo(3000))
+			Eventually(player.PayloadsListener()).Should(Receive(&p))
+			Expect(blockTimestamp(p)).To(BeEquivalentTo(4000))
+		})
+
+		Context("when the InitZone opcode is overridden", func() {
+			BeforeEach(func() {
+				file = writeSessionFile(tmpDir, 1234,
+					ipcPayload(hook.OpRecv, 1, 1000),
+					ipcPayloadWithOpcode(hook.OpRecv, 2, 2000, datatypes.InitZoneOpcode),
+					ipcPayloadWithOpcode(hook.OpRecv, 3, 3000, 0x1234),
+					ipcPayload(hook.OpRecv, 4, 4000),
+				)
+				t, err := opcodes.NewTable([]opcodes.Mapping{
+					{Direction: opcodes.Ingress, Opcode: 0x1234, Datatype: "InitZone"},
+					{Direction: opcodes.Ingress, Opcode: datatypes.InitZoneOpcode, Datatype: opcodes.GenericDatatype},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				opcodeRegistry.SetTable(t)
+			})
+
+			It("seeks backwards to the nearest block with the overridden InitZone opcode", func() {
+				for i := 0; i < 4; i++ {
+					Eventually(player.PayloadsListener()).Should(Receive())
+				}
+				Eventually(logBuf).Should(gbytes.Say("Finished replaying session"))
+
+				statusChan := make(chan replay.Status, 1)
+				go func() {
+					defer GinkgoRecover()
+					status, err := player.SendCommand(replay.Command{Command: replay.CommandSeek, Timestamp: 3500})
+					Expect(err).ToNot(HaveOccurred())
+					statusChan <- status
+				}()
+
+				Eventually(player.ResetListener()).Should(Receive())
+
+				var status replay.Status
+				Eventually(statusChan).Should(Receive(&status))
+				Expect(status.Position).To(Equal(2))
+
+				var p hook.Payload
+				Eventually(player.PayloadsListener()).Should(Receive(&p))
+				Expect(blockTimestamp(p)).To(BeEquivalentTo(3000))
+			})
+		})
+	})
+
+	Context("when the session file does not exist", func() {
+		BeforeEach(func() {
+			file = filepath.Join(tmpDir, "does-not-exist")
+		})
+
+		It("logs an error", func() {
+			Eventually(logBuf).Should(gbytes.Say("opening session file"))
+			Consistently(player.PayloadsListener()).ShouldNot(Receive())
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+)
+
+// Stream provides the interface for a long running process responsible for
+// replaying a recorded session as a stream.
+type Stream interface {
+	suture.Service
+	stream.Provider
+	fmt.Stringer
+}
+
+type replayStream struct {
+	streamID uint32
+	file     string
+	*suture.Supervisor
+
+	player    *Player
+	ipcReader *hook.IPCReader
+}
+
+// NewStream creates a new replay Stream for the session file. The stream ID
+// is read from the header of the session file.
+func NewStream(file string, cfg AdapterConfig, logger *zap.Logger) (Stream, error) {
+	streamID, err := readStreamID(file)
+	if err != nil {
+		return nil, err
+	}
+
+	streamName := fmt.Sprintf("stream-%d", streamID)
+	streamLogger := logger.Named(streamName)
+	supervisorLogger := streamLogger.Named("supervisor")
+
+	s := &replayStream{
+		streamID: streamID,
+		file:     file,
+		Supervisor: suture.New(streamName, suture.Spec{
+			Log: func(line string) {
+				supervisorLogger.Info(line)
+			},
+		}),
+	}
+
+	realtime := cfg.ReplayConfig.Pacing != config.ReplayPacingFast
+
+	p := NewPlayer(file, realtime, cfg.OpcodeRegistry, streamLogger)
+	ir := hook.NewIPCReader(
+		streamID,
+		p.PayloadsListener(),
+		p.ResetListener(),
+		cfg.OpcodeRegistry,
+		cfg.TransformRegistry,
+		streamLogger,
+	)
+
+	s.Add(p)
+	s.Add(ir)
+
+	s.player = p
+	s.ipcReader = ir
+
+	return s, nil
+}
+
+func readStreamID(file string) (uint32, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	sr, err := hook.NewSessionReader(f)
+	if err != nil {
+		return 0, err
+	}
+	defer sr.Close()
+	return sr.Header.StreamID, nil
+}
+
+// StreamID returns this stream's ID
+func (s *replayStream) StreamID() int {
+	return int(s.streamID)
+}
+
+// SourceKey returns the session file being replayed
+func (s *replayStream) SourceKey() string {
+	return s.file
+}
+
+// SubscribeIngress provides parsed ingress frames replayed from the session
+func (s *replayStream) SubscribeIngress() <-chan *xivnet.Block {
+	return s.ipcReader.SubscribeIngress()
+}
+
+// SubscribeEgress provides parsed egress frames replayed from the session
+func (s *replayStream) SubscribeEgress() <-chan *xivnet.Block {
+	return s.ipcReader.SubscribeEgress()
+}
+
+// SendRequest controls the playback of the session. The request must be a
+// JSON-encoded Command, and the response is the JSON-encoded Status of the
+// player after the command is applied.
+func (s *replayStream) SendRequest(req []byte) ([]byte, error) {
+	var cmd Command
+	err := json.Unmarshal(req, &cmd)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal data to command: %s", err)
+	}
+	status, err := s.player.SendCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(status)
+}
@@ -0,0 +1,54 @@
+package simulator
+
+import (
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/stream"
+)
+
+// Adapter defines the implementation of the simulator Adapter
+type Adapter struct {
+	*suture.Supervisor
+}
+
+// AdapterConfig provides commonly accessed configuration for the simulator
+// to the services that make up the simulator Adapter
+type AdapterConfig struct {
+	SimulatorConfig config.SimulatorConfig
+
+	StreamUp   chan<- stream.Provider
+	StreamDown chan<- int
+}
+
+// NewAdapter creates a new instance of the simulator Adapter
+func NewAdapter(cfg AdapterConfig, logger *zap.Logger) *Adapter {
+	simLogger := logger.Named("simulator-adapter")
+	supervisorLogger := simLogger.Named("supervisor")
+	a := &Adapter{
+		Supervisor: suture.New("simulator-adapter", suture.Spec{
+			Log: func(line string) {
+				supervisorLogger.Info(line)
+			},
+		}),
+	}
+
+	streamSupervisorLogger := simLogger.Named("stream-supervisor")
+	streamSupervisor := suture.New("stream-supervisor", suture.Spec{
+		Log: func(line string) {
+			streamSupervisorLogger.Info(line)
+		},
+	})
+
+	streamBuilder := func(file string) (Stream, error) {
+		return NewStream(file, simLogger)
+	}
+
+	manager := NewManager(cfg, streamBuilder, streamSupervisor, simLogger)
+
+	a.Add(streamSupervisor)
+	a.Add(manager)
+
+	return a
+}
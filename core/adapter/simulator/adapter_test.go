@@ -0,0 +1,102 @@
+package simulator_test
+
+import (
+	"path/filepath"
+
+	"github.com/ff14wed/aetherometer/core/adapter/simulator"
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Adapter", func() {
+	var (
+		adapter    *simulator.Adapter
+		supervisor *suture.Supervisor
+
+		streamUp chan stream.Provider
+		files    []string
+	)
+
+	BeforeEach(func() {
+		tmpDir := GinkgoT().TempDir()
+		files = []string{
+			filepath.Join(tmpDir, "does-not-exist.toml"),
+			writeScenario(tmpDir, arenaScenario),
+		}
+		streamUp = make(chan stream.Provider, 10)
+	})
+
+	JustBeforeEach(func() {
+		adapter = simulator.NewAdapter(simulator.AdapterConfig{
+			SimulatorConfig: config.SimulatorConfig{
+				Enabled:   true,
+				Scenarios: files,
+			},
+			StreamUp:   streamUp,
+			StreamDown: make(chan int, 10),
+		}, zap.NewNop())
+
+		supervisor = suture.New("test-adapter", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(adapter)
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It("creates a stream for each valid scenario file", func() {
+		var s stream.Provider
+		Eventually(streamUp).Should(Receive(&s))
+		Expect(s.StreamID()).To(Equal(1234))
+		Expect(s.(stream.SourceKeyer).SourceKey()).To(Equal(files[1]))
+		Consistently(streamUp).ShouldNot(Receive())
+	})
+
+	It("simulates the scenario on the stream", func() {
+		var s stream.Provider
+		Eventually(streamUp).Should(Receive(&s))
+
+		var b *xivnet.Block
+		Eventually(s.SubscribeIngress()).Should(Receive(&b))
+		Expect(b.Data).To(BeAssignableToTypeOf(&datatypes.InitZone{}))
+		Eventually(s.SubscribeIngress()).Should(Receive(&b))
+		Expect(b.Data).To(BeAssignableToTypeOf(&datatypes.NPCSpawn{}))
+
+		Eventually(func() xivnet.BlockData {
+			Eventually(s.SubscribeIngress()).Should(Receive(&b))
+			return b.Data
+		}).Should(BeAssignableToTypeOf(&datatypes.Movement{}))
+	})
+
+	It("does not support requests", func() {
+		var s stream.Provider
+		Eventually(streamUp).Should(Receive(&s))
+		_, err := s.SendRequest([]byte("foo"))
+		Expect(err).To(MatchError("requests are not supported by the simulator"))
+	})
+})
+
+var _ = Describe("GetInfo", func() {
+	It("validates the configured scenarios", func() {
+		info := simulator.GetInfo()
+		Expect(info.Name).To(Equal("Simulator"))
+		cfg := config.Config{}
+		cfg.Adapters.Simulator.Scenarios = []string{writeScenario(GinkgoT().TempDir(), "[[events]]\nactor = \"A\"")}
+		Expect(info.Builder.LoadConfig(cfg)).To(MatchError(ContainSubstring(`unknown actor "A"`)))
+		cfg.Adapters.Simulator.Scenarios = []string{writeScenario(GinkgoT().TempDir(), arenaScenario)}
+		Expect(info.Builder.LoadConfig(cfg)).To(Succeed())
+	})
+})
@@ -0,0 +1,47 @@
+package simulator
+
+import (
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"go.uber.org/zap"
+)
+
+// GetInfo returns the adapter's name and a builder for the adapter.
+func GetInfo() stream.AdapterInfo {
+	return stream.AdapterInfo{
+		Name:    "Simulator",
+		Builder: &builder{},
+	}
+}
+
+type builder struct {
+	cfg config.Config
+}
+
+// LoadConfig loads the configuration for the adapter into the builder. Each
+// of the scenario files is loaded to ensure that it is valid.
+func (b *builder) LoadConfig(cfg config.Config) error {
+	for _, file := range cfg.Adapters.Simulator.Scenarios {
+		if _, err := LoadScenario(file); err != nil {
+			return err
+		}
+	}
+	b.cfg = cfg
+	return nil
+}
+
+// Build returns a new instance of the simulator adapter
+func (b *builder) Build(
+	streamUp chan<- stream.Provider,
+	streamDown chan<- int,
+	logger *zap.Logger,
+) stream.Adapter {
+	return NewAdapter(
+		AdapterConfig{
+			SimulatorConfig: b.cfg.Adapters.Simulator,
+			StreamUp:        streamUp,
+			StreamDown:      streamDown,
+		},
+		logger,
+	)
+}
@@ -0,0 +1,74 @@
+package simulator
+
+import (
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+)
+
+// Manager is responsible for starting up a simulator stream for each of the
+// configured scenario files and notifying the StreamUp channel once the
+// stream is created.
+type Manager struct {
+	cfg AdapterConfig
+
+	streamBuilder    func(file string) (Stream, error)
+	streamSupervisor *suture.Supervisor
+
+	logger *zap.Logger
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewManager creates a new simulator Stream Manager
+func NewManager(
+	cfg AdapterConfig,
+	streamBuilder func(file string) (Stream, error),
+	streamSupervisor *suture.Supervisor,
+	logger *zap.Logger,
+) *Manager {
+	return &Manager{
+		cfg: cfg,
+
+		streamBuilder:    streamBuilder,
+		streamSupervisor: streamSupervisor,
+
+		logger: logger.Named("simulator-manager"),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for starting the simulator streams.
+func (m *Manager) Serve() {
+	defer close(m.stopDone)
+
+	m.logger.Info("Running")
+	for _, file := range m.cfg.SimulatorConfig.Scenarios {
+		s, err := m.streamBuilder(file)
+		if err != nil {
+			m.logger.Error("Failed to create simulator stream",
+				zap.String("file", file),
+				zap.Error(err),
+			)
+			continue
+		}
+		m.streamSupervisor.Add(s)
+		select {
+		case m.cfg.StreamUp <- s:
+		case <-m.stop:
+			m.logger.Info("Stopping...")
+			return
+		}
+	}
+
+	<-m.stop
+	m.logger.Info("Stopping...")
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (m *Manager) Stop() {
+	close(m.stop)
+	<-m.stopDone
+}
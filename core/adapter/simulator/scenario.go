@@ -0,0 +1,260 @@
+package simulator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/ff14wed/aetherometer/core/config"
+)
+
+// Actor kinds
+const (
+	KindPlayer = "player"
+	KindNPC    = "npc"
+)
+
+// Movement types
+const (
+	MovementStatic = "static"
+	MovementCircle = "circle"
+)
+
+// Event types
+const (
+	EventCast     = "cast"
+	EventAction   = "action"
+	EventStatuses = "statuses"
+	EventHateList = "hatelist"
+	EventChat     = "chat"
+)
+
+// Scenario describes the game traffic to simulate for a single stream. It is
+// loaded from a TOML file, for example:
+//
+//	territory_id = 777
+//	character_id = 0x10000001
+//
+//	[[actors]]
+//	name = "Boss"
+//	id = 0x40000001
+//	kind = "npc"
+//	enemy = true
+//	max_hp = 10000000
+//	x = 100.0
+//	z = 100.0
+//
+//	[[actors]]
+//	name = "Player"
+//	id = 0x10000001
+//	count = 8
+//	[actors.movement]
+//	type = "circle"
+//	center_x = 100.0
+//	center_z = 100.0
+//	radius = 20.0
+//	period = "30s"
+//
+//	[[events]]
+//	actor = "Boss"
+//	type = "cast"
+//	every = "30s"
+//	action_id = 1234
+//	cast_time = "5s"
+//	target = "Player 1"
+type Scenario struct {
+	// Name is the display name of the scenario.
+	Name string `toml:"name"`
+
+	// StreamID sets the ID of the simulated stream. Defaults to an ID derived
+	// from the scenario file path.
+	StreamID uint32 `toml:"stream_id,omitzero"`
+
+	// TerritoryID is the territory that the character is placed in.
+	TerritoryID uint16 `toml:"territory_id"`
+	// ServerID is the ID of the server handling the simulated zone.
+	ServerID uint16 `toml:"server_id,omitzero"`
+	// CharacterID is the entity ID of the character receiving the traffic.
+	// Defaults to 0x10000001.
+	CharacterID uint32 `toml:"character_id,omitzero"`
+
+	// Tick controls the interval between simulation steps. Movement is
+	// updated on every tick. Defaults to 100 milliseconds.
+	Tick config.Duration `toml:"tick,omitzero"`
+
+	Actors []Actor `toml:"actors"`
+	Events []Event `toml:"events"`
+}
+
+// Actor describes an entity in the simulated zone.
+type Actor struct {
+	// Name is the name of the actor. If Count is greater than 1, the actors
+	// are named "<Name> 1" through "<Name> <Count>".
+	Name string `toml:"name"`
+	// ID is the entity ID of the actor. If Count is greater than 1, each
+	// subsequent actor receives the next ID.
+	ID uint32 `toml:"id"`
+	// Count is the number of identical actors to spawn. Defaults to 1.
+	Count int `toml:"count,omitzero"`
+
+	// Kind is either "player" (the default) or "npc".
+	Kind string `toml:"kind,omitempty"`
+	// Enemy marks the actor as hostile.
+	Enemy    bool   `toml:"enemy,omitempty"`
+	BNPCName uint32 `toml:"bnpc_name,omitzero"`
+	ClassJob byte   `toml:"class_job,omitzero"`
+	Level    byte   `toml:"level,omitzero"`
+	MaxHP    uint32 `toml:"max_hp,omitzero"`
+	MaxMP    uint16 `toml:"max_mp,omitzero"`
+
+	X float32 `toml:"x"`
+	Y float32 `toml:"y"`
+	Z float32 `toml:"z"`
+
+	Movement Movement `toml:"movement"`
+}
+
+// Movement describes how an actor moves around the zone.
+type Movement struct {
+	// Type is either "static" (the default) or "circle".
+	Type string `toml:"type,omitempty"`
+
+	// CenterX and CenterZ set the center of the circle for circular movement.
+	// If there is more than one actor, the actors are spaced evenly around
+	// the circle.
+	CenterX float32 `toml:"center_x"`
+	CenterZ float32 `toml:"center_z"`
+	Radius  float32 `toml:"radius"`
+	// Period is how long it takes to complete one circle.
+	Period config.Duration `toml:"period"`
+}
+
+// Event describes something an actor does during the scenario.
+type Event struct {
+	// Actor is the name of the actor performing the event. If it refers to a
+	// group of actors, every actor in the group performs the event.
+	Actor string `toml:"actor"`
+	// Type is one of "cast", "action", "statuses", "hatelist", or "chat".
+	Type string `toml:"type"`
+
+	// Start is the offset from the start of the scenario at which the event
+	// first occurs.
+	Start config.Duration `toml:"start,omitzero"`
+	// Every causes the event to repeat at this interval. If it is not set,
+	// the event only occurs once.
+	Every config.Duration `toml:"every,omitzero"`
+
+	// ActionID is the action used in cast and action events.
+	ActionID uint32 `toml:"action_id,omitzero"`
+	// CastTime is the cast time of cast events. Once the cast completes, the
+	// action is used.
+	CastTime config.Duration `toml:"cast_time,omitzero"`
+	// Target is the name of the target of the cast or action.
+	Target string `toml:"target,omitempty"`
+	// Damage is the damage dealt to the target by the action.
+	Damage uint16 `toml:"damage,omitzero"`
+
+	// StatusIDs lists the statuses applied to the actor in statuses events.
+	StatusIDs []uint16 `toml:"status_ids"`
+	// Duration is the duration of the applied statuses.
+	Duration config.Duration `toml:"duration,omitzero"`
+
+	// Targets lists the names of the enemies in hatelist events, ordered
+	// from highest to lowest enmity.
+	Targets []string `toml:"targets"`
+
+	// Message is the message sent in chat events.
+	Message string `toml:"message,omitempty"`
+}
+
+// LoadScenario reads a scenario from the TOML file and validates it.
+func LoadScenario(file string) (*Scenario, error) {
+	s := new(Scenario)
+	if _, err := toml.DecodeFile(file, s); err != nil {
+		return nil, fmt.Errorf("error reading scenario %s: %s", file, err)
+	}
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %s", file, err)
+	}
+	return s, nil
+}
+
+func (s *Scenario) validate() error {
+	names := make(map[string]struct{})
+	for i, a := range s.Actors {
+		if a.Name == "" {
+			return fmt.Errorf("actors[%d]: name must be provided", i)
+		}
+		if a.ID == 0 {
+			return fmt.Errorf("actor %q: id must be provided", a.Name)
+		}
+		switch a.Kind {
+		case "", KindPlayer, KindNPC:
+		default:
+			return fmt.Errorf("actor %q: unknown kind %q", a.Name, a.Kind)
+		}
+		switch a.Movement.Type {
+		case "", MovementStatic:
+		case MovementCircle:
+			if a.Movement.Period <= 0 {
+				return fmt.Errorf("actor %q: movement period must be provided", a.Name)
+			}
+		default:
+			return fmt.Errorf("actor %q: unknown movement type %q", a.Name, a.Movement.Type)
+		}
+		for _, name := range actorNames(a) {
+			names[name] = struct{}{}
+		}
+		names[a.Name] = struct{}{}
+	}
+
+	for i, e := range s.Events {
+		if _, ok := names[e.Actor]; !ok {
+			return fmt.Errorf("events[%d]: unknown actor %q", i, e.Actor)
+		}
+		switch e.Type {
+		case EventCast, EventAction:
+			if e.Target != "" {
+				if _, ok := names[e.Target]; !ok {
+					return fmt.Errorf("events[%d]: unknown target %q", i, e.Target)
+				}
+			}
+		case EventHateList:
+			for _, t := range e.Targets {
+				if _, ok := names[t]; !ok {
+					return fmt.Errorf("events[%d]: unknown target %q", i, t)
+				}
+			}
+		case EventStatuses, EventChat:
+		default:
+			return fmt.Errorf("events[%d]: unknown event type %q", i, e.Type)
+		}
+	}
+	return nil
+}
+
+func (s *Scenario) tick() time.Duration {
+	if s.Tick > 0 {
+		return time.Duration(s.Tick)
+	}
+	return 100 * time.Millisecond
+}
+
+func (s *Scenario) characterID() uint32 {
+	if s.CharacterID != 0 {
+		return s.CharacterID
+	}
+	return 0x10000001
+}
+
+// actorNames returns the names of each of the actors described by a
+func actorNames(a Actor) []string {
+	if a.Count <= 1 {
+		return []string{a.Name}
+	}
+	names := make([]string, a.Count)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s %d", a.Name, i+1)
+	}
+	return names
+}
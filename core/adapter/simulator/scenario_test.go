@@ -0,0 +1,64 @@
+package simulator_test
+
+import (
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/simulator"
+	"github.com/ff14wed/aetherometer/core/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadScenario", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	It("loads a scenario from a TOML file", func() {
+		s, err := simulator.LoadScenario(writeScenario(tmpDir, arenaScenario))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Name).To(Equal("Arena"))
+		Expect(s.StreamID).To(BeEquivalentTo(1234))
+		Expect(s.TerritoryID).To(BeEquivalentTo(777))
+		Expect(s.Actors).To(HaveLen(2))
+		Expect(s.Actors[1].Count).To(Equal(4))
+		Expect(s.Actors[1].Movement.Period).To(Equal(config.Duration(40 * time.Second)))
+		Expect(s.Events).To(HaveLen(4))
+		Expect(s.Events[0].CastTime).To(Equal(config.Duration(5 * time.Second)))
+	})
+
+	It("errors when the file does not exist", func() {
+		_, err := simulator.LoadScenario("does-not-exist.toml")
+		Expect(err).To(MatchError(ContainSubstring("error reading scenario")))
+	})
+
+	DescribeTable("rejects invalid scenarios",
+		func(contents, errString string) {
+			_, err := simulator.LoadScenario(writeScenario(tmpDir, contents))
+			Expect(err).To(MatchError(ContainSubstring(errString)))
+		},
+		Entry("missing actor name", "[[actors]]\nid = 1", "actors[0]: name must be provided"),
+		Entry("missing actor ID", "[[actors]]\nname = \"A\"", `actor "A": id must be provided`),
+		Entry("unknown kind", "[[actors]]\nname = \"A\"\nid = 1\nkind = \"pet\"", `unknown kind "pet"`),
+		Entry("unknown movement",
+			"[[actors]]\nname = \"A\"\nid = 1\n[actors.movement]\ntype = \"zigzag\"",
+			`unknown movement type "zigzag"`,
+		),
+		Entry("missing period",
+			"[[actors]]\nname = \"A\"\nid = 1\n[actors.movement]\ntype = \"circle\"",
+			"movement period must be provided",
+		),
+		Entry("unknown event actor", "[[events]]\nactor = \"B\"\ntype = \"chat\"", `unknown actor "B"`),
+		Entry("unknown event type",
+			"[[actors]]\nname = \"A\"\nid = 1\n[[events]]\nactor = \"A\"\ntype = \"dance\"",
+			`unknown event type "dance"`,
+		),
+		Entry("unknown target",
+			"[[actors]]\nname = \"A\"\nid = 1\n[[events]]\nactor = \"A\"\ntype = \"cast\"\ntarget = \"B\"",
+			`unknown target "B"`,
+		),
+	)
+})
@@ -0,0 +1,353 @@
+package simulator
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+)
+
+type simActor struct {
+	Actor
+	id    uint32
+	name  string
+	phase float64
+
+	hp uint32
+	x  float32
+	z  float32
+}
+
+type scheduledEvent struct {
+	Event
+	next time.Duration
+	done bool
+}
+
+type pendingAction struct {
+	at       time.Duration
+	actorID  uint32
+	targetID uint32
+	event    Event
+}
+
+// Simulation generates the blocks for a scenario. It is not safe for
+// concurrent use.
+type Simulation struct {
+	scenario *Scenario
+
+	actors []*simActor
+	ids    map[string][]uint32
+	events []*scheduledEvent
+
+	pending       []pendingAction
+	globalCounter uint32
+
+	start time.Time
+}
+
+// NewSimulation creates a new Simulation for the scenario.
+func NewSimulation(scenario *Scenario) *Simulation {
+	s := &Simulation{
+		scenario: scenario,
+		ids:      make(map[string][]uint32),
+	}
+	for _, a := range scenario.Actors {
+		names := actorNames(a)
+		for i, name := range names {
+			sa := &simActor{
+				Actor: a,
+				id:    a.ID + uint32(i),
+				name:  name,
+				phase: 2 * math.Pi * float64(i) / float64(len(names)),
+				hp:    a.MaxHP,
+				x:     a.X,
+				z:     a.Z,
+			}
+			s.actors = append(s.actors, sa)
+			s.ids[name] = append(s.ids[name], sa.id)
+			if name != a.Name {
+				s.ids[a.Name] = append(s.ids[a.Name], sa.id)
+			}
+		}
+	}
+	for _, e := range scenario.Events {
+		s.events = append(s.events, &scheduledEvent{
+			Event: e,
+			next:  time.Duration(e.Start),
+		})
+	}
+	return s
+}
+
+// Start begins the scenario at the given time and returns the blocks that
+// set up the zone and spawn all of the actors.
+func (s *Simulation) Start(now time.Time) []*xivnet.Block {
+	s.start = now
+	blocks := []*xivnet.Block{
+		s.block(s.scenario.characterID(), now, &datatypes.InitZone{
+			TerritoryTypeID: s.scenario.TerritoryID,
+		}),
+	}
+	for i, a := range s.actors {
+		s.move(a, 0)
+		blocks = append(blocks, s.block(a.id, now, s.spawn(a, i)))
+	}
+	return blocks
+}
+
+// Advance moves the scenario forward to the given time and returns the blocks
+// generated since the last time the simulation was advanced.
+func (s *Simulation) Advance(now time.Time) []*xivnet.Block {
+	elapsed := now.Sub(s.start)
+	var blocks []*xivnet.Block
+
+	for _, a := range s.actors {
+		if a.Movement.Type != MovementCircle {
+			continue
+		}
+		s.move(a, elapsed)
+		blocks = append(blocks, s.block(a.id, now, s.movement(a, elapsed)))
+	}
+
+	for _, e := range s.events {
+		for !e.done && e.next <= elapsed {
+			blocks = append(blocks, s.fire(e.Event, now, e.next)...)
+			if e.Every > 0 {
+				e.next += time.Duration(e.Every)
+			} else {
+				e.done = true
+			}
+		}
+	}
+
+	var remaining []pendingAction
+	for _, p := range s.pending {
+		if p.at > elapsed {
+			remaining = append(remaining, p)
+			continue
+		}
+		blocks = append(blocks, s.action(p.actorID, p.targetID, p.event, now))
+	}
+	s.pending = remaining
+
+	return blocks
+}
+
+func (s *Simulation) block(subjectID uint32, t time.Time, data xivnet.BlockData) *xivnet.Block {
+	return &xivnet.Block{
+		Length:    uint32(32 + binary.Size(data)),
+		SubjectID: subjectID,
+		CurrentID: s.scenario.characterID(),
+		Type:      xivnet.BlockTypeIPC,
+		IPCHeader: xivnet.IPCHeader{
+			Reserved: 0x14,
+			ServerID: s.scenario.ServerID,
+			Time:     t,
+		},
+		Data: data,
+	}
+}
+
+func (s *Simulation) actor(id uint32) *simActor {
+	for _, a := range s.actors {
+		if a.id == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// move updates the position of the actor after the elapsed time
+func (s *Simulation) move(a *simActor, elapsed time.Duration) {
+	if a.Movement.Type != MovementCircle {
+		return
+	}
+	angle := s.angle(a, elapsed)
+	a.x = a.Movement.CenterX + a.Movement.Radius*float32(math.Sin(angle))
+	a.z = a.Movement.CenterZ + a.Movement.Radius*float32(math.Cos(angle))
+}
+
+func (s *Simulation) angle(a *simActor, elapsed time.Duration) float64 {
+	period := float64(a.Movement.Period)
+	return a.phase + 2*math.Pi*math.Mod(float64(elapsed), period)/period
+}
+
+// heading returns the direction the actor is facing as a fraction of a
+// full turn
+func (s *Simulation) heading(a *simActor, elapsed time.Duration) float64 {
+	if a.Movement.Type != MovementCircle {
+		return 0
+	}
+	// The actor faces along the tangent of the circle
+	h := (s.angle(a, elapsed) + math.Pi/2) / (2 * math.Pi)
+	return h - math.Floor(h)
+}
+
+func (s *Simulation) spawn(a *simActor, index int) xivnet.BlockData {
+	spawn := datatypes.PlayerSpawn{
+		BNPCName:  a.BNPCName,
+		MaxHP:     a.MaxHP,
+		CurrentHP: a.hp,
+		CurrentMP: a.MaxMP,
+		MaxMP:     a.MaxMP,
+		Direction: uint16(s.heading(a, 0) * 0x10000),
+		Index:     byte(index),
+		Level:     a.Level,
+		ClassJob:  a.ClassJob,
+		X:         a.x,
+		Y:         a.Y,
+		Z:         a.z,
+		Name:      datatypes.StringToEntityName(a.name),
+	}
+	if a.Enemy {
+		spawn.EnemyType = 1
+	}
+	if a.Kind == KindNPC {
+		spawn.Type = 2
+		spawn.Subtype = 5
+		return &datatypes.NPCSpawn{PlayerSpawn: spawn}
+	}
+	spawn.Type = 1
+	spawn.Subtype = 4
+	return &spawn
+}
+
+func (s *Simulation) movement(a *simActor, elapsed time.Duration) *datatypes.Movement {
+	m := &datatypes.Movement{
+		Direction: uint8(s.heading(a, elapsed) * 0x100),
+	}
+	m.Position.X.SetFloat(a.x)
+	m.Position.Y.SetFloat(a.Y)
+	m.Position.Z.SetFloat(a.z)
+	return m
+}
+
+// fire generates the blocks for an occurrence of the event
+func (s *Simulation) fire(e Event, now time.Time, at time.Duration) []*xivnet.Block {
+	var blocks []*xivnet.Block
+	var targetID uint32
+	if ids := s.ids[e.Target]; len(ids) > 0 {
+		targetID = ids[0]
+	}
+	for _, id := range s.ids[e.Actor] {
+		switch e.Type {
+		case EventCast:
+			blocks = append(blocks, s.block(id, now, s.casting(id, targetID, e)))
+			s.pending = append(s.pending, pendingAction{
+				at:       at + time.Duration(e.CastTime),
+				actorID:  id,
+				targetID: targetID,
+				event:    e,
+			})
+		case EventAction:
+			blocks = append(blocks, s.action(id, targetID, e, now))
+		case EventStatuses:
+			blocks = append(blocks, s.block(id, now, s.statuses(id, e)))
+		case EventHateList:
+			blocks = append(blocks, s.block(id, now, s.hateList(e)))
+		case EventChat:
+			blocks = append(blocks, s.block(id, now, s.chat(id, e)))
+		}
+	}
+	return blocks
+}
+
+func (s *Simulation) casting(actorID, targetID uint32, e Event) *datatypes.Casting {
+	c := &datatypes.Casting{
+		ActionIDName: uint16(e.ActionID),
+		U1:           0x1,
+		ActionID:     e.ActionID,
+		CastTime:     float32(time.Duration(e.CastTime).Seconds()),
+		TargetID:     targetID,
+	}
+	if a := s.actor(actorID); a != nil {
+		c.Position.X.SetFloat(a.x)
+		c.Position.Y.SetFloat(a.Y)
+		c.Position.Z.SetFloat(a.z)
+	}
+	return c
+}
+
+func (s *Simulation) action(actorID, targetID uint32, e Event, now time.Time) *xivnet.Block {
+	s.globalCounter++
+	a := &datatypes.Action{
+		ActionHeader: datatypes.ActionHeader{
+			TargetID:      targetID,
+			ActionIDName:  e.ActionID,
+			GlobalCounter: s.globalCounter,
+			ActionID:      uint16(e.ActionID),
+		},
+		TargetID2: targetID,
+	}
+	if targetID != 0 && e.Damage > 0 {
+		a.NumAffected = 1
+		a.Effects[0] = datatypes.ActionEffect{Type: 3, Damage: e.Damage}
+		if t := s.actor(targetID); t != nil {
+			// Targets are fully healed instead of dying so that the scenario
+			// can repeat indefinitely
+			if uint32(e.Damage) >= t.hp {
+				t.hp = t.MaxHP
+			} else {
+				t.hp -= uint32(e.Damage)
+			}
+		}
+	}
+	return s.block(actorID, now, a)
+}
+
+func (s *Simulation) statuses(actorID uint32, e Event) *datatypes.UpdateStatuses {
+	u := &datatypes.UpdateStatuses{}
+	if a := s.actor(actorID); a != nil {
+		u.ClassJob = a.ClassJob
+		u.Level1 = a.Level
+		u.Level = uint16(a.Level)
+		u.CurrentHP = a.hp
+		u.MaxHP = a.MaxHP
+		u.CurrentMP = a.MaxMP
+		u.MaxMP = a.MaxMP
+	}
+	for i, id := range e.StatusIDs {
+		if i >= len(u.Statuses) {
+			break
+		}
+		u.Statuses[i] = datatypes.StatusEffect{
+			ID:       id,
+			Duration: float32(time.Duration(e.Duration).Seconds()),
+			ActorID:  actorID,
+		}
+	}
+	return u
+}
+
+func (s *Simulation) hateList(e Event) *datatypes.HateList {
+	h := &datatypes.HateList{}
+	for _, name := range e.Targets {
+		for _, id := range s.ids[name] {
+			if int(h.Count) >= len(h.Entries) {
+				break
+			}
+			h.Entries[h.Count] = datatypes.HateEntry{
+				EnemyID: id,
+				HatePct: byte(100 - 100*int(h.Count)/len(h.Entries)),
+			}
+			h.Count++
+		}
+	}
+	return h
+}
+
+func (s *Simulation) chat(actorID uint32, e Event) *datatypes.Chat {
+	c := &datatypes.Chat{
+		// Party chat on the local world
+		ChannelID:       0x01 << 32,
+		SpeakerEntityID: actorID,
+		Message:         datatypes.StringToChatMessage(e.Message),
+	}
+	if a := s.actor(actorID); a != nil {
+		c.SpeakerName = datatypes.StringToEntityName(a.name)
+	}
+	return c
+}
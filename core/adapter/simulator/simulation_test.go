@@ -0,0 +1,153 @@
+package simulator_test
+
+import (
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/simulator"
+	"github.com/ff14wed/aetherometer/core/datasheet"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/store"
+	"github.com/ff14wed/aetherometer/core/store/update"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func blocksOfType[T any](blocks []*xivnet.Block) []*xivnet.Block {
+	var matched []*xivnet.Block
+	for _, b := range blocks {
+		if _, ok := b.Data.(T); ok {
+			matched = append(matched, b)
+		}
+	}
+	return matched
+}
+
+var _ = Describe("Simulation", func() {
+	var (
+		sim   *simulator.Simulation
+		start time.Time
+	)
+
+	BeforeEach(func() {
+		scenario, err := simulator.LoadScenario(writeScenario(GinkgoT().TempDir(), arenaScenario))
+		Expect(err).ToNot(HaveOccurred())
+		sim = simulator.NewSimulation(scenario)
+		start = time.Unix(1600000000, 0)
+	})
+
+	It("sets up the zone and spawns the actors on start", func() {
+		blocks := sim.Start(start)
+		Expect(blocks).To(HaveLen(6))
+
+		Expect(blocks[0].Data).To(BeAssignableToTypeOf(&datatypes.InitZone{}))
+		Expect(blocks[0].Data.(*datatypes.InitZone).TerritoryTypeID).To(BeEquivalentTo(777))
+		Expect(blocks[0].CurrentID).To(BeEquivalentTo(0x10000001))
+
+		boss := blocks[1].Data.(*datatypes.NPCSpawn)
+		Expect(blocks[1].SubjectID).To(BeEquivalentTo(0x40000001))
+		Expect(boss.Name.String()).To(Equal("Boss"))
+		Expect(boss.BNPCName).To(BeEquivalentTo(5678))
+		Expect(boss.EnemyType).ToNot(BeZero())
+
+		players := blocksOfType[*datatypes.PlayerSpawn](blocks)
+		Expect(players).To(HaveLen(4))
+		for i, b := range players {
+			Expect(b.SubjectID).To(BeEquivalentTo(0x10000001 + i))
+			spawn := b.Data.(*datatypes.PlayerSpawn)
+			Expect(spawn.ClassJob).To(BeEquivalentTo(19))
+		}
+		p1 := players[0].Data.(*datatypes.PlayerSpawn)
+		p3 := players[2].Data.(*datatypes.PlayerSpawn)
+		Expect(p1.Name.String()).To(Equal("Player 1"))
+		Expect(p1.X).To(BeNumerically("~", 100, 0.01))
+		Expect(p1.Z).To(BeNumerically("~", 120, 0.01))
+		// The players are spaced evenly around the circle
+		Expect(p3.X).To(BeNumerically("~", 100, 0.01))
+		Expect(p3.Z).To(BeNumerically("~", 80, 0.01))
+	})
+
+	It("moves the actors around the circle", func() {
+		sim.Start(start)
+		blocks := blocksOfType[*datatypes.Movement](sim.Advance(start.Add(10 * time.Second)))
+		Expect(blocks).To(HaveLen(4))
+		m := blocks[0].Data.(*datatypes.Movement)
+		Expect(m.Position.X.Float()).To(BeNumerically("~", 120, 0.1))
+		Expect(m.Position.Z.Float()).To(BeNumerically("~", 100, 0.1))
+		Expect(blocks[0].Time).To(Equal(start.Add(10 * time.Second)))
+	})
+
+	It("fires the scripted events when they are due", func() {
+		sim.Start(start)
+
+		blocks := sim.Advance(start)
+		hateLists := blocksOfType[*datatypes.HateList](blocks)
+		Expect(hateLists).To(HaveLen(1))
+		hl := hateLists[0].Data.(*datatypes.HateList)
+		Expect(hl.Count).To(BeEquivalentTo(2))
+		Expect(hl.Entries[0].EnemyID).To(BeEquivalentTo(0x10000002))
+		Expect(hl.Entries[1].EnemyID).To(BeEquivalentTo(0x10000001))
+		Expect(blocksOfType[*datatypes.Casting](blocks)).To(BeEmpty())
+
+		blocks = sim.Advance(start.Add(1 * time.Second))
+		casts := blocksOfType[*datatypes.Casting](blocks)
+		Expect(casts).To(HaveLen(1))
+		Expect(casts[0].SubjectID).To(BeEquivalentTo(0x40000001))
+		cast := casts[0].Data.(*datatypes.Casting)
+		Expect(cast.ActionID).To(BeEquivalentTo(1234))
+		Expect(cast.CastTime).To(BeNumerically("==", 5))
+		Expect(cast.TargetID).To(BeEquivalentTo(0x10000001))
+
+		blocks = sim.Advance(start.Add(3 * time.Second))
+		Expect(blocksOfType[*datatypes.UpdateStatuses](blocks)).To(HaveLen(4))
+		chats := blocksOfType[*datatypes.Chat](blocks)
+		Expect(chats).To(HaveLen(1))
+		Expect(chats[0].Data.(*datatypes.Chat).Message.String()).To(Equal("hello"))
+		Expect(chats[0].Data.(*datatypes.Chat).SpeakerName.String()).To(Equal("Player 3"))
+
+		blocks = sim.Advance(start.Add(6 * time.Second))
+		actions := blocksOfType[*datatypes.Action](blocks)
+		Expect(actions).To(HaveLen(1))
+		action := actions[0].Data.(*datatypes.Action)
+		Expect(action.TargetID2).To(BeEquivalentTo(0x10000001))
+		Expect(action.Effects[0].Damage).To(BeEquivalentTo(5000))
+
+		Expect(blocksOfType[*datatypes.Casting](sim.Advance(start.Add(30 * time.Second)))).To(BeEmpty())
+		Expect(blocksOfType[*datatypes.Casting](sim.Advance(start.Add(31 * time.Second)))).To(HaveLen(1))
+	})
+
+	It("produces blocks that can be applied to the store", func() {
+		generator := update.NewGenerator(&datasheet.Collection{})
+		streams := &store.Streams{
+			Map:      map[int]*models.Stream{1234: {ID: 1234}},
+			KeyOrder: []int{1234},
+		}
+		apply := func(blocks []*xivnet.Block) {
+			for _, b := range blocks {
+				u := generator.Generate(1234, false, b)
+				if u == nil {
+					continue
+				}
+				_, _, err := u.ModifyStore(streams)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+
+		apply(sim.Start(start))
+		for i := 1; i <= 6; i++ {
+			apply(sim.Advance(start.Add(time.Duration(i) * time.Second)))
+		}
+
+		s := streams.Map[1234]
+		Expect(s.CharacterID).To(BeEquivalentTo(0x10000001))
+		Expect(s.Place.TerritoryID).To(Equal(777))
+		Expect(s.EntitiesMap).To(HaveLen(5))
+		boss := s.EntitiesMap[0x40000001]
+		Expect(boss.Name).To(Equal("Boss"))
+		Expect(boss.LastAction).ToNot(BeNil())
+		Expect(s.EntitiesMap[0x10000001].Statuses).To(HaveLen(2))
+		Expect(s.Enmity.NearbyEnemyHate).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,83 @@
+package simulator_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSimulator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Simulator Suite")
+}
+
+const arenaScenario = `
+name = "Arena"
+stream_id = 1234
+territory_id = 777
+character_id = 0x10000001
+tick = "10ms"
+
+[[actors]]
+name = "Boss"
+id = 0x40000001
+kind = "npc"
+enemy = true
+bnpc_name = 5678
+level = 90
+max_hp = 1000000
+x = 100.0
+z = 100.0
+
+[[actors]]
+name = "Player"
+id = 0x10000001
+count = 4
+class_job = 19
+level = 90
+max_hp = 100000
+[actors.movement]
+type = "circle"
+center_x = 100.0
+center_z = 100.0
+radius = 20.0
+period = "40s"
+
+[[events]]
+actor = "Boss"
+type = "cast"
+start = "1s"
+every = "30s"
+action_id = 1234
+cast_time = "5s"
+target = "Player 1"
+damage = 5000
+
+[[events]]
+actor = "Player"
+type = "statuses"
+start = "2s"
+status_ids = [1, 2]
+duration = "15s"
+
+[[events]]
+actor = "Boss"
+type = "hatelist"
+targets = ["Player 2", "Player 1"]
+
+[[events]]
+actor = "Player 3"
+type = "chat"
+start = "3s"
+message = "hello"
+`
+
+func writeScenario(dir, contents string) string {
+	file := filepath.Join(dir, "scenario.toml")
+	Expect(os.WriteFile(file, []byte(contents), 0644)).To(Succeed())
+	return file
+}
@@ -0,0 +1,142 @@
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+)
+
+// Stream provides the interface for a long running process responsible for
+// simulating a scenario as a stream.
+type Stream interface {
+	suture.Service
+	stream.Provider
+	fmt.Stringer
+}
+
+type simStream struct {
+	streamID uint32
+	file     string
+	name     string
+	scenario *Scenario
+
+	ingressChan chan *xivnet.Block
+	egressChan  chan *xivnet.Block
+
+	logger *zap.Logger
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewStream creates a new simulator Stream for the scenario file.
+func NewStream(file string, logger *zap.Logger) (Stream, error) {
+	scenario, err := LoadScenario(file)
+	if err != nil {
+		return nil, err
+	}
+
+	streamID := scenario.StreamID
+	if streamID == 0 {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(file))
+		streamID = h.Sum32() & 0x7FFFFFFF
+	}
+	streamName := fmt.Sprintf("stream-%d", streamID)
+
+	return &simStream{
+		streamID: streamID,
+		file:     file,
+		name:     streamName,
+		scenario: scenario,
+
+		ingressChan: make(chan *xivnet.Block, 100),
+		egressChan:  make(chan *xivnet.Block),
+
+		logger: logger.Named(streamName),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}, nil
+}
+
+// Serve runs the service responsible for simulating the scenario.
+func (s *simStream) Serve() {
+	defer close(s.stopDone)
+	s.logger.Info("Running", zap.String("scenario", s.scenario.Name))
+
+	sim := NewSimulation(s.scenario)
+	if !s.send(sim.Start(time.Now())) {
+		s.logger.Info("Stopping...")
+		return
+	}
+
+	ticker := time.NewTicker(s.scenario.tick())
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if !s.send(sim.Advance(now)) {
+				s.logger.Info("Stopping...")
+				return
+			}
+		case <-s.stop:
+			s.logger.Info("Stopping...")
+			return
+		}
+	}
+}
+
+// send returns false if the stream was stopped while sending the blocks
+func (s *simStream) send(blocks []*xivnet.Block) bool {
+	for _, b := range blocks {
+		select {
+		case s.ingressChan <- b:
+		case <-s.stop:
+			return false
+		}
+	}
+	return true
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (s *simStream) Stop() {
+	close(s.stop)
+	<-s.stopDone
+}
+
+func (s *simStream) String() string {
+	return s.name
+}
+
+// StreamID returns this stream's ID
+func (s *simStream) StreamID() int {
+	return int(s.streamID)
+}
+
+// SourceKey returns the scenario file being simulated
+func (s *simStream) SourceKey() string {
+	return s.file
+}
+
+// SubscribeIngress provides the simulated ingress frames
+func (s *simStream) SubscribeIngress() <-chan *xivnet.Block {
+	return s.ingressChan
+}
+
+// SubscribeEgress provides the simulated egress frames. The simulator does
+// not simulate any egress frames.
+func (s *simStream) SubscribeEgress() <-chan *xivnet.Block {
+	return s.egressChan
+}
+
+// SendRequest is not supported by the simulator
+func (s *simStream) SendRequest(req []byte) ([]byte, error) {
+	return nil, errors.New("requests are not supported by the simulator")
+}
@@ -0,0 +1,125 @@
+package socket
+
+import (
+	"io"
+	"time"
+
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/process"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
+)
+
+// Adapter defines the implementation of the socket Adapter
+type Adapter struct {
+	*suture.Supervisor
+
+	connectors []*Connector
+}
+
+// AdapterConfig provides commonly accessed configuration for the socket
+// adapter to the services that make up the socket Adapter
+type AdapterConfig struct {
+	SocketConfig config.SocketConfig
+
+	StreamUp   chan<- stream.Provider
+	StreamDown chan<- int
+
+	ProcessEnumerator process.Enumerator
+	OpcodeRegistry    *opcodes.Registry
+	TransformRegistry *transform.Registry
+}
+
+// NewAdapter creates a new instance of the socket Adapter
+func NewAdapter(cfg AdapterConfig, logger *zap.Logger) *Adapter {
+	socketLogger := logger.Named("socket-adapter")
+	supervisorLogger := socketLogger.Named("supervisor")
+	a := &Adapter{
+		Supervisor: suture.New("socket-adapter", suture.Spec{
+			Log: func(line string) {
+				supervisorLogger.Info(line)
+			},
+		}),
+	}
+
+	streamSupervisorLogger := socketLogger.Named("stream-supervisor")
+	streamSupervisor := suture.New("stream-supervisor", suture.Spec{
+		Log: func(line string) {
+			streamSupervisorLogger.Info(line)
+		},
+	})
+
+	hookCfg := config.HookConfig{
+		PingInterval:     cfg.SocketConfig.PingInterval,
+		HandshakeTimeout: cfg.SocketConfig.HandshakeTimeout,
+		MaxMissedPings:   cfg.SocketConfig.MaxMissedPings,
+	}
+	streamBuilder := func(streamID uint32, endpoint string, conn io.ReadWriteCloser) hook.Stream {
+		return hook.NewConnStream(streamID, endpoint, conn, hookCfg, cfg.OpcodeRegistry, cfg.TransformRegistry, socketLogger)
+	}
+
+	var processes *ProcessWatcher
+	if len(cfg.SocketConfig.ProcessRules) > 0 {
+		matcher, err := process.NewMatcher(cfg.SocketConfig.ProcessRules)
+		if err != nil {
+			socketLogger.Error("Invalid process rules", zap.Error(err))
+			matcher = new(process.Matcher)
+		}
+		scanTicker := time.NewTicker(1 * time.Second)
+		scanner := process.NewScanner(
+			matcher,
+			scanTicker.C,
+			cfg.ProcessEnumerator,
+			10,
+			socketLogger,
+		)
+		processes = NewProcessWatcher(
+			scanner.ProcessAddEventListener(),
+			scanner.ProcessRemoveEventListener(),
+			socketLogger,
+		)
+		a.Add(scanner)
+		a.Add(processes)
+	}
+
+	a.Add(streamSupervisor)
+	for _, endpoint := range cfg.SocketConfig.Endpoints {
+		c := NewConnector(cfg, endpoint, streamBuilder, streamSupervisor, processes, socketLogger)
+		a.Add(c)
+		a.connectors = append(a.connectors, c)
+	}
+
+	return a
+}
+
+// healthSeverity orders the health states of the connectors so that the
+// adapter reports the worst of them
+var healthSeverity = map[models.HealthState]int{
+	models.HealthStateConnected:    0,
+	models.HealthStateConnecting:   1,
+	models.HealthStateReconnecting: 2,
+	models.HealthStateClosed:       3,
+	models.HealthStateDegraded:     4,
+}
+
+// Health reports the worst health out of all of the endpoints, along with the
+// last error encountered by any of the endpoints.
+func (a *Adapter) Health() models.Health {
+	health := models.Health{State: models.HealthStateConnected}
+	for _, c := range a.connectors {
+		h := c.Health()
+		if healthSeverity[h.State] > healthSeverity[health.State] {
+			health.State = h.State
+		}
+		if h.LastError != nil {
+			health.LastError = h.LastError
+		}
+	}
+	return health
+}
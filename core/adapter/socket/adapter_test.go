@@ -0,0 +1,345 @@
+package socket_test
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/adapter/socket"
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/process/processfakes"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func ipcPayload(op byte, subjectID uint32) hook.Payload {
+	data := make([]byte, 48)
+	binary.LittleEndian.PutUint32(data[0:4], subjectID)
+	binary.LittleEndian.PutUint32(data[4:8], subjectID)
+	binary.LittleEndian.PutUint64(data[8:16], 1000)
+	binary.LittleEndian.PutUint16(data[16:18], 0x14)
+	binary.LittleEndian.PutUint16(data[18:20], 0x0001)
+	return hook.Payload{Op: op, Channel: 1, Data: data}
+}
+
+// sayHello plays the part of the hook in the handshake, reporting the
+// provided version in its hello message
+func sayHello(conn net.Conn, version string) {
+	_, err := conn.Write(hook.Payload{
+		Op:   hook.OpDebug,
+		Data: []byte("SERVER HELLO. VERSION: " + version + "."),
+	}.Encode())
+	Expect(err).ToNot(HaveOccurred())
+}
+
+// completeHandshake plays the part of the hook in the handshake, waiting for
+// the stream to request its options
+func completeHandshake(conn net.Conn) {
+	sayHello(conn, "0.9.3")
+
+	d := hook.NewDecoder(conn, 1024)
+	for {
+		p, err := d.NextPayload()
+		Expect(err).ToNot(HaveOccurred())
+		if p.Op == hook.OpOption {
+			break
+		}
+	}
+}
+
+// fakeServer accepts connections on the listener and hands them to the test
+func fakeServer(l net.Listener) <-chan net.Conn {
+	conns := make(chan net.Conn, 10)
+	go func() {
+		defer GinkgoRecover()
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conns <- conn
+		}
+	}()
+	return conns
+}
+
+var _ = Describe("Adapter", func() {
+	var (
+		adapter    *socket.Adapter
+		supervisor *suture.Supervisor
+
+		listener   net.Listener
+		serverConn <-chan net.Conn
+		endpoint   string
+
+		streamUp   chan stream.Provider
+		streamDown chan int
+	)
+
+	testAdapter := func() {
+		var acceptedConn net.Conn
+		var s stream.Provider
+
+		BeforeEach(func() {
+			streamUp = make(chan stream.Provider, 10)
+			streamDown = make(chan int, 10)
+		})
+
+		JustBeforeEach(func() {
+			serverConn = fakeServer(listener)
+
+			adapter = socket.NewAdapter(socket.AdapterConfig{
+				SocketConfig: config.SocketConfig{
+					Enabled:           true,
+					Endpoints:         []string{endpoint},
+					DialRetryInterval: config.Duration(10 * time.Millisecond),
+				},
+				StreamUp:   streamUp,
+				StreamDown: streamDown,
+
+				OpcodeRegistry:    opcodes.NewRegistry(),
+				TransformRegistry: transform.NewRegistry(),
+			}, zap.NewNop())
+
+			supervisor = suture.New("test-adapter", suture.Spec{
+				Log: func(line string) {
+					_, _ = GinkgoWriter.Write([]byte(line))
+				},
+				FailureThreshold: 1,
+			})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(adapter)
+
+			Eventually(serverConn).Should(Receive(&acceptedConn))
+			Consistently(streamUp).ShouldNot(Receive())
+			completeHandshake(acceptedConn)
+			Eventually(streamUp).Should(Receive(&s))
+		})
+
+		AfterEach(func() {
+			supervisor.Stop()
+			listener.Close()
+		})
+
+		It("creates a stream with an ID derived from the endpoint", func() {
+			Expect(s.StreamID()).To(BeEquivalentTo(socket.EndpointStreamID(endpoint)))
+			Expect(s.(stream.SourceKeyer).SourceKey()).To(Equal(endpoint))
+		})
+
+		It("parses the payloads sent by the hook into blocks", func() {
+			_, err := acceptedConn.Write(ipcPayload(hook.OpRecv, 1).Encode())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = acceptedConn.Write(ipcPayload(hook.OpSend, 2).Encode())
+			Expect(err).ToNot(HaveOccurred())
+
+			var b *xivnet.Block
+			Eventually(s.SubscribeIngress()).Should(Receive(&b))
+			Expect(b.SubjectID).To(BeEquivalentTo(1))
+			Eventually(s.SubscribeEgress()).Should(Receive(&b))
+			Expect(b.SubjectID).To(BeEquivalentTo(2))
+		})
+
+		It("reports the health of the adapter and the stream", func() {
+			Expect(adapter.Health().State).To(Equal(models.HealthStateConnected))
+
+			reporter, ok := s.(stream.HealthReporter)
+			Expect(ok).To(BeTrue())
+			Expect(reporter.Health().State).To(Equal(models.HealthStateConnected))
+			Expect(reporter.Health().LastPacketTime).To(BeNil())
+
+			_, err := acceptedConn.Write(ipcPayload(hook.OpRecv, 1).Encode())
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(s.SubscribeIngress()).Should(Receive())
+			Expect(reporter.Health().State).To(Equal(models.HealthStateConnected))
+			Expect(reporter.Health().LastPacketTime).ToNot(BeNil())
+		})
+
+		It("sends requests to the hook", func() {
+			resp, err := s.SendRequest([]byte(`{"op": 4, "channel": 1234, "data": [1, 2, 3]}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal([]byte("OK")))
+
+			d := hook.NewDecoder(acceptedConn, 1024)
+			Eventually(func() hook.Payload {
+				p, err := d.NextPayload()
+				Expect(err).ToNot(HaveOccurred())
+				return p
+			}, 5*time.Second).Should(Equal(hook.Payload{
+				Length: 12, Op: 4, Channel: 1234, Data: []byte{1, 2, 3},
+			}))
+		})
+
+		It("closes the connection and reconnects when the handshake fails", func() {
+			Expect(acceptedConn.Close()).To(Succeed())
+			Eventually(streamDown).Should(Receive())
+
+			var newConn net.Conn
+			Eventually(serverConn).Should(Receive(&newConn))
+			sayHello(newConn, "0.7.9")
+
+			Eventually(func() models.HealthState {
+				return adapter.Health().State
+			}).Should(Equal(models.HealthStateDegraded))
+			Expect(adapter.Health().LastError).To(HaveValue(ContainSubstring("hook version 0.7.9 is not supported")))
+			Consistently(streamUp).ShouldNot(Receive())
+
+			_, err := io.ReadAll(newConn)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(serverConn).Should(Receive())
+		})
+
+		It("shuts down the stream and reconnects when the connection is closed", func() {
+			Expect(acceptedConn.Close()).To(Succeed())
+			Eventually(streamDown).Should(Receive(Equal(s.StreamID())))
+
+			var newConn net.Conn
+			Eventually(serverConn).Should(Receive(&newConn))
+			completeHandshake(newConn)
+			var newStream stream.Provider
+			Eventually(streamUp).Should(Receive(&newStream))
+			Expect(newStream.StreamID()).To(Equal(s.StreamID()))
+
+			_, err := newConn.Write(ipcPayload(hook.OpRecv, 3).Encode())
+			Expect(err).ToNot(HaveOccurred())
+			var b *xivnet.Block
+			Eventually(newStream.SubscribeIngress()).Should(Receive(&b))
+			Expect(b.SubjectID).To(BeEquivalentTo(3))
+		})
+	}
+
+	Context("with a TCP endpoint", func() {
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			endpoint = "tcp://" + listener.Addr().String()
+		})
+
+		testAdapter()
+	})
+
+	Context("with a Unix socket endpoint", func() {
+		BeforeEach(func() {
+			path := filepath.Join(GinkgoT().TempDir(), "deucalion.sock")
+			var err error
+			listener, err = net.Listen("unix", path)
+			Expect(err).ToNot(HaveOccurred())
+			endpoint = "unix://" + path
+		})
+
+		testAdapter()
+	})
+
+	Context("when process rules are configured", func() {
+		var enumerator *processfakes.FakeEnumerator
+
+		BeforeEach(func() {
+			path := filepath.Join(GinkgoT().TempDir(), "hook.sock")
+			var err error
+			listener, err = net.Listen("unix", path)
+			Expect(err).ToNot(HaveOccurred())
+			serverConn = fakeServer(listener)
+
+			enumerator = new(processfakes.FakeEnumerator)
+			enumerator.EnumerateProcessesReturns(map[uint32]string{1: "bash"}, nil)
+
+			adapter = socket.NewAdapter(socket.AdapterConfig{
+				SocketConfig: config.SocketConfig{
+					Enabled:           true,
+					Endpoints:         []string{"unix://" + path},
+					DialRetryInterval: config.Duration(10 * time.Millisecond),
+					ProcessRules:      []config.ProcessMatchRule{{Pattern: "ffxiv_dx11.exe"}},
+				},
+				StreamUp:          make(chan stream.Provider, 10),
+				StreamDown:        make(chan int, 10),
+				ProcessEnumerator: enumerator,
+				OpcodeRegistry:    opcodes.NewRegistry(),
+				TransformRegistry: transform.NewRegistry(),
+			}, zap.NewNop())
+
+			supervisor = suture.New("test-adapter", suture.Spec{
+				Log: func(line string) {
+					_, _ = GinkgoWriter.Write([]byte(line))
+				},
+				FailureThreshold: 1,
+			})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(adapter)
+		})
+
+		AfterEach(func() {
+			supervisor.Stop()
+			listener.Close()
+		})
+
+		It("only dials the endpoint once a matching process is running", func() {
+			Eventually(enumerator.EnumerateProcessesCallCount).Should(BeNumerically(">", 0))
+			Consistently(serverConn).ShouldNot(Receive())
+
+			enumerator.EnumerateProcessesReturns(map[uint32]string{1: "bash", 2: "ffxiv_dx11.exe"}, nil)
+			Eventually(serverConn, 3*time.Second).Should(Receive())
+		})
+	})
+
+	Context("when the endpoint cannot be reached", func() {
+		BeforeEach(func() {
+			path := filepath.Join(GinkgoT().TempDir(), "missing.sock")
+			adapter = socket.NewAdapter(socket.AdapterConfig{
+				SocketConfig: config.SocketConfig{
+					Enabled:           true,
+					Endpoints:         []string{"unix://" + path},
+					DialRetryInterval: config.Duration(10 * time.Millisecond),
+				},
+				StreamUp:   make(chan stream.Provider, 10),
+				StreamDown: make(chan int, 10),
+
+				OpcodeRegistry:    opcodes.NewRegistry(),
+				TransformRegistry: transform.NewRegistry(),
+			}, zap.NewNop())
+
+			supervisor = suture.New("test-adapter", suture.Spec{
+				Log: func(line string) {
+					_, _ = GinkgoWriter.Write([]byte(line))
+				},
+				FailureThreshold: 1,
+			})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(adapter)
+		})
+
+		AfterEach(func() {
+			supervisor.Stop()
+		})
+
+		It("reports the adapter as degraded along with the dial error", func() {
+			Eventually(func() models.HealthState {
+				return adapter.Health().State
+			}).Should(Equal(models.HealthStateDegraded))
+			Expect(adapter.Health().LastError).To(HaveValue(ContainSubstring("missing.sock")))
+		})
+	})
+})
+
+var _ = Describe("GetInfo", func() {
+	It("validates the configured endpoints", func() {
+		info := socket.GetInfo(opcodes.NewRegistry(), transform.NewRegistry())
+		Expect(info.Name).To(Equal("Socket"))
+		cfg := config.Config{}
+		Expect(info.Builder.LoadConfig(cfg)).To(MatchError("no endpoints configured"))
+		cfg.Adapters.Socket.Endpoints = []string{"udp://127.0.0.1:9000"}
+		Expect(info.Builder.LoadConfig(cfg)).To(MatchError(ContainSubstring("unsupported scheme")))
+		cfg.Adapters.Socket.Endpoints = []string{"tcp://127.0.0.1:9000"}
+		Expect(info.Builder.LoadConfig(cfg)).To(Succeed())
+	})
+})
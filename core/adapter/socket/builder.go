@@ -0,0 +1,69 @@
+package socket
+
+import (
+	"errors"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
+	"go.uber.org/zap"
+)
+
+// GetInfo returns the adapter's name and a builder for the adapter. The
+// registries are used to parse and transform the blocks of every stream.
+func GetInfo(
+	opcodeRegistry *opcodes.Registry,
+	transformRegistry *transform.Registry,
+) stream.AdapterInfo {
+	return stream.AdapterInfo{
+		Name: "Socket",
+		Builder: &builder{
+			opcodes:    opcodeRegistry,
+			transforms: transformRegistry,
+		},
+	}
+}
+
+type builder struct {
+	cfg        config.Config
+	opcodes    *opcodes.Registry
+	transforms *transform.Registry
+}
+
+// LoadConfig loads the configuration for the adapter into the builder.
+func (b *builder) LoadConfig(cfg config.Config) error {
+	if len(cfg.Adapters.Socket.Endpoints) == 0 {
+		return errors.New("no endpoints configured")
+	}
+	for _, endpoint := range cfg.Adapters.Socket.Endpoints {
+		if _, _, err := ParseEndpoint(endpoint); err != nil {
+			return err
+		}
+	}
+	if len(cfg.Adapters.Socket.ProcessRules) > 0 && processEnumerator() == nil {
+		return errors.New("process rules are not supported on this platform")
+	}
+	b.cfg = cfg
+	return nil
+}
+
+// Build returns a new instance of the socket adapter
+func (b *builder) Build(
+	streamUp chan<- stream.Provider,
+	streamDown chan<- int,
+	logger *zap.Logger,
+) stream.Adapter {
+	return NewAdapter(
+		AdapterConfig{
+			SocketConfig: b.cfg.Adapters.Socket,
+			StreamUp:     streamUp,
+			StreamDown:   streamDown,
+
+			ProcessEnumerator: processEnumerator(),
+			OpcodeRegistry:    b.opcodes,
+			TransformRegistry: b.transforms,
+		},
+		logger,
+	)
+}
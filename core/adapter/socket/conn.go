@@ -0,0 +1,53 @@
+package socket
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// watchedConn wraps a connection to the hook and notifies listeners once the
+// connection can no longer be read from.
+type watchedConn struct {
+	net.Conn
+
+	closed     chan struct{}
+	closedOnce sync.Once
+	closeOnce  sync.Once
+}
+
+func newWatchedConn(conn net.Conn) *watchedConn {
+	return &watchedConn{
+		Conn:   conn,
+		closed: make(chan struct{}),
+	}
+}
+
+// Read implements the Read interface of a net.Conn. Any read error is
+// converted to io.EOF so that the stream shuts down cleanly once the
+// connection is lost.
+func (c *watchedConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if err != nil {
+		c.closedOnce.Do(func() {
+			close(c.closed)
+		})
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Close implements the Close interface of a net.Conn. It is safe to call
+// Close() more than once since subsequent Close() calls will be no-ops.
+func (c *watchedConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = c.Conn.Close()
+	})
+	return err
+}
+
+// Closed returns a channel that is closed once the connection is lost.
+func (c *watchedConn) Closed() <-chan struct{} {
+	return c.closed
+}
@@ -0,0 +1,187 @@
+package socket
+
+import (
+	"io"
+	"net"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/adapter/hook"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+)
+
+// Connector is responsible for maintaining a connection to the hook at a
+// single endpoint. It starts up a new hook stream whenever it connects to the
+// endpoint and shuts the stream down whenever the connection is lost, in
+// which case it attempts to reconnect after the dial retry interval. If the
+// Connector is given a ProcessWatcher, it only dials the endpoint while a
+// matching process is running.
+// Additionally, the Connector notifies the StreamUp and StreamDown channels
+// when the stream is created and shut down, respectively.
+type Connector struct {
+	cfg      AdapterConfig
+	endpoint string
+	streamID uint32
+
+	streamBuilder    func(streamID uint32, endpoint string, conn io.ReadWriteCloser) hook.Stream
+	streamSupervisor *suture.Supervisor
+	processes        *ProcessWatcher
+
+	health *hook.HealthMonitor
+	logger *zap.Logger
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewConnector creates a new Connector for the endpoint
+func NewConnector(
+	cfg AdapterConfig,
+	endpoint string,
+	streamBuilder func(streamID uint32, endpoint string, conn io.ReadWriteCloser) hook.Stream,
+	streamSupervisor *suture.Supervisor,
+	processes *ProcessWatcher,
+	logger *zap.Logger,
+) *Connector {
+	return &Connector{
+		cfg:      cfg,
+		endpoint: endpoint,
+		streamID: EndpointStreamID(endpoint),
+
+		streamBuilder:    streamBuilder,
+		streamSupervisor: streamSupervisor,
+		processes:        processes,
+
+		health: hook.NewHealthMonitor(0),
+		logger: logger.Named("connector").With(zap.String("endpoint", endpoint)),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for connecting to the endpoint.
+func (c *Connector) Serve() {
+	defer close(c.stopDone)
+
+	c.logger.Info("Running")
+
+	network, address, err := ParseEndpoint(c.endpoint)
+	if err != nil {
+		c.health.SetState(models.HealthStateDegraded)
+		c.health.SetError(err)
+		c.logger.Error("Invalid endpoint", zap.Error(err))
+		<-c.stop
+		c.logger.Info("Stopping...")
+		return
+	}
+
+	for {
+		if c.processes != nil && !c.processes.Wait(c.stop) {
+			c.logger.Info("Stopping...")
+			return
+		}
+		if !c.connect(network, address) {
+			c.logger.Info("Stopping...")
+			return
+		}
+		select {
+		case <-time.After(c.retryInterval()):
+		case <-c.stop:
+			c.logger.Info("Stopping...")
+			return
+		}
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (c *Connector) Stop() {
+	close(c.stop)
+	<-c.stopDone
+}
+
+// StreamID returns the ID of the stream created for the endpoint
+func (c *Connector) StreamID() uint32 {
+	return c.streamID
+}
+
+// Health reports whether the Connector is currently connected to the
+// endpoint, along with the last error encountered when connecting.
+func (c *Connector) Health() models.Health {
+	return c.health.Health()
+}
+
+func (c *Connector) retryInterval() time.Duration {
+	if c.cfg.SocketConfig.DialRetryInterval > 0 {
+		return time.Duration(c.cfg.SocketConfig.DialRetryInterval)
+	}
+	return 5 * time.Second
+}
+
+// connect dials the endpoint and serves a stream until the connection is
+// lost. The stream is only sent on the StreamUp channel once its handshake
+// with the hook has completed. It returns false if the Connector was asked to
+// stop.
+func (c *Connector) connect(network, address string) bool {
+	conn, err := net.DialTimeout(network, address, 5*time.Second)
+	if err != nil {
+		c.health.SetState(models.HealthStateDegraded)
+		c.health.SetError(err)
+		c.logger.Debug("Failed to connect to endpoint", zap.Error(err))
+		return true
+	}
+	c.logger.Info("Connected to endpoint")
+
+	wc := newWatchedConn(conn)
+	s := c.streamBuilder(c.streamID, c.endpoint, wc)
+	token := c.streamSupervisor.Add(s)
+
+	select {
+	case err := <-s.Handshake():
+		if err != nil {
+			c.health.SetState(models.HealthStateDegraded)
+			c.health.SetError(err)
+			c.logger.Error("Hook handshake failed, closing connection", zap.Error(err))
+			c.removeStream(token)
+			return true
+		}
+	case <-wc.Closed():
+		c.logger.Info("Connection to endpoint closed during handshake")
+		c.removeStream(token)
+		return true
+	case <-c.stop:
+		return false
+	}
+	c.health.SetState(models.HealthStateConnected)
+
+	select {
+	case c.cfg.StreamUp <- s:
+	case <-c.stop:
+		return false
+	}
+
+	select {
+	case <-wc.Closed():
+	case <-c.stop:
+		return false
+	}
+
+	c.health.SetState(models.HealthStateConnecting)
+	c.logger.Info("Connection to endpoint closed")
+	c.removeStream(token)
+
+	select {
+	case c.cfg.StreamDown <- int(c.streamID):
+	case <-c.stop:
+		return false
+	}
+	return true
+}
+
+// removeStream shuts down the stream, which also closes its connection
+func (c *Connector) removeStream(token suture.ServiceToken) {
+	if err := c.streamSupervisor.Remove(token); err != nil {
+		c.logger.Error("Error removing stream", zap.Uint32("streamID", c.streamID), zap.Error(err))
+	}
+}
@@ -0,0 +1,43 @@
+package socket
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/url"
+)
+
+// ParseEndpoint parses an endpoint of the form "tcp://host:port" or
+// "unix:///path/to/socket" and returns the network and address to dial.
+func ParseEndpoint(endpoint string) (network string, address string, err error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint %q: %s", endpoint, err)
+	}
+	switch u.Scheme {
+	case "tcp", "tcp4", "tcp6":
+		if u.Host == "" || u.Port() == "" {
+			return "", "", fmt.Errorf("invalid endpoint %q: missing host or port", endpoint)
+		}
+		return u.Scheme, u.Host, nil
+	case "unix":
+		path := u.Path
+		if u.Host != "" {
+			path = u.Host + path
+		}
+		if path == "" {
+			return "", "", fmt.Errorf("invalid endpoint %q: missing socket path", endpoint)
+		}
+		return "unix", path, nil
+	default:
+		return "", "", fmt.Errorf("invalid endpoint %q: unsupported scheme %q", endpoint, u.Scheme)
+	}
+}
+
+// EndpointStreamID derives a stream ID from the endpoint so that the same
+// endpoint always maps to the same stream ID across reconnects and restarts.
+func EndpointStreamID(endpoint string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(endpoint))
+	// Keep the stream ID positive when converted to an int on 32-bit systems
+	return h.Sum32() & 0x7FFFFFFF
+}
@@ -0,0 +1,44 @@
+package socket_test
+
+import (
+	"github.com/ff14wed/aetherometer/core/adapter/socket"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseEndpoint", func() {
+	DescribeTable("parses valid endpoints",
+		func(endpoint, expectedNetwork, expectedAddress string) {
+			network, address, err := socket.ParseEndpoint(endpoint)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(network).To(Equal(expectedNetwork))
+			Expect(address).To(Equal(expectedAddress))
+		},
+		Entry("tcp", "tcp://127.0.0.1:9000", "tcp", "127.0.0.1:9000"),
+		Entry("tcp with hostname", "tcp://gamepc.local:9000", "tcp", "gamepc.local:9000"),
+		Entry("tcp6", "tcp6://[::1]:9000", "tcp6", "[::1]:9000"),
+		Entry("unix", "unix:///tmp/deucalion.sock", "unix", "/tmp/deucalion.sock"),
+		Entry("relative unix", "unix://deucalion.sock", "unix", "deucalion.sock"),
+	)
+
+	DescribeTable("rejects invalid endpoints",
+		func(endpoint, errString string) {
+			_, _, err := socket.ParseEndpoint(endpoint)
+			Expect(err).To(MatchError(ContainSubstring(errString)))
+		},
+		Entry("missing port", "tcp://127.0.0.1", "missing host or port"),
+		Entry("missing path", "unix://", "missing socket path"),
+		Entry("unknown scheme", "udp://127.0.0.1:9000", `unsupported scheme "udp"`),
+		Entry("no scheme", "127.0.0.1:9000", "invalid endpoint"),
+	)
+})
+
+var _ = Describe("EndpointStreamID", func() {
+	It("derives a stable positive stream ID from the endpoint", func() {
+		id := socket.EndpointStreamID("tcp://127.0.0.1:9000")
+		Expect(id).To(Equal(socket.EndpointStreamID("tcp://127.0.0.1:9000")))
+		Expect(id).ToNot(Equal(socket.EndpointStreamID("tcp://127.0.0.1:9001")))
+		Expect(id & 0x80000000).To(BeZero())
+	})
+})
@@ -0,0 +1,9 @@
+package socket
+
+import "github.com/ff14wed/aetherometer/core/process"
+
+// processEnumerator returns the Enumerator used to find the game processes,
+// which walks procfs on Linux
+func processEnumerator() process.Enumerator {
+	return process.NewProcFS("")
+}
@@ -0,0 +1,12 @@
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package socket
+
+import "github.com/ff14wed/aetherometer/core/process"
+
+// processEnumerator returns nil since finding the game processes is not
+// supported on this platform
+func processEnumerator() process.Enumerator {
+	return nil
+}
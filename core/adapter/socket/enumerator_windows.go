@@ -0,0 +1,12 @@
+package socket
+
+import (
+	"github.com/ff14wed/aetherometer/core/process"
+	"github.com/ff14wed/aetherometer/core/win32"
+)
+
+// processEnumerator returns the Enumerator used to find the game processes,
+// which uses the Windows API on Windows
+func processEnumerator() process.Enumerator {
+	return win32.Provider{}
+}
@@ -0,0 +1,95 @@
+package socket
+
+import (
+	"sync"
+
+	"github.com/ff14wed/aetherometer/core/process"
+	"go.uber.org/zap"
+)
+
+// ProcessWatcher keeps track of the running processes that match the process
+// rules of the socket adapter, as reported by a process Scanner, so that the
+// Connectors only dial their endpoints while the game is running.
+type ProcessWatcher struct {
+	addEvents    <-chan process.AddEvent
+	removeEvents <-chan uint32
+	logger       *zap.Logger
+
+	lock    sync.Mutex
+	pids    map[uint32]struct{}
+	changed chan struct{}
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewProcessWatcher returns a new ProcessWatcher that listens to the add and
+// remove events of a process Scanner
+func NewProcessWatcher(
+	addEvents <-chan process.AddEvent,
+	removeEvents <-chan uint32,
+	logger *zap.Logger,
+) *ProcessWatcher {
+	return &ProcessWatcher{
+		addEvents:    addEvents,
+		removeEvents: removeEvents,
+		logger:       logger.Named("process-watcher"),
+
+		pids:    make(map[uint32]struct{}),
+		changed: make(chan struct{}),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the service responsible for keeping track of the matching
+// processes.
+func (w *ProcessWatcher) Serve() {
+	defer close(w.stopDone)
+	w.logger.Info("Running")
+	for {
+		select {
+		case e := <-w.addEvents:
+			w.update(func() { w.pids[e.PID] = struct{}{} })
+		case pid := <-w.removeEvents:
+			w.update(func() { delete(w.pids, pid) })
+		case <-w.stop:
+			w.logger.Info("Stopping...")
+			return
+		}
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (w *ProcessWatcher) Stop() {
+	close(w.stop)
+	<-w.stopDone
+}
+
+func (w *ProcessWatcher) update(f func()) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	f()
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
+
+// Wait blocks until at least one matching process is running. It returns
+// false if the stop channel was closed first.
+func (w *ProcessWatcher) Wait(stop <-chan struct{}) bool {
+	for {
+		w.lock.Lock()
+		running := len(w.pids) > 0
+		changed := w.changed
+		w.lock.Unlock()
+		if running {
+			return true
+		}
+		select {
+		case <-changed:
+		case <-stop:
+			return false
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package socket_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSocket(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Socket Suite")
+}
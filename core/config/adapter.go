@@ -8,15 +8,150 @@ type HookConfig struct {
 	// DLLPath sets the path of the Hook DLL on the system.
 	DLLPath string `toml:"dll_path" validate:"file"`
 
-	// FFXIVProcess is the name of the exe file for the game.
-	FFXIVProcess string `toml:"ffxiv_process" validate:"nonempty"`
+	// FFXIVProcess is the name of the exe file for the game. Any process whose
+	// name contains it is matched, unless ProcessRules are provided.
+	FFXIVProcess string `toml:"ffxiv_process"`
+
+	// ProcessRules lists the rules used to find the game processes. A process
+	// is matched if it matches any of the include rules and none of the
+	// exclude rules.
+	ProcessRules []ProcessMatchRule `toml:"process_rules,omitempty"`
+
+	// DisableAutoAttach toggles whether or not the hook is only injected into
+	// the matching processes that are attached through the API, rather than
+	// into every matching process.
+	DisableAutoAttach bool `toml:"disable_auto_attach,omitempty"`
 
 	// DialRetryInterval controls how long to wait before retrying
-	// failures to make a connection with the hook DLL.
+	// failures to make a connection with the hook DLL. While a lost
+	// connection is being re-established, the wait doubles after every failed
+	// attempt, up to 30 seconds.
 	// Defaults to 500 milliseconds.
 	DialRetryInterval Duration `toml:"dial_retry_interval,omitzero"`
 
 	// PingInterval controls the interval between liveness checks to
 	// hook. Defaults to 1 second.
 	PingInterval Duration `toml:"ping_interval,omitzero"`
+
+	// MaxMissedPings controls how many pings in a row may go by without
+	// receiving anything from the hook before the connection is considered
+	// stale. Defaults to 3.
+	MaxMissedPings int `toml:"max_missed_pings,omitzero"`
+
+	// HandshakeTimeout controls how long to wait for the hook to complete the
+	// handshake after connecting to it. Defaults to 10 seconds.
+	HandshakeTimeout Duration `toml:"handshake_timeout,omitzero"`
+
+	// Record toggles whether or not new hook streams record the data received
+	// from the hook to session files by default. Recording can also be toggled
+	// for each stream at runtime.
+	Record bool `toml:"record,omitempty"`
+
+	// RecordDir sets the directory in which session files are saved.
+	RecordDir string `toml:"record_dir,omitempty"`
+
+	// RecordCompress toggles gzip compression of session files.
+	RecordCompress bool `toml:"record_compress,omitempty"`
+
+	// RecordMaxSize controls the size in bytes at which a session file is
+	// rotated. Defaults to 100 MiB.
+	RecordMaxSize int64 `toml:"record_max_size,omitzero"`
+}
+
+// Match modes for process match rules
+const (
+	ProcessMatchExact = "exact"
+	ProcessMatchGlob  = "glob"
+	ProcessMatchRegex = "regex"
+)
+
+// ProcessMatchRule describes a set of processes by their name, and optionally
+// by the name of their parent process or by their command line.
+type ProcessMatchRule struct {
+	// Name identifies the rule when reporting matched processes. Defaults to
+	// the pattern.
+	Name string `toml:"name,omitempty"`
+
+	// Match sets how the pattern is matched against the process name. It is
+	// one of "exact", "glob" or "regex". Defaults to "exact".
+	Match string `toml:"match,omitempty"`
+
+	// Pattern is matched against the process name.
+	Pattern string `toml:"pattern"`
+
+	// Exclude toggles whether the processes matched by the rule are ignored
+	// rather than included.
+	Exclude bool `toml:"exclude,omitempty"`
+
+	// Parent is matched against the name of the parent process in the same
+	// way as the pattern, if it is provided.
+	Parent string `toml:"parent,omitempty"`
+
+	// Cmdline is a regular expression matched against the full command line
+	// of the process, if it is provided.
+	Cmdline string `toml:"cmdline,omitempty"`
+}
+
+// Pacing modes for the replay adapter
+const (
+	ReplayPacingRealtime = "realtime"
+	ReplayPacingFast     = "fast"
+)
+
+// ReplayConfig stores the configuration for the replay adapter
+type ReplayConfig struct {
+	// Enabled toggles whether or not the Replay adapter is enabled.
+	Enabled bool `toml:"enabled"`
+
+	// Files lists the recorded session files to replay. Each file is replayed
+	// as its own stream.
+	Files []string `toml:"files" validate:"files"`
+
+	// Pacing controls how quickly the recorded data is replayed. "realtime"
+	// paces the data according to the block timestamps, and "fast" replays
+	// the data as fast as possible. Defaults to "realtime".
+	Pacing string `toml:"pacing,omitempty"`
+}
+
+// SocketConfig stores the configuration for the socket adapter
+type SocketConfig struct {
+	// Enabled toggles whether or not the Socket adapter is enabled.
+	Enabled bool `toml:"enabled"`
+
+	// Endpoints lists the addresses of the hooks to connect to, in the form
+	// "tcp://host:port" or "unix:///path/to/socket". Each endpoint is handled
+	// as its own stream.
+	Endpoints []string `toml:"endpoints" validate:"nonempty"`
+
+	// DialRetryInterval controls how long to wait before reconnecting to an
+	// endpoint after failing to connect or after the connection is closed.
+	// Defaults to 5 seconds.
+	DialRetryInterval Duration `toml:"dial_retry_interval,omitzero"`
+
+	// PingInterval controls the interval between liveness checks to
+	// hook. Defaults to 1 second.
+	PingInterval Duration `toml:"ping_interval,omitzero"`
+
+	// MaxMissedPings controls how many pings in a row the hook may fail to
+	// answer before the connection is considered stale. Defaults to 3.
+	MaxMissedPings int `toml:"max_missed_pings,omitzero"`
+
+	// HandshakeTimeout controls how long to wait for the hook to complete the
+	// handshake after connecting to it. Defaults to 10 seconds.
+	HandshakeTimeout Duration `toml:"handshake_timeout,omitzero"`
+
+	// ProcessRules optionally lists the rules used to find the game
+	// processes. If they are provided, the endpoints are only dialed while at
+	// least one matching process is running.
+	ProcessRules []ProcessMatchRule `toml:"process_rules,omitempty"`
+}
+
+// SimulatorConfig stores the configuration for the simulator adapter
+type SimulatorConfig struct {
+	// Enabled toggles whether or not the Simulator adapter is enabled.
+	Enabled bool `toml:"enabled"`
+
+	// Scenarios lists the scenario files describing the simulated game
+	// traffic. Each scenario is simulated as its own stream.
+	Scenarios []string `toml:"scenarios" validate:"files"`
 }
@@ -120,6 +120,105 @@ var _ = Describe("HookConfig", func() {
 			It("errors when empty", func() {
 				Expect(c.Validate()).To(MatchError("config error in [adapters.hook]: ffxiv_process must be provided"))
 			})
+
+			It("is not required when process_rules are provided", func() {
+				c.Adapters.Hook.ProcessRules = []config.ProcessMatchRule{
+					{Pattern: "ffxiv_dx11.exe"},
+				}
+				Expect(c.Validate()).To(Succeed())
+			})
+		})
+
+		Describe("record_dir", func() {
+			BeforeEach(func() {
+				c = &config.Config{
+					APIPort: 9000,
+					Sources: config.Sources{
+						DataPath: dummyPath,
+						Maps: config.MapConfig{
+							Cache: dummyPath,
+						},
+					},
+					Adapters: config.Adapters{
+						Hook: config.HookConfig{
+							Enabled:      true,
+							DLLPath:      dummyFile,
+							FFXIVProcess: "ffxiv_dx11.exe",
+							Record:       true,
+						},
+					},
+				}
+			})
+
+			It("errors when empty and recording is enabled", func() {
+				Expect(c.Validate()).To(MatchError("config error in [adapters.hook]: record_dir must be provided when record is enabled"))
+
+				c.Adapters.Hook.Record = false
+				Expect(c.Validate()).To(Succeed())
+			})
+
+			It("does not need to exist yet", func() {
+				c.Adapters.Hook.RecordDir = filepath.Join(dummyPath, "recordings")
+				Expect(c.Validate()).To(Succeed())
+			})
+
+			It("errors when it is not a directory", func() {
+				c.Adapters.Hook.RecordDir = dummyFile
+				Expect(c.Validate()).To(MatchError(fmt.Sprintf(`config error in [adapters.hook]: record_dir ("%s") must be a directory`, dummyFile)))
+			})
+		})
+
+		Describe("process_rules", func() {
+			BeforeEach(func() {
+				c = &config.Config{
+					APIPort: 9000,
+					Sources: config.Sources{
+						DataPath: dummyPath,
+						Maps: config.MapConfig{
+							Cache: dummyPath,
+						},
+					},
+					Adapters: config.Adapters{
+						Hook: config.HookConfig{
+							Enabled: true,
+							DLLPath: dummyFile,
+						},
+					},
+				}
+			})
+
+			It("is successful on valid rules", func() {
+				c.Adapters.Hook.ProcessRules = []config.ProcessMatchRule{
+					{Name: "game", Match: config.ProcessMatchGlob, Pattern: "ffxiv*.exe", Parent: "ffxivboot*.exe"},
+					{Match: config.ProcessMatchRegex, Pattern: `^ffxiv_dx11_.+\.exe$`, Exclude: true},
+					{Pattern: "ffxiv_dx11.exe", Cmdline: `DEV\.TestSID`, Exclude: true},
+				}
+				Expect(c.Validate()).To(Succeed())
+			})
+
+			DescribeTable("errors on invalid rules",
+				func(rule config.ProcessMatchRule, msg string) {
+					c.Adapters.Hook.ProcessRules = []config.ProcessMatchRule{
+						{Pattern: "ffxiv_dx11.exe"},
+						rule,
+					}
+					Expect(c.Validate()).To(MatchError("config error in [adapters.hook]: process_rules[1]: " + msg))
+				},
+				Entry("empty pattern", config.ProcessMatchRule{}, "pattern must be provided"),
+				Entry("unknown match", config.ProcessMatchRule{Match: "fuzzy", Pattern: "ffxiv"}, `unknown match "fuzzy"`),
+				Entry("invalid glob", config.ProcessMatchRule{Match: config.ProcessMatchGlob, Pattern: "ffxiv[.exe"}, `invalid glob "ffxiv[.exe"`),
+				Entry("invalid regex", config.ProcessMatchRule{Match: config.ProcessMatchRegex, Pattern: "ffxiv(.exe"}, `invalid regex "ffxiv(.exe"`),
+				Entry("invalid parent", config.ProcessMatchRule{Match: config.ProcessMatchRegex, Pattern: "ffxiv", Parent: "("}, `invalid regex "("`),
+				Entry("invalid cmdline", config.ProcessMatchRule{Pattern: "ffxiv", Cmdline: "["}, `invalid regex "["`),
+			)
+
+			It("errors when all of the rules are exclude rules", func() {
+				c.Adapters.Hook.FFXIVProcess = "ffxiv_dx11.exe"
+				c.Adapters.Hook.ProcessRules = []config.ProcessMatchRule{
+					{Pattern: "ffxiv.exe", Exclude: true},
+				}
+				Expect(c.Validate()).To(MatchError("config error in [adapters.hook]: process_rules must include at least one rule that is not an exclude rule"))
+			})
 		})
 	})
 
@@ -139,6 +238,19 @@ var _ = Describe("HookConfig", func() {
 				`ffxiv_process = "something.exe"`,
 				`dial_retry_interval = "13s"`,
 				`ping_interval = "30s"`,
+				`record = true`,
+				`record_dir = "recordings"`,
+				`record_compress = true`,
+				`record_max_size = 1024`,
+				`[[adapters.hook.process_rules]]`,
+				`name = "game"`,
+				`match = "glob"`,
+				`pattern = "ffxiv_dx11*.exe"`,
+				`parent = "ffxivboot.exe"`,
+				`[[adapters.hook.process_rules]]`,
+				`pattern = "ffxiv_dx11_launcher_helper.exe"`,
+				`exclude = true`,
+				`cmdline = "--helper"`,
 			}
 			input = strings.Join(lines, "\n")
 
@@ -154,6 +266,14 @@ var _ = Describe("HookConfig", func() {
 						FFXIVProcess:      "something.exe",
 						DialRetryInterval: config.Duration(13 * time.Second),
 						PingInterval:      config.Duration(30 * time.Second),
+						Record:            true,
+						RecordDir:         "recordings",
+						RecordCompress:    true,
+						RecordMaxSize:     1024,
+						ProcessRules: []config.ProcessMatchRule{
+							{Name: "game", Match: "glob", Pattern: "ffxiv_dx11*.exe", Parent: "ffxivboot.exe"},
+							{Pattern: "ffxiv_dx11_launcher_helper.exe", Exclude: true, Cmdline: "--helper"},
+						},
 					},
 				},
 			}
@@ -167,3 +287,195 @@ var _ = Describe("HookConfig", func() {
 		})
 	})
 })
+
+var _ = Describe("ReplayConfig", func() {
+	var (
+		c         *config.Config
+		dummyFile string
+		dummyPath string
+	)
+
+	Describe("Validate", func() {
+		BeforeEach(func() {
+			var err error
+			dummyFile, err = os.Executable()
+			Expect(err).ToNot(HaveOccurred())
+			dummyPath = filepath.Dir(dummyFile)
+
+			c = &config.Config{
+				APIPort: 9000,
+				Sources: config.Sources{
+					DataPath: dummyPath,
+					Maps: config.MapConfig{
+						Cache: dummyPath,
+					},
+				},
+				Adapters: config.Adapters{
+					Replay: config.ReplayConfig{
+						Enabled: true,
+						Files:   []string{dummyFile},
+					},
+				},
+			}
+		})
+
+		It("is successful on a correct config", func() {
+			Expect(c.Validate()).To(Succeed())
+		})
+
+		It("errors when files is empty", func() {
+			c.Adapters.Replay.Files = []string{}
+			Expect(c.Validate()).To(MatchError("config error in [adapters.replay]: files must be provided"))
+		})
+
+		It("errors when one of the files does not exist", func() {
+			c.Adapters.Replay.Files = []string{dummyFile, `Z:\foo\does\not\exist`}
+			Expect(c.Validate()).To(MatchError(`config error in [adapters.replay]: files file ("Z:\foo\does\not\exist") does not exist`))
+		})
+
+		It("does not validate the files when the adapter is disabled", func() {
+			c.Adapters.Replay = config.ReplayConfig{Enabled: false}
+			Expect(c.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("toml.Decode", func() {
+		It("decodes successfully from TOML", func() {
+			input := strings.Join([]string{
+				`[adapters.replay]`,
+				`enabled = true`,
+				`files = ["a.aethsess", "b.aethsess"]`,
+				`pacing = "fast"`,
+			}, "\n")
+
+			var cfg config.Config
+			_, err := toml.Decode(input, &cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Adapters.Replay).To(Equal(config.ReplayConfig{
+				Enabled: true,
+				Files:   []string{"a.aethsess", "b.aethsess"},
+				Pacing:  config.ReplayPacingFast,
+			}))
+		})
+	})
+})
+
+var _ = Describe("SocketConfig", func() {
+	var (
+		c         *config.Config
+		dummyPath string
+	)
+
+	Describe("Validate", func() {
+		BeforeEach(func() {
+			dummyFile, err := os.Executable()
+			Expect(err).ToNot(HaveOccurred())
+			dummyPath = filepath.Dir(dummyFile)
+
+			c = &config.Config{
+				APIPort: 9000,
+				Sources: config.Sources{
+					DataPath: dummyPath,
+					Maps: config.MapConfig{
+						Cache: dummyPath,
+					},
+				},
+				Adapters: config.Adapters{
+					Socket: config.SocketConfig{
+						Enabled:   true,
+						Endpoints: []string{"tcp://127.0.0.1:9000"},
+					},
+				},
+			}
+		})
+
+		It("is successful on a correct config", func() {
+			Expect(c.Validate()).To(Succeed())
+		})
+
+		It("errors when endpoints is not provided", func() {
+			c.Adapters.Socket.Endpoints = nil
+			Expect(c.Validate()).To(MatchError("config error in [adapters.socket]: endpoints must be provided"))
+		})
+
+		It("does not validate the endpoints when the adapter is disabled", func() {
+			c.Adapters.Socket = config.SocketConfig{Enabled: false}
+			Expect(c.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("toml.Decode", func() {
+		It("decodes successfully from TOML", func() {
+			input := strings.Join([]string{
+				`[adapters.socket]`,
+				`enabled = true`,
+				`endpoints = ["tcp://127.0.0.1:9000", "unix:///tmp/deucalion.sock"]`,
+				`dial_retry_interval = "2s"`,
+				`ping_interval = "3s"`,
+			}, "\n")
+			var cfg config.Config
+			_, err := toml.Decode(input, &cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Adapters.Socket).To(Equal(config.SocketConfig{
+				Enabled:           true,
+				Endpoints:         []string{"tcp://127.0.0.1:9000", "unix:///tmp/deucalion.sock"},
+				DialRetryInterval: config.Duration(2 * time.Second),
+				PingInterval:      config.Duration(3 * time.Second),
+			}))
+		})
+	})
+})
+
+var _ = Describe("SimulatorConfig", func() {
+	var (
+		c         *config.Config
+		dummyFile string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dummyFile, err = os.Executable()
+		Expect(err).ToNot(HaveOccurred())
+		dummyPath := filepath.Dir(dummyFile)
+
+		c = &config.Config{
+			APIPort: 9000,
+			Sources: config.Sources{
+				DataPath: dummyPath,
+				Maps: config.MapConfig{
+					Cache: dummyPath,
+				},
+			},
+			Adapters: config.Adapters{
+				Simulator: config.SimulatorConfig{
+					Enabled:   true,
+					Scenarios: []string{dummyFile},
+				},
+			},
+		}
+	})
+
+	It("is successful on a correct config", func() {
+		Expect(c.Validate()).To(Succeed())
+	})
+
+	It("errors when one of the scenarios does not exist", func() {
+		c.Adapters.Simulator.Scenarios = []string{dummyFile, `Z:\foo\does\not\exist`}
+		Expect(c.Validate()).To(MatchError(`config error in [adapters.simulator]: scenarios file ("Z:\foo\does\not\exist") does not exist`))
+	})
+
+	It("decodes successfully from TOML", func() {
+		input := strings.Join([]string{
+			`[adapters.simulator]`,
+			`enabled = true`,
+			`scenarios = ["arena.toml"]`,
+		}, "\n")
+		var cfg config.Config
+		_, err := toml.Decode(input, &cfg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Adapters.Simulator).To(Equal(config.SimulatorConfig{
+			Enabled:   true,
+			Scenarios: []string{"arena.toml"},
+		}))
+	})
+})
@@ -3,8 +3,13 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+
+	"github.com/ff14wed/aetherometer/core/opcodes"
 )
 
 // Config stores configuration values for the Aetherometer core
@@ -31,6 +36,23 @@ type Config struct {
 	// the core API.
 	Adapters Adapters `toml:"adapters"`
 
+	// Throttle maps the name of an xivnet datatype to the policy used to drop
+	// high frequency blocks of that datatype before they are processed.
+	// Datatypes that are not listed are always processed.
+	Throttle map[string]ThrottleConfig `toml:"throttle,omitempty"`
+
+	// Snapshot provides the configuration for saving the state of the
+	// streams so that it can be restored after a restart.
+	Snapshot SnapshotConfig `toml:"snapshot"`
+
+	// History provides the configuration for keeping the past states of the
+	// streams so that they can be queried.
+	History HistoryConfig `toml:"history"`
+
+	// Journal provides the configuration for recording the stream and entity
+	// events to disk so that plugins can read them back.
+	Journal JournalConfig `toml:"journal"`
+
 	// Plugins is a name -> URL dictionary that allows the listed plugins to
 	// access the API and pass CORS validation.  Note that the plugin scheme
 	// must be provided.
@@ -42,6 +64,15 @@ type Adapters struct {
 	// Hook provides the configuration for the Hook adapter.
 	Hook HookConfig `toml:"hook"`
 
+	// Replay provides the configuration for the Replay adapter.
+	Replay ReplayConfig `toml:"replay"`
+
+	// Socket provides the configuration for the Socket adapter.
+	Socket SocketConfig `toml:"socket"`
+
+	// Simulator provides the configuration for the Simulator adapter.
+	Simulator SimulatorConfig `toml:"simulator"`
+
 	//lint:ignore U1000 test is for testing purposes only. Do not use.
 	test struct{}
 }
@@ -59,6 +90,22 @@ func (a Adapters) IsEnabled(adapterName string) bool {
 	return true
 }
 
+// IsAdapterConfigEqual returns whether or not the configuration for the
+// provided adapter name is the same in both of the adapter configurations
+func (a Adapters) IsAdapterConfigEqual(other Adapters, adapterName string) bool {
+	adapterConfig := reflect.ValueOf(a).FieldByName(adapterName)
+	otherConfig := reflect.ValueOf(other).FieldByName(adapterName)
+	if !adapterConfig.IsValid() {
+		panic(fmt.Sprintf("ERROR: Adapter config for %s does not exist", adapterName))
+	}
+	if !adapterConfig.CanInterface() {
+		// Unexported adapter configs cannot be compared, so they are
+		// treated as unchanged
+		return true
+	}
+	return reflect.DeepEqual(adapterConfig.Interface(), otherConfig.Interface())
+}
+
 // Sources stores configuration for sources that provide data used to interpret
 // indexes sent over the network
 type Sources struct {
@@ -68,6 +115,10 @@ type Sources struct {
 
 	// Maps provides the configuration for the Map endpoint of the API.
 	Maps MapConfig `toml:"maps"`
+
+	// OpcodeFile provides the path to an optional file that overrides the
+	// opcodes compiled into xivnet.
+	OpcodeFile string `toml:"opcode_file,omitempty"`
 }
 
 // Maps sets the configuration for the Map endpoint of the API.
@@ -80,6 +131,70 @@ type MapConfig struct {
 	APIPath string `toml:"api_path"`
 }
 
+// SnapshotConfig sets where and how often the state of the streams is saved.
+type SnapshotConfig struct {
+	// File provides the path of the snapshot file. If empty, the state of the
+	// streams is not saved.
+	File string `toml:"file,omitempty"`
+
+	// Interval controls how often the snapshot is saved.
+	// Defaults to 1 minute.
+	Interval Duration `toml:"interval,omitzero"`
+}
+
+// HistoryConfig sets how much of the past states of the streams is kept.
+type HistoryConfig struct {
+	// Duration controls how far back the states of the streams are kept. If
+	// zero, no history is kept.
+	Duration Duration `toml:"duration,omitzero"`
+
+	// KeyframeInterval controls how often a full copy of each stream is kept.
+	// Defaults to 5 seconds.
+	KeyframeInterval Duration `toml:"keyframe_interval,omitzero"`
+}
+
+// JournalConfig sets whether and where the stream and entity events are
+// recorded.
+type JournalConfig struct {
+	// Enabled toggles whether or not the events are recorded.
+	Enabled bool `toml:"enabled,omitempty"`
+
+	// Dir sets the directory in which the journal segment files are saved.
+	Dir string `toml:"dir,omitempty"`
+
+	// SegmentSize controls the size in bytes at which a segment file is
+	// rotated. Defaults to 16 MiB.
+	SegmentSize int64 `toml:"segment_size,omitzero"`
+
+	// MaxSegments controls how many segment files are kept before the oldest
+	// one is deleted. Defaults to 8.
+	MaxSegments int `toml:"max_segments,omitzero"`
+}
+
+// Throttle policies
+const (
+	// ThrottlePass processes every block of the datatype.
+	ThrottlePass = "pass"
+
+	// ThrottleDedup drops blocks that are exact duplicates of the previous
+	// block of the datatype about the same subject.
+	ThrottleDedup = "dedup"
+
+	// ThrottleLatest processes at most one block of the datatype about the
+	// same subject per interval, keeping the latest block of the interval.
+	ThrottleLatest = "latest"
+)
+
+// ThrottleConfig sets the policy used to drop blocks of a datatype.
+type ThrottleConfig struct {
+	// Policy is one of "pass", "dedup", or "latest".
+	Policy string `toml:"policy"`
+
+	// IntervalMS is the interval in milliseconds used by the "latest"
+	// policy.
+	IntervalMS int `toml:"interval_ms,omitzero"`
+}
+
 func buildError(ctx []string, msg string) error {
 	if len(ctx) > 0 {
 		return fmt.Errorf(`config error in [%s]: %s`, strings.Join(ctx, "."), msg)
@@ -119,6 +234,18 @@ func validateDir(name string, ctx []string, val reflect.Value) error {
 	return nil
 }
 
+func validateFiles(name string, ctx []string, val reflect.Value) error {
+	if val.Len() == 0 {
+		return buildError(ctx, fmt.Sprintf("%s must be provided", name))
+	}
+	for i := 0; i < val.Len(); i++ {
+		if err := validateFile(name, ctx, val.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateNonEmpty(name string, ctx []string, val reflect.Value) error {
 	if reflect.DeepEqual(val.Interface(), reflect.Zero(val.Type()).Interface()) {
 		return buildError(ctx, fmt.Sprintf("%s must be provided", name))
@@ -134,6 +261,8 @@ func validateField(name, validation string, ctx []string, val reflect.Value) err
 		return validateFile(name, ctx, val)
 	case "directory":
 		return validateDir(name, ctx, val)
+	case "files":
+		return validateFiles(name, ctx, val)
 	}
 	return nil
 }
@@ -171,5 +300,117 @@ func validateStruct(rs reflect.Value, ctx []string) error {
 // pass validation
 func (c *Config) Validate() error {
 	rs := reflect.ValueOf(c).Elem()
-	return validateStruct(rs, nil)
+	if err := validateStruct(rs, nil); err != nil {
+		return err
+	}
+	if c.Adapters.Hook.Enabled {
+		if err := validateProcessRules(c.Adapters.Hook); err != nil {
+			return err
+		}
+		if err := validateRecording(c.Adapters.Hook); err != nil {
+			return err
+		}
+	}
+	if c.Adapters.Socket.Enabled && len(c.Adapters.Socket.ProcessRules) > 0 {
+		ctx := []string{"adapters", "socket"}
+		if err := validateMatchRules(ctx, c.Adapters.Socket.ProcessRules); err != nil {
+			return err
+		}
+	}
+	return validateThrottle(c.Throttle)
+}
+
+func validateProcessRules(hook HookConfig) error {
+	ctx := []string{"adapters", "hook"}
+	if len(hook.ProcessRules) == 0 {
+		if hook.FFXIVProcess == "" {
+			return buildError(ctx, "ffxiv_process must be provided")
+		}
+		return nil
+	}
+	return validateMatchRules(ctx, hook.ProcessRules)
+}
+
+// validateRecording checks that new hook streams have somewhere to record
+// to. The directory is created when recording starts, so it only has to be
+// a directory if it already exists.
+func validateRecording(hook HookConfig) error {
+	if !hook.Record {
+		return nil
+	}
+	ctx := []string{"adapters", "hook"}
+	if hook.RecordDir == "" {
+		return buildError(ctx, "record_dir must be provided when record is enabled")
+	}
+	if info, err := os.Stat(hook.RecordDir); err == nil && !info.IsDir() {
+		return buildError(ctx, fmt.Sprintf(`record_dir ("%s") must be a directory`, hook.RecordDir))
+	}
+	return nil
+}
+
+func validateMatchRules(ctx []string, rules []ProcessMatchRule) error {
+	hasInclude := false
+	for i, rule := range rules {
+		prefix := fmt.Sprintf("process_rules[%d]", i)
+		if rule.Pattern == "" {
+			return buildError(ctx, prefix+": pattern must be provided")
+		}
+		patterns := []string{rule.Pattern}
+		if rule.Parent != "" {
+			patterns = append(patterns, rule.Parent)
+		}
+		for _, pattern := range patterns {
+			switch rule.Match {
+			case "", ProcessMatchExact:
+			case ProcessMatchGlob:
+				if _, err := filepath.Match(pattern, ""); err != nil {
+					return buildError(ctx, fmt.Sprintf(`%s: invalid glob "%s"`, prefix, pattern))
+				}
+			case ProcessMatchRegex:
+				if _, err := regexp.Compile(pattern); err != nil {
+					return buildError(ctx, fmt.Sprintf(`%s: invalid regex "%s"`, prefix, pattern))
+				}
+			default:
+				return buildError(ctx, fmt.Sprintf(`%s: unknown match "%s"`, prefix, rule.Match))
+			}
+		}
+		if rule.Cmdline != "" {
+			if _, err := regexp.Compile(rule.Cmdline); err != nil {
+				return buildError(ctx, fmt.Sprintf(`%s: invalid regex "%s"`, prefix, rule.Cmdline))
+			}
+		}
+		if !rule.Exclude {
+			hasInclude = true
+		}
+	}
+	if !hasInclude {
+		return buildError(ctx, "process_rules must include at least one rule that is not an exclude rule")
+	}
+	return nil
+}
+
+func validateThrottle(throttle map[string]ThrottleConfig) error {
+	datatypes := make([]string, 0, len(throttle))
+	for datatype := range throttle {
+		datatypes = append(datatypes, datatype)
+	}
+	sort.Strings(datatypes)
+
+	for _, datatype := range datatypes {
+		ctx := []string{"throttle", datatype}
+		if !opcodes.IsDatatype(datatype) {
+			return buildError(ctx, fmt.Sprintf(`unknown datatype "%s"`, datatype))
+		}
+		t := throttle[datatype]
+		switch t.Policy {
+		case ThrottlePass, ThrottleDedup:
+		case ThrottleLatest:
+			if t.IntervalMS <= 0 {
+				return buildError(ctx, "interval_ms must be positive")
+			}
+		default:
+			return buildError(ctx, fmt.Sprintf(`unknown policy "%s"`, t.Policy))
+		}
+	}
+	return nil
 }
@@ -144,6 +144,73 @@ var _ = Describe("Config", func() {
 				})
 			})
 		})
+
+		Describe("Throttle", func() {
+			var throttle map[string]config.ThrottleConfig
+
+			JustBeforeEach(func() {
+				c = &config.Config{
+					APIPort: 9000,
+					Sources: config.Sources{
+						DataPath: dummyPath,
+						Maps: config.MapConfig{
+							Cache: dummyPath,
+						},
+					},
+					Throttle: throttle,
+				}
+			})
+
+			Context("when every policy is valid", func() {
+				BeforeEach(func() {
+					throttle = map[string]config.ThrottleConfig{
+						"Movement":     {Policy: config.ThrottleLatest, IntervalMS: 100},
+						"UpdateHPMPTP": {Policy: config.ThrottleDedup},
+						"Action":       {Policy: config.ThrottlePass},
+					}
+				})
+
+				It("does not error", func() {
+					Expect(c.Validate()).To(Succeed())
+				})
+			})
+
+			Context("when a datatype is unknown", func() {
+				BeforeEach(func() {
+					throttle = map[string]config.ThrottleConfig{
+						"Movment": {Policy: config.ThrottleDedup},
+					}
+				})
+
+				It("errors", func() {
+					Expect(c.Validate()).To(MatchError(`config error in [throttle.Movment]: unknown datatype "Movment"`))
+				})
+			})
+
+			Context("when a policy is unknown", func() {
+				BeforeEach(func() {
+					throttle = map[string]config.ThrottleConfig{
+						"Movement": {Policy: "sometimes"},
+					}
+				})
+
+				It("errors", func() {
+					Expect(c.Validate()).To(MatchError(`config error in [throttle.Movement]: unknown policy "sometimes"`))
+				})
+			})
+
+			Context("when the latest policy has no interval", func() {
+				BeforeEach(func() {
+					throttle = map[string]config.ThrottleConfig{
+						"Movement": {Policy: config.ThrottleLatest},
+					}
+				})
+
+				It("errors", func() {
+					Expect(c.Validate()).To(MatchError("config error in [throttle.Movement]: interval_ms must be positive"))
+				})
+			})
+		})
 	})
 
 	Describe("Adapters", func() {
@@ -190,6 +257,35 @@ var _ = Describe("Config", func() {
 				Expect(panicMsg).To(Equal("ERROR: Adapter config for Unknown does not exist"))
 			})
 		})
+
+		Describe("IsAdapterConfigEqual", func() {
+			var other config.Adapters
+
+			BeforeEach(func() {
+				a = config.Adapters{Hook: config.HookConfig{Enabled: true, FFXIVProcess: "ffxiv_dx11.exe"}}
+				other = a
+			})
+
+			It("returns true if the adapter config is unchanged", func() {
+				other.Replay.Enabled = true
+				Expect(a.IsAdapterConfigEqual(other, "Hook")).To(BeTrue())
+			})
+
+			It("returns false if the adapter config has changed", func() {
+				other.Hook.FFXIVProcess = "ffxiv.exe"
+				Expect(a.IsAdapterConfigEqual(other, "Hook")).To(BeFalse())
+			})
+
+			It("returns true for adapters with unexported configs", func() {
+				Expect(a.IsAdapterConfigEqual(other, "test")).To(BeTrue())
+			})
+
+			It("panics if the adapter config does not exist", func() {
+				Expect(func() {
+					_ = a.IsAdapterConfigEqual(other, "Unknown")
+				}).To(PanicWith("ERROR: Adapter config for Unknown does not exist"))
+			})
+		})
 	})
 
 	Describe("toml.Decode", func() {
@@ -85,10 +85,31 @@ Map API Path
 The field `sources.map.api_path` configures where to pull map images from if
 they do not exist locally.  Defaults to "https://xivapi.com"
 
+Opcode File
+
+The optional field `sources.opcode_file` configures the location of a file that
+overrides the opcodes compiled into Aetherometer, so that a game patch that
+changes opcodes does not require a rebuild.  The file is read as JSON if it has
+a ".json" extension and as TOML otherwise, and it is reloaded whenever it
+changes.  Each entry maps an opcode to the name of an xivnet datatype for one
+direction ("ingress" or "egress") and one channel ("zone" or "chat", defaulting
+to "zone"):
+
+	[[opcodes]]
+		direction = "ingress"
+		channel = "zone"
+		opcode = 0x02A8
+		datatype = "Movement"
+
+Mapping an opcode to the datatype "Generic" leaves blocks with that opcode
+unparsed.  If the file does not exist or is removed, the compiled opcodes are
+used.  If the file is invalid, the previously loaded overrides are kept.
+
 Adapters Table
 
 This table lists configuration of the various ingress adapters that Aetherometer
-supports. Currently, only the "hook" adapter for Windows is supported.
+supports. Currently, the "hook" adapter for Windows, the "replay" adapter, the
+"socket" adapter, and the "simulator" adapter are supported.
 
 Hook Adapter
 
@@ -103,7 +124,144 @@ FFXIV processes.
 
 The field `adapters.hook.ffxiv_process` should be set to the name of the FFXIV
 process into which to inject the hook. Generally it should be set to
-"ffxiv_dx11.exe", but change it "ffxiv.exe" if you are using DirectX 9.
+"ffxiv_dx11.exe", but change it "ffxiv.exe" if you are using DirectX 9. Any
+process whose name contains this value is matched.
+
+For finer control, `adapters.hook.process_rules` lists the rules used to find
+the FFXIV processes instead. A process is matched if it matches any rule that
+is not an exclude rule, and it is ignored if it matches any exclude rule. Each
+rule matches the process name against its `pattern` according to `match`,
+which is one of "exact" (the default), "glob" or "regex". Exact names and
+globs are matched case insensitively. A rule can also require the name of the
+parent process to match `parent` in the same way, or the full command line of
+the process to match the regular expression `cmdline`. The `name` of the rule
+that matched a process is logged when the process is found.
+
+	[adapters.hook]
+		[[adapters.hook.process_rules]]
+			name = "game"
+			pattern = "ffxiv_dx11.exe"
+		[[adapters.hook.process_rules]]
+			pattern = "ffxiv_dx11.exe"
+			cmdline = "DEV\\.TestSID=secondary"
+			exclude = true
+
+After connecting to the hook, Aetherometer waits for the hook to greet it and
+then negotiates the data it should receive based on the version of the hook.
+The field `adapters.hook.handshake_timeout` controls how long to wait for this
+handshake to complete (defaults to 10s). The stream is not started if the
+handshake fails.
+
+The hook is pinged every `adapters.hook.ping_interval` (defaults to 1s). Once
+nothing at all has been received from the hook for
+`adapters.hook.max_missed_pings` pings in a row (defaults to 3), the stream is
+reported as degraded. If the hook exits or its pipe is closed, Aetherometer
+reconnects to the pipe without injecting the hook again, backing off between
+attempts, and the stream is reported as reconnecting in the meantime.
+
+Setting the field `adapters.hook.record` to `true` records all of the data
+received from each hook to a session file on disk, which can be replayed later
+with the "replay" adapter. Recording can also be toggled for each stream at
+runtime by sending the stream request
+`{"command": "record", "enabled": true}` or
+`{"command": "record", "enabled": false}`.
+
+The field `adapters.hook.record_dir` specifies the directory in which session
+files are saved, and it must be provided when `adapters.hook.record` is
+`true`. Setting `adapters.hook.record_compress` to `true` compresses
+session files with gzip, and `adapters.hook.record_max_size` sets the size in
+bytes at which a new session file is started (defaults to 100 MiB).
+
+Replay Adapter
+
+The table `[adapters.replay]` contains configuration for the "replay" adapter.
+This adapter replays recorded hook sessions as streams, so it can be used on
+any system without a running game.
+
+Setting the field `adapters.replay.enabled` to `true` enables the adapter.
+
+The field `adapters.replay.files` lists the session files to replay. Each
+file is replayed as its own stream.
+
+The field `adapters.replay.pacing` controls how quickly the session is
+replayed. Set it to "realtime" (the default) to replay the data at the same
+pace it was recorded, or "fast" to replay it as fast as possible.
+
+	[adapters.replay]
+		enabled = true
+		files = ["C:\\path\\to\\session.aethsess"]
+		pacing = "realtime"
+
+Socket Adapter
+
+The table `[adapters.socket]` contains configuration for the "socket" adapter.
+This adapter connects to hooks that serve their data over a TCP or Unix domain
+socket instead of a named pipe, such as a game running under Wine or on another
+machine.
+
+Setting the field `adapters.socket.enabled` to `true` enables the adapter.
+
+The field `adapters.socket.endpoints` lists the addresses of the hooks, in the
+form "tcp://host:port" or "unix:///path/to/socket". Each endpoint is handled as
+its own stream, and the stream ID is derived from the endpoint.
+
+The field `adapters.socket.dial_retry_interval` controls how long to wait before
+reconnecting to an endpoint (defaults to 5s), and the field
+`adapters.socket.ping_interval` controls the interval between liveness checks
+to the hook (defaults to 1s). The field `adapters.socket.handshake_timeout`
+controls how long to wait for the hook to complete the handshake after
+connecting (defaults to 10s). The field `adapters.socket.max_missed_pings`
+controls how many pings in a row the hook may fail to answer before the
+connection is reported as degraded (defaults to 3).
+
+	[adapters.socket]
+		enabled = true
+		endpoints = ["tcp://192.168.1.10:9000", "unix:///tmp/deucalion.sock"]
+		dial_retry_interval = "5s"
+
+Simulator Adapter
+
+The table `[adapters.simulator]` contains configuration for the "simulator"
+adapter. This adapter generates fake game traffic according to scripted
+scenarios, so plugins can be tested without running the game.
+
+Setting the field `adapters.simulator.enabled` to `true` enables the adapter.
+
+The field `adapters.simulator.scenarios` lists the scenario files to simulate.
+Each scenario is simulated as its own stream. See the documentation for
+simulator.Scenario for the format of a scenario file.
+
+	[adapters.simulator]
+		enabled = true
+		scenarios = ["C:\\path\\to\\scenario.toml"]
+
+Throttle Table
+
+This table limits how many high frequency blocks of a given xivnet datatype are
+processed, which keeps busy areas such as large hunts from flooding the API
+with updates.  Each key is the name of a datatype known to xivnet, such as
+"Movement", and its value sets one of the following policies:
+
+	"pass" processes every block of the datatype.  This is the default for
+	datatypes that are not listed.
+
+	"dedup" drops blocks that are exact duplicates of the previous block of
+	the datatype about the same entity.
+
+	"latest" processes at most one block of the datatype about the same entity
+	every `interval_ms` milliseconds.  The latest block received during the
+	interval is processed once the interval elapses.
+
+	[throttle]
+		[throttle.Movement]
+			policy = "latest"
+			interval_ms = 100
+
+		[throttle.UpdateHPMPTP]
+			policy = "dedup"
+
+The number of blocks dropped for each datatype can be queried per stream.
+Changes to this table also apply to the streams that are already open.
 
 Plugins Table
 
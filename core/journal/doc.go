@@ -0,0 +1,4 @@
+// Package journal records the events emitted by the store to a rotating
+// on-disk log and reads them back, so that consumers that start late can
+// catch up on what they missed
+package journal
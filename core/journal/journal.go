@@ -0,0 +1,420 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/store"
+	"go.uber.org/zap"
+)
+
+// Journal records every stream and entity event sent to it by the store
+// provider to an append-only log of segment files in a directory. Each event
+// is stamped with the time its update was applied to the store and a
+// sequence number, which doubles as the cursor used to page through query
+// results. Records are buffered in memory and written to the current segment
+// on the flush interval, or before the journal is queried.
+//
+// Segments are rotated once they reach the configured size, and only the
+// most recent segments are kept. Each segment is indexed in memory by the
+// streams, times and types of the events in it, so that queries only read the
+// segments that can contain matching events.
+type Journal struct {
+	dir           string
+	segmentSize   int64
+	maxSegments   int
+	flushInterval time.Duration
+
+	events chan store.EventBatch
+	logger *zap.Logger
+
+	lock     sync.Mutex
+	segments []*segment
+	current  *os.File
+	writer   *bufio.Writer
+	nextSeq  uint64
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// New creates a new journal that writes its segments to dir and loads the
+// index of the segments already in it.
+// Options can optionally be provided like follows:
+// 	j, err := journal.New(
+// 		dir,
+// 		logger,
+// 		journal.WithSegmentSize(1<<20),
+// 		journal.WithMaxSegments(4),
+// 		journal.WithEventBufferSize(100),
+// 		journal.WithFlushInterval(time.Second),
+// 	)
+func New(
+	dir string,
+	logger *zap.Logger,
+	opts ...Option,
+) (*Journal, error) {
+	cfg := journalConfig{
+		segmentSize:     16 << 20,
+		maxSegments:     8,
+		eventBufferSize: 10000,
+		flushInterval:   time.Second,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.maxSegments < 1 {
+		cfg.maxSegments = 1
+	}
+	if cfg.flushInterval <= 0 {
+		cfg.flushInterval = time.Second
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	j := &Journal{
+		dir:           dir,
+		segmentSize:   cfg.segmentSize,
+		maxSegments:   cfg.maxSegments,
+		flushInterval: cfg.flushInterval,
+
+		events: make(chan store.EventBatch, cfg.eventBufferSize),
+		logger: logger.Named("journal"),
+
+		nextSeq: 1,
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+	if err := j.loadSegments(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// loadSegments indexes the segment files left by a previous run. New records
+// are never appended to these files, since their last record may have been
+// cut short.
+func (j *Journal) loadSegments() error {
+	paths, err := filepath.Glob(filepath.Join(j.dir, "events-*.jsonl"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		s, err := loadSegment(path)
+		if err != nil {
+			return err
+		}
+		if s.empty() {
+			os.Remove(path)
+			continue
+		}
+		j.segments = append(j.segments, s)
+		j.nextSeq = s.lastSeq + 1
+	}
+	j.pruneSegments()
+	return nil
+}
+
+// EventsChan returns the channel on which the store provider sends the events
+// to record. The channel is buffered, and the events sent while the journal
+// is not running are recorded once it starts.
+func (j *Journal) EventsChan() chan<- store.EventBatch {
+	return j.events
+}
+
+// Serve runs the service for the journal. It records events until the
+// journal is stopped.
+func (j *Journal) Serve() {
+	defer close(j.stopDone)
+	j.logger.Info("Running")
+	flushTicker := time.NewTicker(j.flushInterval)
+	defer flushTicker.Stop()
+
+	for {
+		select {
+		case batch := <-j.events:
+			for _, e := range batch.StreamEvents {
+				j.record(&record{
+					Kind:     streamKind,
+					StreamID: e.StreamID,
+					Type:     typeName(streamKind, e.Type),
+					Time:     batch.Time,
+				}, e.Type)
+			}
+			for _, e := range batch.EntityEvents {
+				j.record(&record{
+					Kind:     entityKind,
+					StreamID: e.StreamID,
+					EntityID: e.EntityID,
+					Type:     typeName(entityKind, e.Type),
+					Time:     batch.Time,
+				}, e.Type)
+			}
+		case <-flushTicker.C:
+			j.flush()
+		case <-j.stop:
+			j.logger.Info("Stopping...")
+			j.closeCurrent()
+			return
+		}
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning.
+func (j *Journal) Stop() {
+	close(j.stop)
+	<-j.stopDone
+}
+
+func (j *Journal) record(r *record, event interface{}) {
+	if r.Type == "" {
+		j.logger.Warn("Skipping event of unknown type", zap.String("type", fmt.Sprintf("%T", event)))
+		return
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		j.logger.Error("Error encoding event", zap.String("type", r.Type), zap.Error(err))
+		return
+	}
+	r.Data = data
+	if err := j.append(r); err != nil {
+		j.logger.Error("Error writing event", zap.String("type", r.Type), zap.Error(err))
+	}
+}
+
+// append writes the record to the current segment, starting a new segment if
+// the current one is full
+func (j *Journal) append(r *record) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	r.Seq = j.nextSeq
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if j.current == nil || j.lastSegment().size+int64(len(line)) > j.segmentSize {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+	if _, err := j.writer.Write(line); err != nil {
+		return err
+	}
+	j.lastSegment().add(r, int64(len(line)))
+	j.nextSeq++
+	return nil
+}
+
+func (j *Journal) lastSegment() *segment {
+	return j.segments[len(j.segments)-1]
+}
+
+// rotate closes the current segment and opens a new one starting at the next
+// sequence number
+func (j *Journal) rotate() error {
+	if j.current != nil && j.lastSegment().empty() {
+		return nil
+	}
+	j.closeCurrentLocked()
+	path := segmentPath(j.dir, j.nextSeq)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.current = f
+	j.writer = bufio.NewWriter(f)
+	j.segments = append(j.segments, newSegment(path))
+	j.pruneSegments()
+	return nil
+}
+
+// pruneSegments deletes the oldest segments beyond the configured number
+func (j *Journal) pruneSegments() {
+	for len(j.segments) > j.maxSegments {
+		if err := os.Remove(j.segments[0].path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			j.logger.Error("Error removing segment", zap.String("path", j.segments[0].path), zap.Error(err))
+		}
+		j.segments = j.segments[1:]
+	}
+}
+
+// flush writes the buffered records to the current segment
+func (j *Journal) flush() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.flushLocked()
+}
+
+func (j *Journal) flushLocked() {
+	if j.writer == nil {
+		return
+	}
+	if err := j.writer.Flush(); err != nil {
+		j.logger.Error("Error writing segment", zap.String("path", j.current.Name()), zap.Error(err))
+	}
+}
+
+func (j *Journal) closeCurrent() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.closeCurrentLocked()
+}
+
+func (j *Journal) closeCurrentLocked() {
+	if j.current == nil {
+		return
+	}
+	j.flushLocked()
+	if err := j.current.Close(); err != nil {
+		j.logger.Error("Error closing segment", zap.String("path", j.current.Name()), zap.Error(err))
+	}
+	j.current = nil
+	j.writer = nil
+}
+
+type query struct {
+	streamID int
+	from     *time.Time
+	to       *time.Time
+	types    map[string]struct{}
+	entityID *uint64
+	after    uint64
+}
+
+func (q *query) matches(r *record) bool {
+	if r.Seq <= q.after || r.StreamID != q.streamID {
+		return false
+	}
+	if q.from != nil && r.Time.Before(*q.from) {
+		return false
+	}
+	if q.to != nil && !r.Time.Before(*q.to) {
+		return false
+	}
+	if q.entityID != nil && (r.Kind != entityKind || r.EntityID != *q.entityID) {
+		return false
+	}
+	if len(q.types) > 0 {
+		if _, ok := q.types[r.Type]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func formatCursor(seq uint64) string {
+	return strconv.FormatUint(seq, 10)
+}
+
+func parseCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	seq, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return seq, nil
+}
+
+// Query reads back the recorded events matching the query, oldest first. At
+// most query.Limit entries are returned. If more events may match, the cursor
+// of the last entry is returned as the next cursor of the page.
+func (j *Journal) Query(eq models.EventQuery) (*models.JournalPage, error) {
+	after, err := parseCursor(eq.After)
+	if err != nil {
+		return nil, err
+	}
+	q := query{
+		streamID: eq.StreamID,
+		from:     eq.From,
+		to:       eq.To,
+		entityID: eq.EntityID,
+		after:    after,
+	}
+	if len(eq.Types) > 0 {
+		q.types = make(map[string]struct{})
+		for _, t := range eq.Types {
+			_, isStreamEvent := streamEventTypes[t]
+			_, isEntityEvent := entityEventTypes[t]
+			if !isStreamEvent && !isEntityEvent {
+				return nil, fmt.Errorf("unknown event type %q", t)
+			}
+			q.types[t] = struct{}{}
+		}
+	}
+
+	// Only the paths and the sizes of the matching segments are taken under
+	// the lock, once the buffered records are written. Records are never
+	// modified once written, so the segments can be read up to these sizes
+	// while new records are being appended.
+	type segmentRange struct {
+		path string
+		size int64
+	}
+	var ranges []segmentRange
+	j.lock.Lock()
+	j.flushLocked()
+	for _, s := range j.segments {
+		if s.matches(&q) {
+			ranges = append(ranges, segmentRange{path: s.path, size: s.size})
+		}
+	}
+	j.lock.Unlock()
+
+	page := &models.JournalPage{Entries: []models.JournalEntry{}}
+	hasMore := false
+	var decodeErr error
+	for _, sr := range ranges {
+		_, err := scanSegment(sr.path, sr.size, func(r *record, _ int64) bool {
+			if !q.matches(r) {
+				return true
+			}
+			if len(page.Entries) == eq.Limit {
+				hasMore = true
+				return false
+			}
+			e, err := r.entry()
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			page.Entries = append(page.Entries, e)
+			return true
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			// The segment was pruned after the index was read
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		if hasMore {
+			break
+		}
+	}
+	if hasMore && len(page.Entries) > 0 {
+		next := page.Entries[len(page.Entries)-1].Cursor
+		page.NextCursor = &next
+	}
+	return page, nil
+}
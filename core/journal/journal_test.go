@@ -0,0 +1,242 @@
+package journal_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/journal"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/store"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		dir  string
+		j    *journal.Journal
+		opts []journal.Option
+
+		supervisor *suture.Supervisor
+	)
+
+	startJournal := func() {
+		var err error
+		j, err = journal.New(dir, zap.NewNop(), opts...)
+		Expect(err).ToNot(HaveOccurred())
+
+		supervisor = suture.New("test-journal", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(j)
+	}
+
+	sendStreamEvent := func(t time.Time, e models.StreamEvent) {
+		j.EventsChan() <- store.EventBatch{Time: t, StreamEvents: []models.StreamEvent{e}}
+	}
+
+	sendEntityEvent := func(t time.Time, e models.EntityEvent) {
+		j.EventsChan() <- store.EventBatch{Time: t, EntityEvents: []models.EntityEvent{e}}
+	}
+
+	query := func(q models.EventQuery) []models.JournalEntry {
+		if q.Limit == 0 {
+			q.Limit = 100
+		}
+		page, err := j.Query(q)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return page.Entries
+	}
+
+	waitForEntries := func(streamID int, n int) []models.JournalEntry {
+		var entries []models.JournalEntry
+		EventuallyWithOffset(1, func() []models.JournalEntry {
+			entries = query(models.EventQuery{StreamID: streamID})
+			return entries
+		}).Should(HaveLen(n))
+		return entries
+	}
+
+	BeforeEach(func() {
+		dir = filepath.Join(GinkgoT().TempDir(), "journal")
+		opts = nil
+	})
+
+	JustBeforeEach(func() {
+		startJournal()
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It("reads back the stream and entity events of a stream in order", func() {
+		t := time.Unix(100, 0)
+		j.EventsChan() <- store.EventBatch{
+			Time: t,
+			StreamEvents: []models.StreamEvent{{
+				StreamID: 1234,
+				Type:     models.UpdateMap{Place: &models.Place{MapID: 12, TerritoryID: 34}},
+			}},
+			EntityEvents: []models.EntityEvent{{
+				StreamID: 1234,
+				EntityID: 5678,
+				Type:     models.UpdateLocation{Location: &models.Location{X: 1, Y: 2, Z: 3}},
+			}},
+		}
+		sendStreamEvent(t.Add(time.Second), models.StreamEvent{StreamID: 2345, Type: models.RemoveStream{ID: 2345}})
+
+		entries := waitForEntries(1234, 2)
+		Expect(entries[0].StreamEvent).To(Equal(&models.StreamEvent{
+			StreamID: 1234,
+			Type:     models.UpdateMap{Place: &models.Place{MapID: 12, TerritoryID: 34}},
+		}))
+		Expect(entries[0].EntityEvent).To(BeNil())
+		Expect(entries[1].EntityEvent).To(Equal(&models.EntityEvent{
+			StreamID: 1234,
+			EntityID: 5678,
+			Type:     models.UpdateLocation{Location: &models.Location{X: 1, Y: 2, Z: 3}},
+		}))
+		Expect(entries[1].StreamEvent).To(BeNil())
+		Expect(entries[0].Time).To(BeTemporally("==", t))
+		Expect(entries[1].Time).To(BeTemporally("==", t))
+
+		Expect(waitForEntries(2345, 1)[0].StreamEvent.Type).To(Equal(models.RemoveStream{ID: 2345}))
+	})
+
+	Context("with recorded events", func() {
+		var entries []models.JournalEntry
+
+		JustBeforeEach(func() {
+			t := time.Unix(100, 0)
+			events := []models.EntityEvent{
+				{StreamID: 1, EntityID: 10, Type: models.UpdateTarget{TargetID: 20}},
+				{StreamID: 1, EntityID: 11, Type: models.UpdateTarget{TargetID: 21}},
+				{StreamID: 1, EntityID: 10, Type: models.RemoveStatus{Index: 2}},
+			}
+			for i, e := range events {
+				sendEntityEvent(t.Add(time.Duration(i)*time.Second), e)
+			}
+			sendStreamEvent(t.Add(3*time.Second), models.StreamEvent{StreamID: 1, Type: models.UpdateEnmity{}})
+			entries = waitForEntries(1, 4)
+		})
+
+		It("filters the events by type", func() {
+			filtered := query(models.EventQuery{StreamID: 1, Types: []string{"UpdateTarget", "UpdateEnmity"}})
+			Expect(filtered).To(Equal([]models.JournalEntry{entries[0], entries[1], entries[3]}))
+		})
+
+		It("filters the events by entity", func() {
+			entityID := uint64(10)
+			filtered := query(models.EventQuery{StreamID: 1, EntityID: &entityID})
+			Expect(filtered).To(Equal([]models.JournalEntry{entries[0], entries[2]}))
+		})
+
+		It("filters the events by time, including from and excluding to", func() {
+			from := entries[1].Time
+			to := entries[3].Time
+			filtered := query(models.EventQuery{StreamID: 1, From: &from, To: &to})
+			Expect(filtered).To(Equal(entries[1:3]))
+		})
+
+		It("pages through the events with cursors", func() {
+			page, err := j.Query(models.EventQuery{StreamID: 1, Limit: 3})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Entries).To(Equal(entries[:3]))
+			Expect(page.NextCursor).To(Equal(&entries[2].Cursor))
+
+			page, err = j.Query(models.EventQuery{StreamID: 1, Limit: 3, After: *page.NextCursor})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Entries).To(Equal(entries[3:]))
+			Expect(page.NextCursor).To(BeNil())
+		})
+
+		It("returns an error for unknown event types", func() {
+			_, err := j.Query(models.EventQuery{StreamID: 1, Limit: 1, Types: []string{"Foo"}})
+			Expect(err).To(MatchError(`unknown event type "Foo"`))
+		})
+
+		It("returns an error for invalid cursors", func() {
+			_, err := j.Query(models.EventQuery{StreamID: 1, Limit: 1, After: "foo"})
+			Expect(err).To(MatchError(`invalid cursor "foo"`))
+		})
+
+		It("reads back the events after a restart and continues the sequence", func() {
+			supervisor.Stop()
+			startJournal()
+			Expect(query(models.EventQuery{StreamID: 1})).To(Equal(entries))
+
+			sendStreamEvent(time.Unix(104, 0), models.StreamEvent{StreamID: 1, Type: models.RemoveStream{ID: 1}})
+			restarted := waitForEntries(1, 5)
+			Expect(restarted[:4]).To(Equal(entries))
+			Expect(restarted[4].StreamEvent.Type).To(Equal(models.RemoveStream{ID: 1}))
+
+			page, err := j.Query(models.EventQuery{StreamID: 1, Limit: 1, After: entries[3].Cursor})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Entries).To(Equal(restarted[4:]))
+		})
+	})
+
+	Context("when segments are rotated", func() {
+		BeforeEach(func() {
+			opts = []journal.Option{
+				journal.WithSegmentSize(1),
+				journal.WithMaxSegments(2),
+			}
+		})
+
+		It("only keeps the most recent segments", func() {
+			for i := 0; i < 4; i++ {
+				sendEntityEvent(time.Unix(int64(100+i), 0), models.EntityEvent{StreamID: 1, EntityID: 10, Type: models.RemoveStatus{Index: i}})
+			}
+			Eventually(func() []models.JournalEntry {
+				return query(models.EventQuery{StreamID: 1})
+			}).Should(SatisfyAll(
+				HaveLen(2),
+				ContainElement(HaveField("EntityEvent.Type", models.RemoveStatus{Index: 3})),
+			))
+			files, err := os.ReadDir(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+		})
+	})
+
+	Context("with a flush interval", func() {
+		BeforeEach(func() {
+			opts = []journal.Option{journal.WithFlushInterval(10 * time.Millisecond)}
+		})
+
+		It("writes the buffered records to the segment on the interval", func() {
+			sendStreamEvent(time.Unix(100, 0), models.StreamEvent{StreamID: 1, Type: models.RemoveStream{ID: 1}})
+			Eventually(func() ([]byte, error) {
+				return os.ReadFile(filepath.Join(dir, "events-00000000000000000001.jsonl"))
+			}).Should(ContainSubstring(`"type":"RemoveStream"`))
+		})
+	})
+
+	It("ignores a record cut short at the end of a segment", func() {
+		supervisor.Stop()
+		Expect(os.WriteFile(
+			filepath.Join(dir, "events-00000000000000000001.jsonl"),
+			[]byte(`{"seq":1,"time":"2021-01-01T00:00:00Z","kind":"stream","streamID":1,"entityID":0,"type":"RemoveStream","data":{"id":1}}`+"\n"+`{"seq":2,"ti`),
+			0644,
+		)).To(Succeed())
+		startJournal()
+
+		entries := query(models.EventQuery{StreamID: 1})
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Cursor).To(Equal("1"))
+		Expect(entries[0].StreamEvent.Type).To(Equal(models.RemoveStream{ID: 1}))
+
+		sendStreamEvent(time.Unix(100, 0), models.StreamEvent{StreamID: 1, Type: models.RemoveStream{ID: 1}})
+		Expect(waitForEntries(1, 2)[1].Cursor).To(Equal("2"))
+	})
+})
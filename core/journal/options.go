@@ -0,0 +1,56 @@
+package journal
+
+import "time"
+
+type journalConfig struct {
+	segmentSize     int64
+	maxSegments     int
+	eventBufferSize int
+	flushInterval   time.Duration
+}
+
+// Option defines an optional configuration parameter to the constructor of the
+// Journal
+type Option func(j *journalConfig)
+
+// WithSegmentSize sets the size in bytes after which the journal starts
+// writing to a new segment file.
+//
+// The default value is 16 MiB.
+func WithSegmentSize(size int64) Option {
+	return func(j *journalConfig) {
+		j.segmentSize = size
+	}
+}
+
+// WithMaxSegments sets the number of segment files kept by the journal. The
+// oldest segment is deleted when a new segment would exceed this number.
+//
+// The default value is 8.
+func WithMaxSegments(n int) Option {
+	return func(j *journalConfig) {
+		j.maxSegments = n
+	}
+}
+
+// WithEventBufferSize sets the size of the channel on which the journal
+// receives events. The store provider waits for the journal when this buffer
+// is full, so a larger buffer absorbs bursts of events.
+//
+// The default value is 10000.
+func WithEventBufferSize(size int) Option {
+	return func(j *journalConfig) {
+		j.eventBufferSize = size
+	}
+}
+
+// WithFlushInterval sets the interval on which the buffered records are
+// written to the current segment file. Records are also written before the
+// journal is queried and when it stops.
+//
+// The default value is 1 second.
+func WithFlushInterval(interval time.Duration) Option {
+	return func(j *journalConfig) {
+		j.flushInterval = interval
+	}
+}
@@ -0,0 +1,234 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+const (
+	streamKind = "stream"
+	entityKind = "entity"
+)
+
+// record is a single line of a segment file. The data of the event is kept
+// raw so that entries can be filtered without decoding their events.
+type record struct {
+	Seq      uint64          `json:"seq"`
+	Time     time.Time       `json:"time"`
+	Kind     string          `json:"kind"`
+	StreamID int             `json:"streamID"`
+	EntityID uint64          `json:"entityID"`
+	Type     string          `json:"type"`
+	Data     json.RawMessage `json:"data"`
+}
+
+var streamEventTypes = eventTypes(
+	models.AddStream{},
+	models.RemoveStream{},
+	models.UpdateIDs{},
+	models.UpdateMap{},
+	models.UpdateCraftingInfo{},
+	models.UpdateEnmity{},
+	models.UpdateStats{},
+	models.UpdateStreamStatus{},
+	models.ChatEvent{},
+	models.HookMessage{},
+)
+
+var entityEventTypes = eventTypes(
+	models.AddEntity{},
+	models.RemoveEntity{},
+	models.SetEntities{},
+	models.UpdateTarget{},
+	models.UpdateClass{},
+	models.UpdateLastAction{},
+	models.UpdateCastingInfo{},
+	models.UpsertStatus{},
+	models.RemoveStatus{},
+	models.UpdateLocation{},
+	models.UpdateResources{},
+	models.UpdateLockonMarker{},
+)
+
+func eventTypes(events ...interface{}) map[string]reflect.Type {
+	types := make(map[string]reflect.Type)
+	for _, e := range events {
+		t := reflect.TypeOf(e)
+		types[t.Name()] = t
+	}
+	return types
+}
+
+// typeName returns the name under which the event type is recorded, or an
+// empty string if events of this type cannot be read back.
+func typeName(kind string, event interface{}) string {
+	t := reflect.TypeOf(event)
+	if t == nil {
+		return ""
+	}
+	types := streamEventTypes
+	if kind == entityKind {
+		types = entityEventTypes
+	}
+	if types[t.Name()] != t {
+		return ""
+	}
+	return t.Name()
+}
+
+// entry decodes the event of the record into a journal entry
+func (r *record) entry() (models.JournalEntry, error) {
+	e := models.JournalEntry{
+		Cursor: formatCursor(r.Seq),
+		Time:   r.Time,
+	}
+	types := streamEventTypes
+	if r.Kind == entityKind {
+		types = entityEventTypes
+	}
+	t, ok := types[r.Type]
+	if !ok {
+		return e, fmt.Errorf("unknown %s event type %q", r.Kind, r.Type)
+	}
+	v := reflect.New(t)
+	if err := json.Unmarshal(r.Data, v.Interface()); err != nil {
+		return e, err
+	}
+	if r.Kind == entityKind {
+		e.EntityEvent = &models.EntityEvent{
+			StreamID: r.StreamID,
+			EntityID: r.EntityID,
+			Type:     v.Elem().Interface().(models.EntityEventType),
+		}
+	} else {
+		e.StreamEvent = &models.StreamEvent{
+			StreamID: r.StreamID,
+			Type:     v.Elem().Interface().(models.StreamEventType),
+		}
+	}
+	return e, nil
+}
+
+// segment indexes the records of a segment file, so that queries can skip
+// the files that cannot contain any matching events
+type segment struct {
+	path string
+	size int64
+
+	firstSeq  uint64
+	lastSeq   uint64
+	firstTime time.Time
+	lastTime  time.Time
+	streams   map[int]struct{}
+	types     map[string]struct{}
+}
+
+func segmentPath(dir string, firstSeq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("events-%020d.jsonl", firstSeq))
+}
+
+func newSegment(path string) *segment {
+	return &segment{
+		path:    path,
+		streams: make(map[int]struct{}),
+		types:   make(map[string]struct{}),
+	}
+}
+
+// empty returns true if no records have been added to the segment
+func (s *segment) empty() bool {
+	return s.lastSeq == 0
+}
+
+// add indexes a record of the given size in bytes
+func (s *segment) add(r *record, size int64) {
+	if s.empty() {
+		s.firstSeq = r.Seq
+		s.firstTime = r.Time
+	}
+	s.lastSeq = r.Seq
+	s.lastTime = r.Time
+	s.streams[r.StreamID] = struct{}{}
+	s.types[r.Type] = struct{}{}
+	s.size += size
+}
+
+// matches returns true if the segment may contain records matching the query
+func (s *segment) matches(q *query) bool {
+	if s.empty() || s.lastSeq <= q.after {
+		return false
+	}
+	if _, ok := s.streams[q.streamID]; !ok {
+		return false
+	}
+	if q.from != nil && s.lastTime.Before(*q.from) {
+		return false
+	}
+	if q.to != nil && !s.firstTime.Before(*q.to) {
+		return false
+	}
+	if len(q.types) == 0 {
+		return true
+	}
+	for t := range q.types {
+		if _, ok := s.types[t]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// scanSegment reads the records of a segment file, up to size bytes, and
+// calls fn for each of them until fn returns false. It returns the number of
+// bytes taken by the complete records, so a record cut short by a crash is
+// ignored.
+func scanSegment(path string, size int64, fn func(r *record, size int64) bool) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var src io.Reader = f
+	if size >= 0 {
+		src = io.LimitReader(f, size)
+	}
+	reader := bufio.NewReader(src)
+	var read int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return read, nil
+		}
+		if err != nil {
+			return read, err
+		}
+		var r record
+		if err := json.Unmarshal(line, &r); err != nil {
+			return read, nil
+		}
+		read += int64(len(line))
+		if !fn(&r, int64(len(line))) {
+			return read, nil
+		}
+	}
+}
+
+// loadSegment rebuilds the index of an existing segment file
+func loadSegment(path string) (*segment, error) {
+	s := newSegment(path)
+	_, err := scanSegment(path, -1, func(r *record, size int64) bool {
+		s.add(r, size)
+		return true
+	})
+	return s, err
+}
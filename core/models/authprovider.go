@@ -6,4 +6,5 @@ import "context"
 // that handles creation of auth tokens and authorization of them.
 type AuthProvider interface {
 	AuthorizePluginToken(ctx context.Context) error
+	AuthorizeAdmin(ctx context.Context) error
 }
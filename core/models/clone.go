@@ -11,6 +11,16 @@ func (s Stream) Clone() Stream {
 		s.CraftingInfo = &craftInfoClone
 	}
 
+	if s.Source != nil {
+		sourceClone := *s.Source
+		s.Source = &sourceClone
+	}
+
+	if s.Status != nil {
+		statusClone := *s.Status
+		s.Status = &statusClone
+	}
+
 	if len(s.EntitiesMap) > 0 {
 		entitiesMap := make(map[uint64]*Entity)
 		for id, ent := range s.EntitiesMap {
@@ -24,6 +34,18 @@ func (s Stream) Clone() Stream {
 		s.EntitiesMap = entitiesMap
 	}
 
+	if len(s.Departed) > 0 {
+		departed := make([]DepartedEntity, len(s.Departed))
+		for i, d := range s.Departed {
+			if d.Entity != nil {
+				entClone := d.Entity.Clone()
+				d.Entity = &entClone
+			}
+			departed[i] = d
+		}
+		s.Departed = departed
+	}
+
 	return s
 }
 
@@ -73,6 +95,11 @@ func (e Entity) Clone() Entity {
 		e.CastingInfo = &castingInfoClone
 	}
 
+	if e.Resources != nil {
+		resourcesClone := *e.Resources
+		e.Resources = &resourcesClone
+	}
+
 	if len(e.Statuses) > 0 {
 		statuses := make([]*Status, len(e.Statuses))
 		for i, v := range e.Statuses {
@@ -115,3 +142,122 @@ func (a Action) Clone() Action {
 	}
 	return a
 }
+
+// Clone returns a deep copy of the StreamEvent struct. Any changes made to the
+// store after the event was emitted should not affect this copy.
+func (e StreamEvent) Clone() StreamEvent {
+	switch t := e.Type.(type) {
+	case AddStream:
+		if t.Stream != nil {
+			streamClone := t.Stream.Clone()
+			t.Stream = &streamClone
+		}
+		e.Type = t
+	case UpdateIDs:
+		t.HomeWorld = cloneWorld(t.HomeWorld)
+		t.CurrentWorld = cloneWorld(t.CurrentWorld)
+		e.Type = t
+	case UpdateMap:
+		if t.Place != nil {
+			placeClone := t.Place.Clone()
+			t.Place = &placeClone
+		}
+		e.Type = t
+	case UpdateCraftingInfo:
+		if t.CraftingInfo != nil {
+			craftingInfoClone := *t.CraftingInfo
+			t.CraftingInfo = &craftingInfoClone
+		}
+		e.Type = t
+	case UpdateEnmity:
+		if t.Enmity != nil {
+			enmityClone := t.Enmity.Clone()
+			t.Enmity = &enmityClone
+		}
+		e.Type = t
+	case UpdateStats:
+		if t.Stats != nil {
+			statsClone := *t.Stats
+			t.Stats = &statsClone
+		}
+		e.Type = t
+	case UpdateStreamStatus:
+		if t.Status != nil {
+			statusClone := *t.Status
+			t.Status = &statusClone
+		}
+		e.Type = t
+	case ChatEvent:
+		t.ChannelWorld = cloneWorld(t.ChannelWorld)
+		t.World = cloneWorld(t.World)
+		e.Type = t
+	}
+	return e
+}
+
+// Clone returns a deep copy of the EntityEvent struct. Any changes made to the
+// store after the event was emitted should not affect this copy.
+func (e EntityEvent) Clone() EntityEvent {
+	switch t := e.Type.(type) {
+	case AddEntity:
+		if t.Entity != nil {
+			entityClone := t.Entity.Clone()
+			t.Entity = &entityClone
+		}
+		e.Type = t
+	case SetEntities:
+		if len(t.Entities) > 0 {
+			entities := make([]Entity, len(t.Entities))
+			for i, entity := range t.Entities {
+				entities[i] = entity.Clone()
+			}
+			t.Entities = entities
+		}
+		e.Type = t
+	case UpdateCastingInfo:
+		if t.CastingInfo != nil {
+			castingInfoClone := *t.CastingInfo
+			t.CastingInfo = &castingInfoClone
+		}
+		e.Type = t
+	case UpdateClass:
+		if t.ClassJob != nil {
+			classJobClone := *t.ClassJob
+			t.ClassJob = &classJobClone
+		}
+		e.Type = t
+	case UpdateLastAction:
+		if t.Action != nil {
+			actionClone := t.Action.Clone()
+			t.Action = &actionClone
+		}
+		e.Type = t
+	case UpdateLocation:
+		if t.Location != nil {
+			locationClone := *t.Location
+			t.Location = &locationClone
+		}
+		e.Type = t
+	case UpdateResources:
+		if t.Resources != nil {
+			resourcesClone := *t.Resources
+			t.Resources = &resourcesClone
+		}
+		e.Type = t
+	case UpsertStatus:
+		if t.Status != nil {
+			statusClone := *t.Status
+			t.Status = &statusClone
+		}
+		e.Type = t
+	}
+	return e
+}
+
+func cloneWorld(w *World) *World {
+	if w == nil {
+		return nil
+	}
+	worldClone := *w
+	return &worldClone
+}
@@ -1,6 +1,8 @@
 package models_test
 
 import (
+	"time"
+
 	"github.com/ff14wed/aetherometer/core/models"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -34,6 +36,11 @@ var _ = Describe("Stream Clone", func() {
 			CraftingInfo: &models.CraftingInfo{
 				StepNum: 900,
 			},
+			Source: &models.StreamSource{Adapter: "Hook", Key: "1234"},
+			Status: &models.Health{
+				State:            models.HealthStateConnected,
+				PacketsPerSecond: 10,
+			},
 			EntitiesMap: map[uint64]*models.Entity{
 				1: {
 					ID: 1, Index: 2, Name: "FooBar",
@@ -48,11 +55,19 @@ var _ = Describe("Stream Clone", func() {
 						},
 					},
 					CastingInfo: &models.CastingInfo{ActionID: 100},
+					Resources:   &models.Resources{Hp: 100},
 					Statuses: []*models.Status{
 						{ID: 50},
 					},
 				},
 			},
+			Departed: []models.DepartedEntity{
+				{
+					Entity:      &models.Entity{ID: 3, Name: "Gone", Resources: &models.Resources{Hp: 10}},
+					DespawnTime: time.Unix(100, 0),
+					Reason:      models.DespawnReasonRemoved,
+				},
+			},
 		}
 	})
 
@@ -82,9 +97,21 @@ var _ = Describe("Stream Clone", func() {
 		Entry("stream.CraftingInfo", func(s *models.Stream) {
 			s.CraftingInfo.StepNum = 200
 		}),
+		Entry("stream.Source", func(s *models.Stream) {
+			s.Source.Key = "5678"
+		}),
+		Entry("stream.Status", func(s *models.Stream) {
+			s.Status.State = models.HealthStateDegraded
+		}),
 		Entry("stream.EntitiesMap", func(s *models.Stream) {
 			s.EntitiesMap[2] = &models.Entity{ID: 2, Name: "Baah", Index: 1}
 		}),
+		Entry("stream.Departed", func(s *models.Stream) {
+			s.Departed[0].Reason = models.DespawnReasonIndexReused
+		}),
+		Entry("DepartedEntity", func(s *models.Stream) {
+			s.Departed[0].Entity.Resources.Hp = 0
+		}),
 		Entry("Entity", func(s *models.Stream) {
 			s.EntitiesMap[1].Name = "BarFoo"
 		}),
@@ -108,6 +135,9 @@ var _ = Describe("Stream Clone", func() {
 		Entry("entity.CastingInfo", func(s *models.Stream) {
 			s.EntitiesMap[1].CastingInfo.ActionID = 101
 		}),
+		Entry("entity.Resources", func(s *models.Stream) {
+			s.EntitiesMap[1].Resources.Hp = 200
+		}),
 		Entry("entity.Statuses", func(s *models.Stream) {
 			s.EntitiesMap[1].Statuses[0].ID = 51
 		}),
@@ -142,3 +172,31 @@ var _ = Describe("Stream Clone", func() {
 		})
 	})
 })
+
+var _ = Describe("Event Clone", func() {
+	It("produces stream events that do not share data with the original", func() {
+		enmity := &models.Enmity{TargetHateRanking: []models.HateRanking{{ActorID: 1, Hate: 100}}}
+		event := models.StreamEvent{StreamID: 1234, Type: models.UpdateEnmity{Enmity: enmity}}
+		eventClone := event.Clone()
+		Expect(eventClone).To(Equal(event))
+
+		enmity.TargetHateRanking[0].Hate = 50
+		enmity.NearbyEnemyHate = []models.HateEntry{{EnemyID: 2}}
+		Expect(eventClone.Type.(models.UpdateEnmity).Enmity).To(Equal(&models.Enmity{
+			TargetHateRanking: []models.HateRanking{{ActorID: 1, Hate: 100}},
+		}))
+	})
+
+	It("produces entity events that do not share data with the original", func() {
+		entity := &models.Entity{ID: 1, Statuses: []*models.Status{{ID: 1}}}
+		event := models.EntityEvent{StreamID: 1234, EntityID: 1, Type: models.AddEntity{Entity: entity}}
+		eventClone := event.Clone()
+		Expect(eventClone).To(Equal(event))
+
+		entity.Statuses[0].ID = 2
+		entity.Name = "Changed"
+		Expect(eventClone.Type.(models.AddEntity).Entity).To(Equal(&models.Entity{
+			ID: 1, Statuses: []*models.Status{{ID: 1}},
+		}))
+	})
+})
@@ -32,7 +32,55 @@ type Stream struct {
 
 	Stats *Stats `json:"stats"`
 
+	Source *StreamSource `json:"source"`
+	Status *Health       `json:"status"`
+
+	// Restored is true if the state of the stream was restored from a
+	// snapshot, in which case it may be stale until the next zone change.
+	Restored bool `json:"restored"`
+
 	EntitiesMap map[uint64]*Entity `json:"entities"`
+
+	// Departed holds the most recently removed entities of the stream, oldest
+	// first. Departed entities are never modified.
+	Departed []DepartedEntity `json:"departed"`
+}
+
+// MaxDepartedEntities is the number of departed entities kept for each
+// stream. The oldest departed entities are discarded first.
+const MaxDepartedEntities = 100
+
+// DepartEntity removes the entity identified by entityID from the stream and
+// records it as departed at time t for the provided reason. It returns false
+// if the entity was not in the stream.
+func (s *Stream) DepartEntity(entityID uint64, reason DespawnReason, t time.Time) bool {
+	e, found := s.EntitiesMap[entityID]
+	delete(s.EntitiesMap, entityID)
+	if !found || e == nil {
+		return false
+	}
+	// Discarding from the front never modifies the entries that are kept, so
+	// copies of this slice remain valid.
+	if n := len(s.Departed) + 1 - MaxDepartedEntities; n > 0 {
+		s.Departed = s.Departed[n:]
+	}
+	s.Departed = append(s.Departed, DepartedEntity{
+		Entity:      e,
+		DespawnTime: t,
+		Reason:      reason,
+	})
+	return true
+}
+
+// HasDeparted returns true if the entity identified by entityID is one of the
+// departed entities of the stream.
+func (s *Stream) HasDeparted(entityID uint64) bool {
+	for _, d := range s.Departed {
+		if d.Entity.ID == entityID {
+			return true
+		}
+	}
+	return false
 }
 
 // Entities returns all the entities from the stream, sorted in order by index.
@@ -58,9 +106,33 @@ func MarshalTimestamp(t time.Time) graphql.Marshaler {
 	})
 }
 
-// UnmarshalTimestamp is currently unimplemented.
+// UnmarshalTimestamp converts the provided time in milliseconds since the
+// Unix epoch to a time.
 func UnmarshalTimestamp(v interface{}) (time.Time, error) {
-	panic("not implemented")
+	var ms int64
+	switch v := v.(type) {
+	case string:
+		var err error
+		ms, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+	case int:
+		ms = int64(v)
+	case int32:
+		ms = int64(v)
+	case int64:
+		ms = v
+	case json.Number:
+		var err error
+		ms, err = v.Int64()
+		if err != nil {
+			return time.Time{}, err
+		}
+	default:
+		return time.Time{}, fmt.Errorf("%T is not a supported timestamp type", v)
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), nil
 }
 
 func getTimeInMs(t time.Time) int64 {
@@ -33,6 +33,45 @@ var _ = Describe("Custom Types", func() {
 				}))
 			})
 		})
+
+		Describe("DepartEntity", func() {
+			It("moves the entity to the departed entities of the stream", func() {
+				entity := stream.EntitiesMap[1]
+				t := time.Unix(100, 0)
+				Expect(stream.DepartEntity(1, models.DespawnReasonRemoved, t)).To(BeTrue())
+				Expect(stream.EntitiesMap).ToNot(HaveKey(uint64(1)))
+				Expect(stream.Departed).To(Equal([]models.DepartedEntity{
+					{Entity: entity, DespawnTime: t, Reason: models.DespawnReasonRemoved},
+				}))
+			})
+
+			It("returns false if the entity is not in the stream", func() {
+				Expect(stream.DepartEntity(3, models.DespawnReasonRemoved, time.Unix(100, 0))).To(BeFalse())
+				Expect(stream.DepartEntity(4, models.DespawnReasonRemoved, time.Unix(100, 0))).To(BeFalse())
+				Expect(stream.EntitiesMap).ToNot(HaveKey(uint64(3)))
+				Expect(stream.Departed).To(BeEmpty())
+			})
+
+			It("discards the oldest departed entities beyond the maximum", func() {
+				for i := 0; i < models.MaxDepartedEntities+5; i++ {
+					id := uint64(100 + i)
+					stream.EntitiesMap[id] = &models.Entity{ID: id}
+					Expect(stream.DepartEntity(id, models.DespawnReasonIndexReused, time.Unix(int64(i), 0))).To(BeTrue())
+				}
+				Expect(stream.Departed).To(HaveLen(models.MaxDepartedEntities))
+				Expect(stream.Departed[0].Entity.ID).To(Equal(uint64(105)))
+				Expect(stream.Departed[models.MaxDepartedEntities-1].Entity.ID).To(Equal(uint64(100 + models.MaxDepartedEntities + 4)))
+			})
+		})
+
+		Describe("HasDeparted", func() {
+			It("returns true only for the departed entities of the stream", func() {
+				Expect(stream.DepartEntity(1, models.DespawnReasonRemoved, time.Unix(100, 0))).To(BeTrue())
+				Expect(stream.HasDeparted(1)).To(BeTrue())
+				Expect(stream.HasDeparted(2)).To(BeFalse())
+				Expect(stream.HasDeparted(3)).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("Timestamp", func() {
@@ -43,6 +82,29 @@ var _ = Describe("Custom Types", func() {
 			m.MarshalGQL(b)
 			Expect(b.String()).To(Equal("101302"))
 		})
+
+		It("unmarshals the time since the Unix epoch in milliseconds to the expected time", func() {
+			t, err := models.UnmarshalTimestamp("101302")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t).To(BeTemporally("==", time.Unix(101, 302000000)))
+		})
+
+		It("unmarshals the JSON number to the expected time", func() {
+			t, err := models.UnmarshalTimestamp(json.Number("101302"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t).To(BeTemporally("==", time.Unix(101, 302000000)))
+		})
+
+		It("unmarshals the integers to the expected time", func() {
+			t, err := models.UnmarshalTimestamp(int64(101302))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(t).To(BeTemporally("==", time.Unix(101, 302000000)))
+		})
+
+		It("errors if the data is not an integer type", func() {
+			_, err := models.UnmarshalTimestamp(1.2)
+			Expect(err).To(MatchError(MatchRegexp(`.* is not a supported timestamp type`)))
+		})
 	})
 
 	Describe("Uint", func() {
@@ -3,6 +3,9 @@
 package models
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
@@ -41,6 +44,11 @@ type ActionEffect struct {
 	Value           int    `json:"value"`
 }
 
+type Adapter struct {
+	Name   string  `json:"name"`
+	Health *Health `json:"health"`
+}
+
 type AddEntity struct {
 	Entity *Entity `json:"entity" validate:"nil=false"`
 }
@@ -104,6 +112,12 @@ type CraftingInfo struct {
 	ReuseProc           bool        `json:"reuseProc"`
 }
 
+type DepartedEntity struct {
+	Entity      *Entity       `json:"entity" validate:"nil=false"`
+	DespawnTime time.Time     `json:"despawnTime"`
+	Reason      DespawnReason `json:"reason"`
+}
+
 type Enmity struct {
 	TargetHateRanking []HateRanking `json:"targetHateRanking"`
 	NearbyEnemyHate   []HateEntry   `json:"nearbyEnemyHate"`
@@ -146,6 +160,35 @@ type HateRanking struct {
 	Hate    int    `json:"hate"`
 }
 
+type Health struct {
+	State            HealthState `json:"state"`
+	LastError        *string     `json:"lastError"`
+	LastPacketTime   *time.Time  `json:"lastPacketTime"`
+	PingRtt          *float64    `json:"pingRTT"`
+	PacketsPerSecond float64     `json:"packetsPerSecond"`
+	DiscardedBytes   int         `json:"discardedBytes"`
+}
+
+type HookMessage struct {
+	Time     time.Time           `json:"time"`
+	Severity HookMessageSeverity `json:"severity"`
+	Message  string              `json:"message"`
+}
+
+func (HookMessage) IsStreamEventType() {}
+
+type JournalEntry struct {
+	Cursor      string       `json:"cursor"`
+	Time        time.Time    `json:"time"`
+	StreamEvent *StreamEvent `json:"streamEvent"`
+	EntityEvent *EntityEvent `json:"entityEvent"`
+}
+
+type JournalPage struct {
+	Entries    []JournalEntry `json:"entries"`
+	NextCursor *string        `json:"nextCursor"`
+}
+
 type Location struct {
 	X           float64   `json:"x"`
 	Y           float64   `json:"y"`
@@ -174,12 +217,34 @@ type NPCInfo struct {
 	Error   int      `json:"error"`
 }
 
+type OpcodeMapping struct {
+	Direction OpcodeDirection `json:"direction"`
+	Channel   OpcodeChannel   `json:"channel"`
+	Opcode    int             `json:"opcode"`
+	Datatype  string          `json:"datatype"`
+	Source    OpcodeSource    `json:"source"`
+}
+
+type OpcodeTable struct {
+	File      *string         `json:"file"`
+	LoadedAt  *time.Time      `json:"loadedAt"`
+	LastError *string         `json:"lastError"`
+	Mappings  []OpcodeMapping `json:"mappings"`
+}
+
 type Place struct {
 	MapID       int       `json:"mapID"`
 	TerritoryID int       `json:"territoryID"`
 	Maps        []MapInfo `json:"maps"`
 }
 
+type ProcessCandidate struct {
+	Pid      int    `json:"pid"`
+	Adapter  string `json:"adapter"`
+	Rule     string `json:"rule"`
+	StreamID *int   `json:"streamID"`
+}
+
 type RecipeInfo struct {
 	ID          int    `json:"id"`
 	Name        string `json:"name"`
@@ -193,7 +258,9 @@ type RecipeInfo struct {
 }
 
 type RemoveEntity struct {
-	ID uint64 `json:"id"`
+	ID     uint64        `json:"id"`
+	Reason DespawnReason `json:"reason"`
+	Time   time.Time     `json:"time"`
 }
 
 func (RemoveEntity) IsEntityEventType() {}
@@ -269,6 +336,12 @@ type Status struct {
 	LastTick    time.Time `json:"lastTick"`
 }
 
+type StreamCommand struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Schema      string `json:"schema"`
+}
+
 type StreamEvent struct {
 	StreamID int             `json:"streamID"`
 	Type     StreamEventType `json:"type"`
@@ -279,6 +352,17 @@ type StreamRequest struct {
 	Data     string `json:"data"`
 }
 
+type StreamSource struct {
+	Adapter string `json:"adapter"`
+	Key     string `json:"key"`
+}
+
+type ThrottleStat struct {
+	Datatype string         `json:"datatype"`
+	Policy   ThrottlePolicy `json:"policy"`
+	Dropped  int            `json:"dropped"`
+}
+
 type UpdateCastingInfo struct {
 	CastingInfo *CastingInfo `json:"castingInfo"`
 }
@@ -350,6 +434,12 @@ type UpdateStats struct {
 
 func (UpdateStats) IsStreamEventType() {}
 
+type UpdateStreamStatus struct {
+	Status *Health `json:"status" validate:"nil=false"`
+}
+
+func (UpdateStreamStatus) IsStreamEventType() {}
+
 type UpdateTarget struct {
 	TargetID uint64 `json:"targetID"`
 }
@@ -367,3 +457,302 @@ type World struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
+
+type DespawnReason string
+
+const (
+	DespawnReasonIndexReused DespawnReason = "INDEX_REUSED"
+	DespawnReasonRemoved     DespawnReason = "REMOVED"
+)
+
+var AllDespawnReason = []DespawnReason{
+	DespawnReasonIndexReused,
+	DespawnReasonRemoved,
+}
+
+func (e DespawnReason) IsValid() bool {
+	switch e {
+	case DespawnReasonIndexReused, DespawnReasonRemoved:
+		return true
+	}
+	return false
+}
+
+func (e DespawnReason) String() string {
+	return string(e)
+}
+
+func (e *DespawnReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DespawnReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DespawnReason", str)
+	}
+	return nil
+}
+
+func (e DespawnReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type HealthState string
+
+const (
+	HealthStateConnecting   HealthState = "CONNECTING"
+	HealthStateConnected    HealthState = "CONNECTED"
+	HealthStateDegraded     HealthState = "DEGRADED"
+	HealthStateReconnecting HealthState = "RECONNECTING"
+	HealthStateClosed       HealthState = "CLOSED"
+)
+
+var AllHealthState = []HealthState{
+	HealthStateConnecting,
+	HealthStateConnected,
+	HealthStateDegraded,
+	HealthStateReconnecting,
+	HealthStateClosed,
+}
+
+func (e HealthState) IsValid() bool {
+	switch e {
+	case HealthStateConnecting, HealthStateConnected, HealthStateDegraded, HealthStateReconnecting, HealthStateClosed:
+		return true
+	}
+	return false
+}
+
+func (e HealthState) String() string {
+	return string(e)
+}
+
+func (e *HealthState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HealthState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HealthState", str)
+	}
+	return nil
+}
+
+func (e HealthState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type HookMessageSeverity string
+
+const (
+	HookMessageSeverityDebug HookMessageSeverity = "DEBUG"
+	HookMessageSeverityInfo  HookMessageSeverity = "INFO"
+	HookMessageSeverityWarn  HookMessageSeverity = "WARN"
+	HookMessageSeverityError HookMessageSeverity = "ERROR"
+)
+
+var AllHookMessageSeverity = []HookMessageSeverity{
+	HookMessageSeverityDebug,
+	HookMessageSeverityInfo,
+	HookMessageSeverityWarn,
+	HookMessageSeverityError,
+}
+
+func (e HookMessageSeverity) IsValid() bool {
+	switch e {
+	case HookMessageSeverityDebug, HookMessageSeverityInfo, HookMessageSeverityWarn, HookMessageSeverityError:
+		return true
+	}
+	return false
+}
+
+func (e HookMessageSeverity) String() string {
+	return string(e)
+}
+
+func (e *HookMessageSeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HookMessageSeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HookMessageSeverity", str)
+	}
+	return nil
+}
+
+func (e HookMessageSeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OpcodeChannel string
+
+const (
+	OpcodeChannelZone OpcodeChannel = "ZONE"
+	OpcodeChannelChat OpcodeChannel = "CHAT"
+)
+
+var AllOpcodeChannel = []OpcodeChannel{
+	OpcodeChannelZone,
+	OpcodeChannelChat,
+}
+
+func (e OpcodeChannel) IsValid() bool {
+	switch e {
+	case OpcodeChannelZone, OpcodeChannelChat:
+		return true
+	}
+	return false
+}
+
+func (e OpcodeChannel) String() string {
+	return string(e)
+}
+
+func (e *OpcodeChannel) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OpcodeChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OpcodeChannel", str)
+	}
+	return nil
+}
+
+func (e OpcodeChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OpcodeDirection string
+
+const (
+	OpcodeDirectionIngress OpcodeDirection = "INGRESS"
+	OpcodeDirectionEgress  OpcodeDirection = "EGRESS"
+)
+
+var AllOpcodeDirection = []OpcodeDirection{
+	OpcodeDirectionIngress,
+	OpcodeDirectionEgress,
+}
+
+func (e OpcodeDirection) IsValid() bool {
+	switch e {
+	case OpcodeDirectionIngress, OpcodeDirectionEgress:
+		return true
+	}
+	return false
+}
+
+func (e OpcodeDirection) String() string {
+	return string(e)
+}
+
+func (e *OpcodeDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OpcodeDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OpcodeDirection", str)
+	}
+	return nil
+}
+
+func (e OpcodeDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OpcodeSource string
+
+const (
+	OpcodeSourceCompiled OpcodeSource = "COMPILED"
+	OpcodeSourceOverride OpcodeSource = "OVERRIDE"
+)
+
+var AllOpcodeSource = []OpcodeSource{
+	OpcodeSourceCompiled,
+	OpcodeSourceOverride,
+}
+
+func (e OpcodeSource) IsValid() bool {
+	switch e {
+	case OpcodeSourceCompiled, OpcodeSourceOverride:
+		return true
+	}
+	return false
+}
+
+func (e OpcodeSource) String() string {
+	return string(e)
+}
+
+func (e *OpcodeSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OpcodeSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OpcodeSource", str)
+	}
+	return nil
+}
+
+func (e OpcodeSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ThrottlePolicy string
+
+const (
+	ThrottlePolicyPass   ThrottlePolicy = "PASS"
+	ThrottlePolicyDedup  ThrottlePolicy = "DEDUP"
+	ThrottlePolicyLatest ThrottlePolicy = "LATEST"
+)
+
+var AllThrottlePolicy = []ThrottlePolicy{
+	ThrottlePolicyPass,
+	ThrottlePolicyDedup,
+	ThrottlePolicyLatest,
+}
+
+func (e ThrottlePolicy) IsValid() bool {
+	switch e {
+	case ThrottlePolicyPass, ThrottlePolicyDedup, ThrottlePolicyLatest:
+		return true
+	}
+	return false
+}
+
+func (e ThrottlePolicy) String() string {
+	return string(e)
+}
+
+func (e *ThrottlePolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ThrottlePolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ThrottlePolicy", str)
+	}
+	return nil
+}
+
+func (e ThrottlePolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -73,6 +73,11 @@ type ComplexityRoot struct {
 		ValueMultiplier func(childComplexity int) int
 	}
 
+	Adapter struct {
+		Health func(childComplexity int) int
+		Name   func(childComplexity int) int
+	}
+
 	AddEntity struct {
 		Entity func(childComplexity int) int
 	}
@@ -130,6 +135,12 @@ type ComplexityRoot struct {
 		StepNum             func(childComplexity int) int
 	}
 
+	DepartedEntity struct {
+		DespawnTime func(childComplexity int) int
+		Entity      func(childComplexity int) int
+		Reason      func(childComplexity int) int
+	}
+
 	Enmity struct {
 		NearbyEnemyHate   func(childComplexity int) int
 		TargetHateRanking func(childComplexity int) int
@@ -172,6 +183,33 @@ type ComplexityRoot struct {
 		Hate    func(childComplexity int) int
 	}
 
+	Health struct {
+		DiscardedBytes   func(childComplexity int) int
+		LastError        func(childComplexity int) int
+		LastPacketTime   func(childComplexity int) int
+		PacketsPerSecond func(childComplexity int) int
+		PingRtt          func(childComplexity int) int
+		State            func(childComplexity int) int
+	}
+
+	HookMessage struct {
+		Message  func(childComplexity int) int
+		Severity func(childComplexity int) int
+		Time     func(childComplexity int) int
+	}
+
+	JournalEntry struct {
+		Cursor      func(childComplexity int) int
+		EntityEvent func(childComplexity int) int
+		StreamEvent func(childComplexity int) int
+		Time        func(childComplexity int) int
+	}
+
+	JournalPage struct {
+		Entries    func(childComplexity int) int
+		NextCursor func(childComplexity int) int
+	}
+
 	Location struct {
 		LastUpdated func(childComplexity int) int
 		Orientation func(childComplexity int) int
@@ -192,6 +230,8 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
+		AttachProcess     func(childComplexity int, pid int) int
+		DetachProcess     func(childComplexity int, streamID int) int
 		SendStreamRequest func(childComplexity int, request StreamRequest) int
 	}
 
@@ -204,17 +244,46 @@ type ComplexityRoot struct {
 		Size    func(childComplexity int) int
 	}
 
+	OpcodeMapping struct {
+		Channel   func(childComplexity int) int
+		Datatype  func(childComplexity int) int
+		Direction func(childComplexity int) int
+		Opcode    func(childComplexity int) int
+		Source    func(childComplexity int) int
+	}
+
+	OpcodeTable struct {
+		File      func(childComplexity int) int
+		LastError func(childComplexity int) int
+		LoadedAt  func(childComplexity int) int
+		Mappings  func(childComplexity int) int
+	}
+
 	Place struct {
 		MapID       func(childComplexity int) int
 		Maps        func(childComplexity int) int
 		TerritoryID func(childComplexity int) int
 	}
 
+	ProcessCandidate struct {
+		Adapter  func(childComplexity int) int
+		Pid      func(childComplexity int) int
+		Rule     func(childComplexity int) int
+		StreamID func(childComplexity int) int
+	}
+
 	Query struct {
-		APIVersion func(childComplexity int) int
-		Entity     func(childComplexity int, streamID int, entityID uint64) int
-		Stream     func(childComplexity int, streamID int) int
-		Streams    func(childComplexity int) int
+		APIVersion         func(childComplexity int) int
+		Adapters           func(childComplexity int) int
+		CandidateProcesses func(childComplexity int) int
+		Entity             func(childComplexity int, streamID int, entityID uint64, at *time.Time) int
+		Events             func(childComplexity int, streamID int, from *time.Time, to *time.Time, types []string, entityID *uint64, after *string, first *int) int
+		HookMessages       func(childComplexity int, streamID int) int
+		OpcodeTable        func(childComplexity int) int
+		Stream             func(childComplexity int, streamID int, at *time.Time) int
+		StreamCommands     func(childComplexity int, streamID int) int
+		Streams            func(childComplexity int) int
+		ThrottleStats      func(childComplexity int, streamID int) int
 	}
 
 	RecipeInfo struct {
@@ -230,7 +299,9 @@ type ComplexityRoot struct {
 	}
 
 	RemoveEntity struct {
-		ID func(childComplexity int) int
+		ID     func(childComplexity int) int
+		Reason func(childComplexity int) int
+		Time   func(childComplexity int) int
 	}
 
 	RemoveStatus struct {
@@ -302,14 +373,24 @@ type ComplexityRoot struct {
 		CharacterID  func(childComplexity int) int
 		CraftingInfo func(childComplexity int) int
 		CurrentWorld func(childComplexity int) int
+		Departed     func(childComplexity int) int
 		Enmity       func(childComplexity int) int
 		Entities     func(childComplexity int) int
 		HomeWorld    func(childComplexity int) int
 		ID           func(childComplexity int) int
 		InstanceNum  func(childComplexity int) int
 		Place        func(childComplexity int) int
+		Restored     func(childComplexity int) int
 		ServerID     func(childComplexity int) int
+		Source       func(childComplexity int) int
 		Stats        func(childComplexity int) int
+		Status       func(childComplexity int) int
+	}
+
+	StreamCommand struct {
+		Description func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Schema      func(childComplexity int) int
 	}
 
 	StreamEvent struct {
@@ -317,11 +398,22 @@ type ComplexityRoot struct {
 		Type     func(childComplexity int) int
 	}
 
+	StreamSource struct {
+		Adapter func(childComplexity int) int
+		Key     func(childComplexity int) int
+	}
+
 	Subscription struct {
 		EntityEvent func(childComplexity int) int
 		StreamEvent func(childComplexity int) int
 	}
 
+	ThrottleStat struct {
+		Datatype func(childComplexity int) int
+		Dropped  func(childComplexity int) int
+		Policy   func(childComplexity int) int
+	}
+
 	UpdateCastingInfo struct {
 		CastingInfo func(childComplexity int) int
 	}
@@ -371,6 +463,10 @@ type ComplexityRoot struct {
 		Stats func(childComplexity int) int
 	}
 
+	UpdateStreamStatus struct {
+		Status func(childComplexity int) int
+	}
+
 	UpdateTarget struct {
 		TargetID func(childComplexity int) int
 	}
@@ -388,12 +484,21 @@ type ComplexityRoot struct {
 
 type MutationResolver interface {
 	SendStreamRequest(ctx context.Context, request StreamRequest) (string, error)
+	AttachProcess(ctx context.Context, pid int) (bool, error)
+	DetachProcess(ctx context.Context, streamID int) (bool, error)
 }
 type QueryResolver interface {
 	APIVersion(ctx context.Context) (string, error)
 	Streams(ctx context.Context) ([]Stream, error)
-	Stream(ctx context.Context, streamID int) (*Stream, error)
-	Entity(ctx context.Context, streamID int, entityID uint64) (*Entity, error)
+	Stream(ctx context.Context, streamID int, at *time.Time) (*Stream, error)
+	Entity(ctx context.Context, streamID int, entityID uint64, at *time.Time) (*Entity, error)
+	Adapters(ctx context.Context) ([]Adapter, error)
+	StreamCommands(ctx context.Context, streamID int) ([]StreamCommand, error)
+	OpcodeTable(ctx context.Context) (*OpcodeTable, error)
+	ThrottleStats(ctx context.Context, streamID int) ([]ThrottleStat, error)
+	HookMessages(ctx context.Context, streamID int) ([]HookMessage, error)
+	CandidateProcesses(ctx context.Context) ([]ProcessCandidate, error)
+	Events(ctx context.Context, streamID int, from *time.Time, to *time.Time, types []string, entityID *uint64, after *string, first *int) (*JournalPage, error)
 }
 type SubscriptionResolver interface {
 	StreamEvent(ctx context.Context) (<-chan *StreamEvent, error)
@@ -562,6 +667,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ActionEffect.ValueMultiplier(childComplexity), true
 
+	case "Adapter.health":
+		if e.complexity.Adapter.Health == nil {
+			break
+		}
+
+		return e.complexity.Adapter.Health(childComplexity), true
+
+	case "Adapter.name":
+		if e.complexity.Adapter.Name == nil {
+			break
+		}
+
+		return e.complexity.Adapter.Name(childComplexity), true
+
 	case "AddEntity.entity":
 		if e.complexity.AddEntity.Entity == nil {
 			break
@@ -835,6 +954,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CraftingInfo.StepNum(childComplexity), true
 
+	case "DepartedEntity.despawnTime":
+		if e.complexity.DepartedEntity.DespawnTime == nil {
+			break
+		}
+
+		return e.complexity.DepartedEntity.DespawnTime(childComplexity), true
+
+	case "DepartedEntity.entity":
+		if e.complexity.DepartedEntity.Entity == nil {
+			break
+		}
+
+		return e.complexity.DepartedEntity.Entity(childComplexity), true
+
+	case "DepartedEntity.reason":
+		if e.complexity.DepartedEntity.Reason == nil {
+			break
+		}
+
+		return e.complexity.DepartedEntity.Reason(childComplexity), true
+
 	case "Enmity.nearbyEnemyHate":
 		if e.complexity.Enmity.NearbyEnemyHate == nil {
 			break
@@ -1024,6 +1164,111 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HateRanking.Hate(childComplexity), true
 
+	case "Health.discardedBytes":
+		if e.complexity.Health.DiscardedBytes == nil {
+			break
+		}
+
+		return e.complexity.Health.DiscardedBytes(childComplexity), true
+
+	case "Health.lastError":
+		if e.complexity.Health.LastError == nil {
+			break
+		}
+
+		return e.complexity.Health.LastError(childComplexity), true
+
+	case "Health.lastPacketTime":
+		if e.complexity.Health.LastPacketTime == nil {
+			break
+		}
+
+		return e.complexity.Health.LastPacketTime(childComplexity), true
+
+	case "Health.packetsPerSecond":
+		if e.complexity.Health.PacketsPerSecond == nil {
+			break
+		}
+
+		return e.complexity.Health.PacketsPerSecond(childComplexity), true
+
+	case "Health.pingRTT":
+		if e.complexity.Health.PingRtt == nil {
+			break
+		}
+
+		return e.complexity.Health.PingRtt(childComplexity), true
+
+	case "Health.state":
+		if e.complexity.Health.State == nil {
+			break
+		}
+
+		return e.complexity.Health.State(childComplexity), true
+
+	case "HookMessage.message":
+		if e.complexity.HookMessage.Message == nil {
+			break
+		}
+
+		return e.complexity.HookMessage.Message(childComplexity), true
+
+	case "HookMessage.severity":
+		if e.complexity.HookMessage.Severity == nil {
+			break
+		}
+
+		return e.complexity.HookMessage.Severity(childComplexity), true
+
+	case "HookMessage.time":
+		if e.complexity.HookMessage.Time == nil {
+			break
+		}
+
+		return e.complexity.HookMessage.Time(childComplexity), true
+
+	case "JournalEntry.cursor":
+		if e.complexity.JournalEntry.Cursor == nil {
+			break
+		}
+
+		return e.complexity.JournalEntry.Cursor(childComplexity), true
+
+	case "JournalEntry.entityEvent":
+		if e.complexity.JournalEntry.EntityEvent == nil {
+			break
+		}
+
+		return e.complexity.JournalEntry.EntityEvent(childComplexity), true
+
+	case "JournalEntry.streamEvent":
+		if e.complexity.JournalEntry.StreamEvent == nil {
+			break
+		}
+
+		return e.complexity.JournalEntry.StreamEvent(childComplexity), true
+
+	case "JournalEntry.time":
+		if e.complexity.JournalEntry.Time == nil {
+			break
+		}
+
+		return e.complexity.JournalEntry.Time(childComplexity), true
+
+	case "JournalPage.entries":
+		if e.complexity.JournalPage.Entries == nil {
+			break
+		}
+
+		return e.complexity.JournalPage.Entries(childComplexity), true
+
+	case "JournalPage.nextCursor":
+		if e.complexity.JournalPage.NextCursor == nil {
+			break
+		}
+
+		return e.complexity.JournalPage.NextCursor(childComplexity), true
+
 	case "Location.lastUpdated":
 		if e.complexity.Location.LastUpdated == nil {
 			break
@@ -1115,6 +1360,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MapInfo.TerritoryType(childComplexity), true
 
+	case "Mutation.attachProcess":
+		if e.complexity.Mutation.AttachProcess == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_attachProcess_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AttachProcess(childComplexity, args["pid"].(int)), true
+
+	case "Mutation.detachProcess":
+		if e.complexity.Mutation.DetachProcess == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_detachProcess_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DetachProcess(childComplexity, args["streamID"].(int)), true
+
 	case "Mutation.sendStreamRequest":
 		if e.complexity.Mutation.SendStreamRequest == nil {
 			break
@@ -1169,6 +1438,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NPCInfo.Size(childComplexity), true
 
+	case "OpcodeMapping.channel":
+		if e.complexity.OpcodeMapping.Channel == nil {
+			break
+		}
+
+		return e.complexity.OpcodeMapping.Channel(childComplexity), true
+
+	case "OpcodeMapping.datatype":
+		if e.complexity.OpcodeMapping.Datatype == nil {
+			break
+		}
+
+		return e.complexity.OpcodeMapping.Datatype(childComplexity), true
+
+	case "OpcodeMapping.direction":
+		if e.complexity.OpcodeMapping.Direction == nil {
+			break
+		}
+
+		return e.complexity.OpcodeMapping.Direction(childComplexity), true
+
+	case "OpcodeMapping.opcode":
+		if e.complexity.OpcodeMapping.Opcode == nil {
+			break
+		}
+
+		return e.complexity.OpcodeMapping.Opcode(childComplexity), true
+
+	case "OpcodeMapping.source":
+		if e.complexity.OpcodeMapping.Source == nil {
+			break
+		}
+
+		return e.complexity.OpcodeMapping.Source(childComplexity), true
+
+	case "OpcodeTable.file":
+		if e.complexity.OpcodeTable.File == nil {
+			break
+		}
+
+		return e.complexity.OpcodeTable.File(childComplexity), true
+
+	case "OpcodeTable.lastError":
+		if e.complexity.OpcodeTable.LastError == nil {
+			break
+		}
+
+		return e.complexity.OpcodeTable.LastError(childComplexity), true
+
+	case "OpcodeTable.loadedAt":
+		if e.complexity.OpcodeTable.LoadedAt == nil {
+			break
+		}
+
+		return e.complexity.OpcodeTable.LoadedAt(childComplexity), true
+
+	case "OpcodeTable.mappings":
+		if e.complexity.OpcodeTable.Mappings == nil {
+			break
+		}
+
+		return e.complexity.OpcodeTable.Mappings(childComplexity), true
+
 	case "Place.mapID":
 		if e.complexity.Place.MapID == nil {
 			break
@@ -1190,6 +1522,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Place.TerritoryID(childComplexity), true
 
+	case "ProcessCandidate.adapter":
+		if e.complexity.ProcessCandidate.Adapter == nil {
+			break
+		}
+
+		return e.complexity.ProcessCandidate.Adapter(childComplexity), true
+
+	case "ProcessCandidate.pid":
+		if e.complexity.ProcessCandidate.Pid == nil {
+			break
+		}
+
+		return e.complexity.ProcessCandidate.Pid(childComplexity), true
+
+	case "ProcessCandidate.rule":
+		if e.complexity.ProcessCandidate.Rule == nil {
+			break
+		}
+
+		return e.complexity.ProcessCandidate.Rule(childComplexity), true
+
+	case "ProcessCandidate.streamID":
+		if e.complexity.ProcessCandidate.StreamID == nil {
+			break
+		}
+
+		return e.complexity.ProcessCandidate.StreamID(childComplexity), true
+
 	case "Query.apiVersion":
 		if e.complexity.Query.APIVersion == nil {
 			break
@@ -1197,6 +1557,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.APIVersion(childComplexity), true
 
+	case "Query.adapters":
+		if e.complexity.Query.Adapters == nil {
+			break
+		}
+
+		return e.complexity.Query.Adapters(childComplexity), true
+
+	case "Query.candidateProcesses":
+		if e.complexity.Query.CandidateProcesses == nil {
+			break
+		}
+
+		return e.complexity.Query.CandidateProcesses(childComplexity), true
+
 	case "Query.entity":
 		if e.complexity.Query.Entity == nil {
 			break
@@ -1207,7 +1581,38 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Entity(childComplexity, args["streamID"].(int), args["entityID"].(uint64)), true
+		return e.complexity.Query.Entity(childComplexity, args["streamID"].(int), args["entityID"].(uint64), args["at"].(*time.Time)), true
+
+	case "Query.events":
+		if e.complexity.Query.Events == nil {
+			break
+		}
+
+		args, err := ec.field_Query_events_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Events(childComplexity, args["streamID"].(int), args["from"].(*time.Time), args["to"].(*time.Time), args["types"].([]string), args["entityID"].(*uint64), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.hookMessages":
+		if e.complexity.Query.HookMessages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_hookMessages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HookMessages(childComplexity, args["streamID"].(int)), true
+
+	case "Query.opcodeTable":
+		if e.complexity.Query.OpcodeTable == nil {
+			break
+		}
+
+		return e.complexity.Query.OpcodeTable(childComplexity), true
 
 	case "Query.stream":
 		if e.complexity.Query.Stream == nil {
@@ -1219,7 +1624,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Stream(childComplexity, args["streamID"].(int)), true
+		return e.complexity.Query.Stream(childComplexity, args["streamID"].(int), args["at"].(*time.Time)), true
+
+	case "Query.streamCommands":
+		if e.complexity.Query.StreamCommands == nil {
+			break
+		}
+
+		args, err := ec.field_Query_streamCommands_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StreamCommands(childComplexity, args["streamID"].(int)), true
 
 	case "Query.streams":
 		if e.complexity.Query.Streams == nil {
@@ -1228,6 +1645,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Streams(childComplexity), true
 
+	case "Query.throttleStats":
+		if e.complexity.Query.ThrottleStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_throttleStats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ThrottleStats(childComplexity, args["streamID"].(int)), true
+
 	case "RecipeInfo.canHQ":
 		if e.complexity.RecipeInfo.CanHq == nil {
 			break
@@ -1298,6 +1727,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RemoveEntity.ID(childComplexity), true
 
+	case "RemoveEntity.reason":
+		if e.complexity.RemoveEntity.Reason == nil {
+			break
+		}
+
+		return e.complexity.RemoveEntity.Reason(childComplexity), true
+
+	case "RemoveEntity.time":
+		if e.complexity.RemoveEntity.Time == nil {
+			break
+		}
+
+		return e.complexity.RemoveEntity.Time(childComplexity), true
+
 	case "RemoveStatus.index":
 		if e.complexity.RemoveStatus.Index == nil {
 			break
@@ -1648,6 +2091,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stream.CurrentWorld(childComplexity), true
 
+	case "Stream.departed":
+		if e.complexity.Stream.Departed == nil {
+			break
+		}
+
+		return e.complexity.Stream.Departed(childComplexity), true
+
 	case "Stream.enmity":
 		if e.complexity.Stream.Enmity == nil {
 			break
@@ -1690,6 +2140,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stream.Place(childComplexity), true
 
+	case "Stream.restored":
+		if e.complexity.Stream.Restored == nil {
+			break
+		}
+
+		return e.complexity.Stream.Restored(childComplexity), true
+
 	case "Stream.serverID":
 		if e.complexity.Stream.ServerID == nil {
 			break
@@ -1697,6 +2154,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stream.ServerID(childComplexity), true
 
+	case "Stream.source":
+		if e.complexity.Stream.Source == nil {
+			break
+		}
+
+		return e.complexity.Stream.Source(childComplexity), true
+
 	case "Stream.stats":
 		if e.complexity.Stream.Stats == nil {
 			break
@@ -1704,34 +2168,97 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stream.Stats(childComplexity), true
 
-	case "StreamEvent.streamID":
-		if e.complexity.StreamEvent.StreamID == nil {
+	case "Stream.status":
+		if e.complexity.Stream.Status == nil {
 			break
 		}
 
-		return e.complexity.StreamEvent.StreamID(childComplexity), true
+		return e.complexity.Stream.Status(childComplexity), true
 
-	case "StreamEvent.type":
-		if e.complexity.StreamEvent.Type == nil {
+	case "StreamCommand.description":
+		if e.complexity.StreamCommand.Description == nil {
 			break
 		}
 
-		return e.complexity.StreamEvent.Type(childComplexity), true
+		return e.complexity.StreamCommand.Description(childComplexity), true
 
-	case "Subscription.entityEvent":
-		if e.complexity.Subscription.EntityEvent == nil {
+	case "StreamCommand.name":
+		if e.complexity.StreamCommand.Name == nil {
 			break
 		}
 
-		return e.complexity.Subscription.EntityEvent(childComplexity), true
+		return e.complexity.StreamCommand.Name(childComplexity), true
 
-	case "Subscription.streamEvent":
-		if e.complexity.Subscription.StreamEvent == nil {
+	case "StreamCommand.schema":
+		if e.complexity.StreamCommand.Schema == nil {
+			break
+		}
+
+		return e.complexity.StreamCommand.Schema(childComplexity), true
+
+	case "StreamEvent.streamID":
+		if e.complexity.StreamEvent.StreamID == nil {
+			break
+		}
+
+		return e.complexity.StreamEvent.StreamID(childComplexity), true
+
+	case "StreamEvent.type":
+		if e.complexity.StreamEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.StreamEvent.Type(childComplexity), true
+
+	case "StreamSource.adapter":
+		if e.complexity.StreamSource.Adapter == nil {
+			break
+		}
+
+		return e.complexity.StreamSource.Adapter(childComplexity), true
+
+	case "StreamSource.key":
+		if e.complexity.StreamSource.Key == nil {
+			break
+		}
+
+		return e.complexity.StreamSource.Key(childComplexity), true
+
+	case "Subscription.entityEvent":
+		if e.complexity.Subscription.EntityEvent == nil {
+			break
+		}
+
+		return e.complexity.Subscription.EntityEvent(childComplexity), true
+
+	case "Subscription.streamEvent":
+		if e.complexity.Subscription.StreamEvent == nil {
 			break
 		}
 
 		return e.complexity.Subscription.StreamEvent(childComplexity), true
 
+	case "ThrottleStat.datatype":
+		if e.complexity.ThrottleStat.Datatype == nil {
+			break
+		}
+
+		return e.complexity.ThrottleStat.Datatype(childComplexity), true
+
+	case "ThrottleStat.dropped":
+		if e.complexity.ThrottleStat.Dropped == nil {
+			break
+		}
+
+		return e.complexity.ThrottleStat.Dropped(childComplexity), true
+
+	case "ThrottleStat.policy":
+		if e.complexity.ThrottleStat.Policy == nil {
+			break
+		}
+
+		return e.complexity.ThrottleStat.Policy(childComplexity), true
+
 	case "UpdateCastingInfo.castingInfo":
 		if e.complexity.UpdateCastingInfo.CastingInfo == nil {
 			break
@@ -1844,6 +2371,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateStats.Stats(childComplexity), true
 
+	case "UpdateStreamStatus.status":
+		if e.complexity.UpdateStreamStatus.Status == nil {
+			break
+		}
+
+		return e.complexity.UpdateStreamStatus.Status(childComplexity), true
+
 	case "UpdateTarget.targetID":
 		if e.complexity.UpdateTarget.TargetID == nil {
 			break
@@ -1963,8 +2497,45 @@ var sources = []*ast.Source{
 	{Name: "models/schema.graphql", Input: `type Query {
   apiVersion: ID!
   streams: [Stream!]!
-  stream(streamID: Int!): Stream!
-  entity(streamID: Int!, entityID: Uint!): Entity!
+  stream(streamID: Int!, at: Timestamp): Stream!
+  entity(streamID: Int!, entityID: Uint!, at: Timestamp): Entity!
+  adapters: [Adapter!]!
+  streamCommands(streamID: Int!): [StreamCommand!]!
+  opcodeTable: OpcodeTable!
+  throttleStats(streamID: Int!): [ThrottleStat!]!
+  hookMessages(streamID: Int!): [HookMessage!]!
+  candidateProcesses: [ProcessCandidate!]!
+  events(
+    streamID: Int!
+    from: Timestamp
+    to: Timestamp
+    types: [String!]
+    entityID: Uint
+    after: String
+    first: Int
+  ): JournalPage!
+}
+
+type Adapter {
+  name: String!
+  health: Health
+}
+
+enum HealthState {
+  CONNECTING
+  CONNECTED
+  DEGRADED
+  RECONNECTING
+  CLOSED
+}
+
+type Health {
+  state: HealthState!
+  lastError: String
+  lastPacketTime: Timestamp
+  pingRTT: Float
+  packetsPerSecond: Float!
+  discardedBytes: Int!
 }
 
 type Stream {
@@ -1982,7 +2553,85 @@ type Stream {
 
   stats: Stats
 
+  source: StreamSource
+  status: Health
+
+  restored: Boolean!
+
   entities: [Entity!]!
+  departed: [DepartedEntity!]!
+}
+
+type StreamSource {
+  adapter: String!
+  key: String!
+}
+
+type StreamCommand {
+  name: String!
+  description: String!
+  schema: String!
+}
+
+enum ThrottlePolicy {
+  PASS
+  DEDUP
+  LATEST
+}
+
+type ProcessCandidate {
+  pid: Int!
+  adapter: String!
+  rule: String!
+  streamID: Int
+}
+
+type JournalPage {
+  entries: [JournalEntry!]!
+  nextCursor: String
+}
+
+type JournalEntry {
+  cursor: String!
+  time: Timestamp!
+  streamEvent: StreamEvent
+  entityEvent: EntityEvent
+}
+
+type ThrottleStat {
+  datatype: String!
+  policy: ThrottlePolicy!
+  dropped: Int!
+}
+
+enum OpcodeDirection {
+  INGRESS
+  EGRESS
+}
+
+enum OpcodeChannel {
+  ZONE
+  CHAT
+}
+
+enum OpcodeSource {
+  COMPILED
+  OVERRIDE
+}
+
+type OpcodeMapping {
+  direction: OpcodeDirection!
+  channel: OpcodeChannel!
+  opcode: Int!
+  datatype: String!
+  source: OpcodeSource!
+}
+
+type OpcodeTable {
+  file: String
+  loadedAt: Timestamp
+  lastError: String
+  mappings: [OpcodeMapping!]!
 }
 
 type Place {
@@ -2030,6 +2679,17 @@ type Entity {
   rawSpawnJSONData: String!
 }
 
+enum DespawnReason {
+  INDEX_REUSED
+  REMOVED
+}
+
+type DepartedEntity {
+  entity: Entity!
+  despawnTime: Timestamp!
+  reason: DespawnReason!
+}
+
 type HateRanking {
   actorID: Uint!
   hate: Int!
@@ -2218,7 +2878,9 @@ union StreamEventType =
   UpdateCraftingInfo |
   UpdateEnmity |
   UpdateStats |
-  ChatEvent
+  UpdateStreamStatus |
+  ChatEvent |
+  HookMessage
 
 type AddStream {
   stream: Stream!
@@ -2253,6 +2915,23 @@ type UpdateStats {
   stats: Stats!
 }
 
+type UpdateStreamStatus {
+  status: Health!
+}
+
+enum HookMessageSeverity {
+  DEBUG
+  INFO
+  WARN
+  ERROR
+}
+
+type HookMessage {
+  time: Timestamp!
+  severity: HookMessageSeverity!
+  message: String!
+}
+
 type ChatEvent {
   channelID: Uint!
   channelWorld: World!
@@ -2292,6 +2971,8 @@ type AddEntity {
 
 type RemoveEntity {
   id: Uint!
+  reason: DespawnReason!
+  time: Timestamp!
 }
 
 type SetEntities {
@@ -2341,6 +3022,8 @@ scalar Uint
 
 type Mutation {
   sendStreamRequest(request: StreamRequest!): String!
+  attachProcess(pid: Int!): Boolean!
+  detachProcess(streamID: Int!): Boolean!
 }
 
 input StreamRequest {
@@ -2355,6 +3038,36 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Mutation_attachProcess_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["pid"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pid"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pid"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_detachProcess_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["streamID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["streamID"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_sendStreamRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2406,10 +3119,142 @@ func (ec *executionContext) field_Query_entity_args(ctx context.Context, rawArgs
 		}
 	}
 	args["entityID"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["at"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("at"))
+		arg2, err = ec.unmarshalOTimestamp2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["at"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_events_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["streamID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["streamID"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg1, err = ec.unmarshalOTimestamp2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg2, err = ec.unmarshalOTimestamp2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg2
+	var arg3 []string
+	if tmp, ok := rawArgs["types"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("types"))
+		arg3, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["types"] = arg3
+	var arg4 *uint64
+	if tmp, ok := rawArgs["entityID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entityID"))
+		arg4, err = ec.unmarshalOUint2ᚖuint64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["entityID"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg6
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_hookMessages_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["streamID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["streamID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_streamCommands_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["streamID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["streamID"] = arg0
 	return args, nil
 }
 
 func (ec *executionContext) field_Query_stream_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["streamID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["streamID"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["at"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("at"))
+		arg1, err = ec.unmarshalOTimestamp2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["at"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_throttleStats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 int
@@ -3212,7 +4057,7 @@ func (ec *executionContext) _ActionEffect_value(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AddEntity_entity(ctx context.Context, field graphql.CollectedField, obj *AddEntity) (ret graphql.Marshaler) {
+func (ec *executionContext) _Adapter_name(ctx context.Context, field graphql.CollectedField, obj *Adapter) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3220,7 +4065,7 @@ func (ec *executionContext) _AddEntity_entity(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AddEntity",
+		Object:     "Adapter",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3230,7 +4075,7 @@ func (ec *executionContext) _AddEntity_entity(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entity, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3242,12 +4087,12 @@ func (ec *executionContext) _AddEntity_entity(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Entity)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AddStream_stream(ctx context.Context, field graphql.CollectedField, obj *AddStream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Adapter_health(ctx context.Context, field graphql.CollectedField, obj *Adapter) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3255,7 +4100,7 @@ func (ec *executionContext) _AddStream_stream(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "AddStream",
+		Object:     "Adapter",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3265,24 +4110,21 @@ func (ec *executionContext) _AddStream_stream(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Stream, nil
+		return obj.Health, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*Stream)
+	res := resTmp.(*Health)
 	fc.Result = res
-	return ec.marshalNStream2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStream(ctx, field.Selections, res)
+	return ec.marshalOHealth2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealth(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CastingInfo_actionID(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AddEntity_entity(ctx context.Context, field graphql.CollectedField, obj *AddEntity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3290,7 +4132,7 @@ func (ec *executionContext) _CastingInfo_actionID(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "CastingInfo",
+		Object:     "AddEntity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3300,7 +4142,7 @@ func (ec *executionContext) _CastingInfo_actionID(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActionID, nil
+		return obj.Entity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3312,12 +4154,12 @@ func (ec *executionContext) _CastingInfo_actionID(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*Entity)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CastingInfo_actionName(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _AddStream_stream(ctx context.Context, field graphql.CollectedField, obj *AddStream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3325,7 +4167,7 @@ func (ec *executionContext) _CastingInfo_actionName(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "CastingInfo",
+		Object:     "AddStream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3335,7 +4177,7 @@ func (ec *executionContext) _CastingInfo_actionName(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActionName, nil
+		return obj.Stream, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3347,12 +4189,12 @@ func (ec *executionContext) _CastingInfo_actionName(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Stream)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNStream2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStream(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CastingInfo_startTime(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _CastingInfo_actionID(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3370,7 +4212,7 @@ func (ec *executionContext) _CastingInfo_startTime(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartTime, nil
+		return obj.ActionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3382,12 +4224,82 @@ func (ec *executionContext) _CastingInfo_startTime(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CastingInfo_castTime(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _CastingInfo_actionName(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CastingInfo",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ActionName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CastingInfo_startTime(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CastingInfo",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CastingInfo_castTime(ctx context.Context, field graphql.CollectedField, obj *CastingInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4577,6 +5489,111 @@ func (ec *executionContext) _CraftingInfo_reuseProc(ctx context.Context, field g
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _DepartedEntity_entity(ctx context.Context, field graphql.CollectedField, obj *DepartedEntity) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DepartedEntity",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Entity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Entity)
+	fc.Result = res
+	return ec.marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DepartedEntity_despawnTime(ctx context.Context, field graphql.CollectedField, obj *DepartedEntity) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DepartedEntity",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DespawnTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DepartedEntity_reason(ctx context.Context, field graphql.CollectedField, obj *DepartedEntity) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DepartedEntity",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(DespawnReason)
+	fc.Result = res
+	return ec.marshalNDespawnReason2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDespawnReason(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Enmity_targetHateRanking(ctx context.Context, field graphql.CollectedField, obj *Enmity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -5513,7 +6530,7 @@ func (ec *executionContext) _HateRanking_hate(ctx context.Context, field graphql
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Location_x(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _Health_state(ctx context.Context, field graphql.CollectedField, obj *Health) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5521,7 +6538,7 @@ func (ec *executionContext) _Location_x(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "Health",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5531,7 +6548,7 @@ func (ec *executionContext) _Location_x(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.X, nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5543,12 +6560,12 @@ func (ec *executionContext) _Location_x(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(HealthState)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNHealthState2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealthState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Location_y(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _Health_lastError(ctx context.Context, field graphql.CollectedField, obj *Health) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5556,7 +6573,7 @@ func (ec *executionContext) _Location_y(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "Health",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5566,24 +6583,21 @@ func (ec *executionContext) _Location_y(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Y, nil
+		return obj.LastError, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Location_z(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _Health_lastPacketTime(ctx context.Context, field graphql.CollectedField, obj *Health) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5591,7 +6605,7 @@ func (ec *executionContext) _Location_z(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "Health",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5601,24 +6615,21 @@ func (ec *executionContext) _Location_z(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Z, nil
+		return obj.LastPacketTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOTimestamp2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Location_orientation(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _Health_pingRTT(ctx context.Context, field graphql.CollectedField, obj *Health) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5626,7 +6637,7 @@ func (ec *executionContext) _Location_orientation(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "Health",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5636,24 +6647,21 @@ func (ec *executionContext) _Location_orientation(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Orientation, nil
+		return obj.PingRtt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Location_lastUpdated(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _Health_packetsPerSecond(ctx context.Context, field graphql.CollectedField, obj *Health) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5661,7 +6669,7 @@ func (ec *executionContext) _Location_lastUpdated(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "Health",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5671,7 +6679,7 @@ func (ec *executionContext) _Location_lastUpdated(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastUpdated, nil
+		return obj.PacketsPerSecond, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5683,12 +6691,12 @@ func (ec *executionContext) _Location_lastUpdated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_key(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Health_discardedBytes(ctx context.Context, field graphql.CollectedField, obj *Health) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5696,7 +6704,7 @@ func (ec *executionContext) _MapInfo_key(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "Health",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5706,7 +6714,7 @@ func (ec *executionContext) _MapInfo_key(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.DiscardedBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5723,7 +6731,7 @@ func (ec *executionContext) _MapInfo_key(ctx context.Context, field graphql.Coll
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_id(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _HookMessage_time(ctx context.Context, field graphql.CollectedField, obj *HookMessage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5731,7 +6739,7 @@ func (ec *executionContext) _MapInfo_id(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "HookMessage",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5741,7 +6749,7 @@ func (ec *executionContext) _MapInfo_id(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5753,12 +6761,12 @@ func (ec *executionContext) _MapInfo_id(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_SizeFactor(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _HookMessage_severity(ctx context.Context, field graphql.CollectedField, obj *HookMessage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5766,7 +6774,7 @@ func (ec *executionContext) _MapInfo_SizeFactor(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "HookMessage",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5776,7 +6784,7 @@ func (ec *executionContext) _MapInfo_SizeFactor(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SizeFactor, nil
+		return obj.Severity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5788,12 +6796,12 @@ func (ec *executionContext) _MapInfo_SizeFactor(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(HookMessageSeverity)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNHookMessageSeverity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessageSeverity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_OffsetX(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _HookMessage_message(ctx context.Context, field graphql.CollectedField, obj *HookMessage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5801,7 +6809,7 @@ func (ec *executionContext) _MapInfo_OffsetX(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "HookMessage",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5811,7 +6819,7 @@ func (ec *executionContext) _MapInfo_OffsetX(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OffsetX, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5823,12 +6831,12 @@ func (ec *executionContext) _MapInfo_OffsetX(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_OffsetY(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _JournalEntry_cursor(ctx context.Context, field graphql.CollectedField, obj *JournalEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5836,7 +6844,7 @@ func (ec *executionContext) _MapInfo_OffsetY(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "JournalEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5846,7 +6854,7 @@ func (ec *executionContext) _MapInfo_OffsetY(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OffsetY, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5858,12 +6866,12 @@ func (ec *executionContext) _MapInfo_OffsetY(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_PlaceName(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _JournalEntry_time(ctx context.Context, field graphql.CollectedField, obj *JournalEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5871,7 +6879,7 @@ func (ec *executionContext) _MapInfo_PlaceName(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "JournalEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5881,7 +6889,7 @@ func (ec *executionContext) _MapInfo_PlaceName(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PlaceName, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5893,12 +6901,12 @@ func (ec *executionContext) _MapInfo_PlaceName(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_PlaceNameSub(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _JournalEntry_streamEvent(ctx context.Context, field graphql.CollectedField, obj *JournalEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5906,7 +6914,7 @@ func (ec *executionContext) _MapInfo_PlaceNameSub(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "JournalEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5916,24 +6924,21 @@ func (ec *executionContext) _MapInfo_PlaceNameSub(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PlaceNameSub, nil
+		return obj.StreamEvent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*StreamEvent)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOStreamEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MapInfo_TerritoryType(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _JournalEntry_entityEvent(ctx context.Context, field graphql.CollectedField, obj *JournalEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5941,7 +6946,7 @@ func (ec *executionContext) _MapInfo_TerritoryType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "MapInfo",
+		Object:     "JournalEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5951,24 +6956,21 @@ func (ec *executionContext) _MapInfo_TerritoryType(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TerritoryType, nil
+		return obj.EntityEvent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*EntityEvent)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOEntityEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_sendStreamRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _JournalPage_entries(ctx context.Context, field graphql.CollectedField, obj *JournalPage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5976,24 +6978,17 @@ func (ec *executionContext) _Mutation_sendStreamRequest(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "JournalPage",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_sendStreamRequest_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SendStreamRequest(rctx, args["request"].(StreamRequest))
+		return obj.Entries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6005,12 +7000,12 @@ func (ec *executionContext) _Mutation_sendStreamRequest(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]JournalEntry)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNJournalEntry2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NPCInfo_nameID(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _JournalPage_nextCursor(ctx context.Context, field graphql.CollectedField, obj *JournalPage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6018,7 +7013,7 @@ func (ec *executionContext) _NPCInfo_nameID(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "NPCInfo",
+		Object:     "JournalPage",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6028,24 +7023,21 @@ func (ec *executionContext) _NPCInfo_nameID(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NameID, nil
+		return obj.NextCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NPCInfo_baseID(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Location_x(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6053,7 +7045,7 @@ func (ec *executionContext) _NPCInfo_baseID(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "NPCInfo",
+		Object:     "Location",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6063,7 +7055,7 @@ func (ec *executionContext) _NPCInfo_baseID(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BaseID, nil
+		return obj.X, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6075,12 +7067,12 @@ func (ec *executionContext) _NPCInfo_baseID(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NPCInfo_modelID(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Location_y(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6088,7 +7080,7 @@ func (ec *executionContext) _NPCInfo_modelID(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "NPCInfo",
+		Object:     "Location",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6098,7 +7090,7 @@ func (ec *executionContext) _NPCInfo_modelID(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModelID, nil
+		return obj.Y, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6110,12 +7102,12 @@ func (ec *executionContext) _NPCInfo_modelID(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NPCInfo_name(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Location_z(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6123,7 +7115,7 @@ func (ec *executionContext) _NPCInfo_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "NPCInfo",
+		Object:     "Location",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6133,21 +7125,24 @@ func (ec *executionContext) _NPCInfo_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Z, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NPCInfo_size(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Location_orientation(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6155,7 +7150,7 @@ func (ec *executionContext) _NPCInfo_size(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "NPCInfo",
+		Object:     "Location",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6165,21 +7160,24 @@ func (ec *executionContext) _NPCInfo_size(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Size, nil
+		return obj.Orientation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NPCInfo_error(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Location_lastUpdated(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6187,7 +7185,7 @@ func (ec *executionContext) _NPCInfo_error(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "NPCInfo",
+		Object:     "Location",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6197,7 +7195,7 @@ func (ec *executionContext) _NPCInfo_error(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return obj.LastUpdated, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6209,12 +7207,12 @@ func (ec *executionContext) _NPCInfo_error(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_mapID(ctx context.Context, field graphql.CollectedField, obj *Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_key(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6222,7 +7220,7 @@ func (ec *executionContext) _Place_mapID(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Place",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6232,7 +7230,7 @@ func (ec *executionContext) _Place_mapID(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MapID, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6249,7 +7247,7 @@ func (ec *executionContext) _Place_mapID(ctx context.Context, field graphql.Coll
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_territoryID(ctx context.Context, field graphql.CollectedField, obj *Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_id(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6257,7 +7255,7 @@ func (ec *executionContext) _Place_territoryID(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Place",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6267,7 +7265,7 @@ func (ec *executionContext) _Place_territoryID(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TerritoryID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6279,12 +7277,12 @@ func (ec *executionContext) _Place_territoryID(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_maps(ctx context.Context, field graphql.CollectedField, obj *Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_SizeFactor(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6292,7 +7290,7 @@ func (ec *executionContext) _Place_maps(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Place",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6302,7 +7300,7 @@ func (ec *executionContext) _Place_maps(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Maps, nil
+		return obj.SizeFactor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6314,12 +7312,12 @@ func (ec *executionContext) _Place_maps(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]MapInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNMapInfo2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfoᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_apiVersion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_OffsetX(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6327,17 +7325,17 @@ func (ec *executionContext) _Query_apiVersion(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().APIVersion(rctx)
+		return obj.OffsetX, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6349,12 +7347,12 @@ func (ec *executionContext) _Query_apiVersion(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_streams(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_OffsetY(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6362,17 +7360,17 @@ func (ec *executionContext) _Query_streams(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Streams(rctx)
+		return obj.OffsetY, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6384,12 +7382,12 @@ func (ec *executionContext) _Query_streams(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]Stream)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStream2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_stream(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_PlaceName(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6397,24 +7395,17 @@ func (ec *executionContext) _Query_stream(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_stream_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Stream(rctx, args["streamID"].(int))
+		return obj.PlaceName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6426,12 +7417,12 @@ func (ec *executionContext) _Query_stream(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Stream)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNStream2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStream(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_entity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_PlaceNameSub(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6439,24 +7430,17 @@ func (ec *executionContext) _Query_entity(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_entity_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Entity(rctx, args["streamID"].(int), args["entityID"].(uint64))
+		return obj.PlaceNameSub, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6468,12 +7452,12 @@ func (ec *executionContext) _Query_entity(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Entity)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _MapInfo_TerritoryType(ctx context.Context, field graphql.CollectedField, obj *MapInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6481,38 +7465,34 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "MapInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.TerritoryType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_sendStreamRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6520,31 +7500,41 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_sendStreamRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Mutation().SendStreamRequest(rctx, args["request"].(StreamRequest))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_id(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_attachProcess(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6552,17 +7542,24 @@ func (ec *executionContext) _RecipeInfo_id(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_attachProcess_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().AttachProcess(rctx, args["pid"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6574,12 +7571,12 @@ func (ec *executionContext) _RecipeInfo_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_name(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_detachProcess(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6587,17 +7584,24 @@ func (ec *executionContext) _RecipeInfo_name(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_detachProcess_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().DetachProcess(rctx, args["streamID"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6609,12 +7613,12 @@ func (ec *executionContext) _RecipeInfo_name(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_recipeLevel(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _NPCInfo_nameID(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6622,7 +7626,7 @@ func (ec *executionContext) _RecipeInfo_recipeLevel(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "NPCInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6632,7 +7636,7 @@ func (ec *executionContext) _RecipeInfo_recipeLevel(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RecipeLevel, nil
+		return obj.NameID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6649,7 +7653,7 @@ func (ec *executionContext) _RecipeInfo_recipeLevel(ctx context.Context, field g
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_itemID(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _NPCInfo_baseID(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6657,7 +7661,7 @@ func (ec *executionContext) _RecipeInfo_itemID(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "NPCInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6667,7 +7671,7 @@ func (ec *executionContext) _RecipeInfo_itemID(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ItemID, nil
+		return obj.BaseID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6684,7 +7688,7 @@ func (ec *executionContext) _RecipeInfo_itemID(ctx context.Context, field graphq
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_element(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _NPCInfo_modelID(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6692,7 +7696,7 @@ func (ec *executionContext) _RecipeInfo_element(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "NPCInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6702,7 +7706,7 @@ func (ec *executionContext) _RecipeInfo_element(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Element, nil
+		return obj.ModelID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6719,7 +7723,7 @@ func (ec *executionContext) _RecipeInfo_element(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_canHQ(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _NPCInfo_name(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6727,7 +7731,7 @@ func (ec *executionContext) _RecipeInfo_canHQ(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "NPCInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6737,24 +7741,21 @@ func (ec *executionContext) _RecipeInfo_canHQ(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CanHq, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_difficulty(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _NPCInfo_size(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6762,7 +7763,7 @@ func (ec *executionContext) _RecipeInfo_difficulty(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "NPCInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6772,24 +7773,21 @@ func (ec *executionContext) _RecipeInfo_difficulty(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Difficulty, nil
+		return obj.Size, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_quality(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _NPCInfo_error(ctx context.Context, field graphql.CollectedField, obj *NPCInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6797,7 +7795,7 @@ func (ec *executionContext) _RecipeInfo_quality(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "NPCInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6807,7 +7805,7 @@ func (ec *executionContext) _RecipeInfo_quality(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Quality, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6824,7 +7822,7 @@ func (ec *executionContext) _RecipeInfo_quality(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecipeInfo_durability(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeMapping_direction(ctx context.Context, field graphql.CollectedField, obj *OpcodeMapping) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6832,7 +7830,7 @@ func (ec *executionContext) _RecipeInfo_durability(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RecipeInfo",
+		Object:     "OpcodeMapping",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6842,7 +7840,7 @@ func (ec *executionContext) _RecipeInfo_durability(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Durability, nil
+		return obj.Direction, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6854,12 +7852,12 @@ func (ec *executionContext) _RecipeInfo_durability(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(OpcodeDirection)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOpcodeDirection2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeDirection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RemoveEntity_id(ctx context.Context, field graphql.CollectedField, obj *RemoveEntity) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeMapping_channel(ctx context.Context, field graphql.CollectedField, obj *OpcodeMapping) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6867,7 +7865,7 @@ func (ec *executionContext) _RemoveEntity_id(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RemoveEntity",
+		Object:     "OpcodeMapping",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6877,7 +7875,7 @@ func (ec *executionContext) _RemoveEntity_id(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Channel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6889,12 +7887,12 @@ func (ec *executionContext) _RemoveEntity_id(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint64)
+	res := resTmp.(OpcodeChannel)
 	fc.Result = res
-	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+	return ec.marshalNOpcodeChannel2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeChannel(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RemoveStatus_index(ctx context.Context, field graphql.CollectedField, obj *RemoveStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeMapping_opcode(ctx context.Context, field graphql.CollectedField, obj *OpcodeMapping) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6902,7 +7900,7 @@ func (ec *executionContext) _RemoveStatus_index(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RemoveStatus",
+		Object:     "OpcodeMapping",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6912,7 +7910,7 @@ func (ec *executionContext) _RemoveStatus_index(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Index, nil
+		return obj.Opcode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6929,7 +7927,7 @@ func (ec *executionContext) _RemoveStatus_index(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RemoveStream_id(ctx context.Context, field graphql.CollectedField, obj *RemoveStream) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeMapping_datatype(ctx context.Context, field graphql.CollectedField, obj *OpcodeMapping) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6937,7 +7935,7 @@ func (ec *executionContext) _RemoveStream_id(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "RemoveStream",
+		Object:     "OpcodeMapping",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6947,7 +7945,7 @@ func (ec *executionContext) _RemoveStream_id(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Datatype, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6959,12 +7957,12 @@ func (ec *executionContext) _RemoveStream_id(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Resources_hp(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeMapping_source(ctx context.Context, field graphql.CollectedField, obj *OpcodeMapping) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6972,7 +7970,7 @@ func (ec *executionContext) _Resources_hp(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Resources",
+		Object:     "OpcodeMapping",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6982,7 +7980,7 @@ func (ec *executionContext) _Resources_hp(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hp, nil
+		return obj.Source, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6994,12 +7992,12 @@ func (ec *executionContext) _Resources_hp(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(OpcodeSource)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOpcodeSource2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Resources_mp(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeTable_file(ctx context.Context, field graphql.CollectedField, obj *OpcodeTable) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7007,7 +8005,7 @@ func (ec *executionContext) _Resources_mp(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Resources",
+		Object:     "OpcodeTable",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7017,24 +8015,21 @@ func (ec *executionContext) _Resources_mp(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mp, nil
+		return obj.File, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Resources_tp(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeTable_loadedAt(ctx context.Context, field graphql.CollectedField, obj *OpcodeTable) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7042,7 +8037,7 @@ func (ec *executionContext) _Resources_tp(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Resources",
+		Object:     "OpcodeTable",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7052,24 +8047,21 @@ func (ec *executionContext) _Resources_tp(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tp, nil
+		return obj.LoadedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTimestamp2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Resources_maxHP(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeTable_lastError(ctx context.Context, field graphql.CollectedField, obj *OpcodeTable) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7077,7 +8069,7 @@ func (ec *executionContext) _Resources_maxHP(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Resources",
+		Object:     "OpcodeTable",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7087,24 +8079,21 @@ func (ec *executionContext) _Resources_maxHP(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxHp, nil
+		return obj.LastError, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Resources_maxMP(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
+func (ec *executionContext) _OpcodeTable_mappings(ctx context.Context, field graphql.CollectedField, obj *OpcodeTable) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7112,7 +8101,7 @@ func (ec *executionContext) _Resources_maxMP(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Resources",
+		Object:     "OpcodeTable",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7122,7 +8111,7 @@ func (ec *executionContext) _Resources_maxMP(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxMp, nil
+		return obj.Mappings, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7134,12 +8123,12 @@ func (ec *executionContext) _Resources_maxMP(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]OpcodeMapping)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOpcodeMapping2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeMappingᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Resources_lastTick(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
+func (ec *executionContext) _Place_mapID(ctx context.Context, field graphql.CollectedField, obj *Place) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7147,7 +8136,7 @@ func (ec *executionContext) _Resources_lastTick(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Resources",
+		Object:     "Place",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7157,7 +8146,7 @@ func (ec *executionContext) _Resources_lastTick(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastTick, nil
+		return obj.MapID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7169,12 +8158,12 @@ func (ec *executionContext) _Resources_lastTick(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SetEntities_entities(ctx context.Context, field graphql.CollectedField, obj *SetEntities) (ret graphql.Marshaler) {
+func (ec *executionContext) _Place_territoryID(ctx context.Context, field graphql.CollectedField, obj *Place) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7182,7 +8171,7 @@ func (ec *executionContext) _SetEntities_entities(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SetEntities",
+		Object:     "Place",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7192,7 +8181,7 @@ func (ec *executionContext) _SetEntities_entities(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entities, nil
+		return obj.TerritoryID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7204,12 +8193,12 @@ func (ec *executionContext) _SetEntities_entities(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]Entity)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_strength(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Place_maps(ctx context.Context, field graphql.CollectedField, obj *Place) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7217,7 +8206,7 @@ func (ec *executionContext) _Stats_strength(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Place",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7227,7 +8216,7 @@ func (ec *executionContext) _Stats_strength(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Strength, nil
+		return obj.Maps, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7239,12 +8228,12 @@ func (ec *executionContext) _Stats_strength(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]MapInfo)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNMapInfo2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfoᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_dexterity(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessCandidate_pid(ctx context.Context, field graphql.CollectedField, obj *ProcessCandidate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7252,7 +8241,7 @@ func (ec *executionContext) _Stats_dexterity(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "ProcessCandidate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7262,7 +8251,7 @@ func (ec *executionContext) _Stats_dexterity(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Dexterity, nil
+		return obj.Pid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7279,7 +8268,7 @@ func (ec *executionContext) _Stats_dexterity(ctx context.Context, field graphql.
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_vitality(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessCandidate_adapter(ctx context.Context, field graphql.CollectedField, obj *ProcessCandidate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7287,7 +8276,7 @@ func (ec *executionContext) _Stats_vitality(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "ProcessCandidate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7297,7 +8286,7 @@ func (ec *executionContext) _Stats_vitality(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Vitality, nil
+		return obj.Adapter, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7309,12 +8298,12 @@ func (ec *executionContext) _Stats_vitality(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_intelligence(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessCandidate_rule(ctx context.Context, field graphql.CollectedField, obj *ProcessCandidate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7322,7 +8311,7 @@ func (ec *executionContext) _Stats_intelligence(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "ProcessCandidate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7332,7 +8321,7 @@ func (ec *executionContext) _Stats_intelligence(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Intelligence, nil
+		return obj.Rule, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7344,12 +8333,12 @@ func (ec *executionContext) _Stats_intelligence(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_mind(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProcessCandidate_streamID(ctx context.Context, field graphql.CollectedField, obj *ProcessCandidate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7357,7 +8346,7 @@ func (ec *executionContext) _Stats_mind(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "ProcessCandidate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7367,24 +8356,21 @@ func (ec *executionContext) _Stats_mind(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mind, nil
+		return obj.StreamID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_piety(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_apiVersion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7392,17 +8378,17 @@ func (ec *executionContext) _Stats_piety(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Piety, nil
+		return ec.resolvers.Query().APIVersion(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7414,12 +8400,12 @@ func (ec *executionContext) _Stats_piety(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_hp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_streams(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7427,17 +8413,17 @@ func (ec *executionContext) _Stats_hp(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hp, nil
+		return ec.resolvers.Query().Streams(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7449,12 +8435,12 @@ func (ec *executionContext) _Stats_hp(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]Stream)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNStream2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_mp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_stream(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7462,17 +8448,24 @@ func (ec *executionContext) _Stats_mp(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_stream_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mp, nil
+		return ec.resolvers.Query().Stream(rctx, args["streamID"].(int), args["at"].(*time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7484,12 +8477,12 @@ func (ec *executionContext) _Stats_mp(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*Stream)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNStream2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStream(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_tp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_entity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7497,17 +8490,24 @@ func (ec *executionContext) _Stats_tp(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_entity_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tp, nil
+		return ec.resolvers.Query().Entity(rctx, args["streamID"].(int), args["entityID"].(uint64), args["at"].(*time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7519,12 +8519,12 @@ func (ec *executionContext) _Stats_tp(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*Entity)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_gp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_adapters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7532,17 +8532,17 @@ func (ec *executionContext) _Stats_gp(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Gp, nil
+		return ec.resolvers.Query().Adapters(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7554,12 +8554,12 @@ func (ec *executionContext) _Stats_gp(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]Adapter)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNAdapter2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAdapterᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_cp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_streamCommands(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7567,18 +8567,25 @@ func (ec *executionContext) _Stats_cp(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Cp, nil
-	})
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_streamCommands_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().StreamCommands(rctx, args["streamID"].(int))
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7589,12 +8596,12 @@ func (ec *executionContext) _Stats_cp(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]StreamCommand)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNStreamCommand2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamCommandᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_delay(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_opcodeTable(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7602,17 +8609,17 @@ func (ec *executionContext) _Stats_delay(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Delay, nil
+		return ec.resolvers.Query().OpcodeTable(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7624,12 +8631,12 @@ func (ec *executionContext) _Stats_delay(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*OpcodeTable)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOpcodeTable2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeTable(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_tenacity(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_throttleStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7637,17 +8644,24 @@ func (ec *executionContext) _Stats_tenacity(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_throttleStats_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tenacity, nil
+		return ec.resolvers.Query().ThrottleStats(rctx, args["streamID"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7659,12 +8673,12 @@ func (ec *executionContext) _Stats_tenacity(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]ThrottleStat)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNThrottleStat2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottleStatᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_attackPower(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_hookMessages(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7672,17 +8686,24 @@ func (ec *executionContext) _Stats_attackPower(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_hookMessages_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AttackPower, nil
+		return ec.resolvers.Query().HookMessages(rctx, args["streamID"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7694,12 +8715,12 @@ func (ec *executionContext) _Stats_attackPower(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]HookMessage)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNHookMessage2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessageᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_defense(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_candidateProcesses(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7707,17 +8728,17 @@ func (ec *executionContext) _Stats_defense(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Defense, nil
+		return ec.resolvers.Query().CandidateProcesses(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7729,12 +8750,12 @@ func (ec *executionContext) _Stats_defense(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]ProcessCandidate)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNProcessCandidate2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐProcessCandidateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_directHitRate(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_events(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7742,17 +8763,24 @@ func (ec *executionContext) _Stats_directHitRate(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_events_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DirectHitRate, nil
+		return ec.resolvers.Query().Events(rctx, args["streamID"].(int), args["from"].(*time.Time), args["to"].(*time.Time), args["types"].([]string), args["entityID"].(*uint64), args["after"].(*string), args["first"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7764,12 +8792,12 @@ func (ec *executionContext) _Stats_directHitRate(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*JournalPage)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNJournalPage2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalPage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_evasion(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7777,34 +8805,38 @@ func (ec *executionContext) _Stats_evasion(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Evasion, nil
+		return ec.introspectType(args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_magicDefense(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7812,34 +8844,31 @@ func (ec *executionContext) _Stats_magicDefense(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MagicDefense, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_criticalHit(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_id(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7847,7 +8876,7 @@ func (ec *executionContext) _Stats_criticalHit(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7857,7 +8886,7 @@ func (ec *executionContext) _Stats_criticalHit(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CriticalHit, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7874,7 +8903,7 @@ func (ec *executionContext) _Stats_criticalHit(ctx context.Context, field graphq
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_attackMagicPotency(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_name(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7882,7 +8911,7 @@ func (ec *executionContext) _Stats_attackMagicPotency(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7892,7 +8921,7 @@ func (ec *executionContext) _Stats_attackMagicPotency(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AttackMagicPotency, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7904,12 +8933,12 @@ func (ec *executionContext) _Stats_attackMagicPotency(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_healingMagicPotency(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_recipeLevel(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7917,7 +8946,7 @@ func (ec *executionContext) _Stats_healingMagicPotency(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7927,7 +8956,7 @@ func (ec *executionContext) _Stats_healingMagicPotency(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HealingMagicPotency, nil
+		return obj.RecipeLevel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7944,7 +8973,7 @@ func (ec *executionContext) _Stats_healingMagicPotency(ctx context.Context, fiel
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_elementalBonus(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_itemID(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7952,7 +8981,7 @@ func (ec *executionContext) _Stats_elementalBonus(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7962,7 +8991,7 @@ func (ec *executionContext) _Stats_elementalBonus(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ElementalBonus, nil
+		return obj.ItemID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7979,7 +9008,7 @@ func (ec *executionContext) _Stats_elementalBonus(ctx context.Context, field gra
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_determination(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_element(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7987,7 +9016,7 @@ func (ec *executionContext) _Stats_determination(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -7997,7 +9026,7 @@ func (ec *executionContext) _Stats_determination(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Determination, nil
+		return obj.Element, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8014,7 +9043,7 @@ func (ec *executionContext) _Stats_determination(ctx context.Context, field grap
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_skillSpeed(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_canHQ(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8022,7 +9051,7 @@ func (ec *executionContext) _Stats_skillSpeed(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8032,7 +9061,7 @@ func (ec *executionContext) _Stats_skillSpeed(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SkillSpeed, nil
+		return obj.CanHq, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8044,12 +9073,12 @@ func (ec *executionContext) _Stats_skillSpeed(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_spellSpeed(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_difficulty(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8057,7 +9086,7 @@ func (ec *executionContext) _Stats_spellSpeed(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8067,7 +9096,7 @@ func (ec *executionContext) _Stats_spellSpeed(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpellSpeed, nil
+		return obj.Difficulty, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8084,7 +9113,7 @@ func (ec *executionContext) _Stats_spellSpeed(ctx context.Context, field graphql
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_haste(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_quality(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8092,7 +9121,7 @@ func (ec *executionContext) _Stats_haste(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8102,7 +9131,7 @@ func (ec *executionContext) _Stats_haste(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Haste, nil
+		return obj.Quality, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8119,7 +9148,7 @@ func (ec *executionContext) _Stats_haste(ctx context.Context, field graphql.Coll
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_craftsmanship(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecipeInfo_durability(ctx context.Context, field graphql.CollectedField, obj *RecipeInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8127,7 +9156,7 @@ func (ec *executionContext) _Stats_craftsmanship(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RecipeInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8137,7 +9166,7 @@ func (ec *executionContext) _Stats_craftsmanship(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Craftsmanship, nil
+		return obj.Durability, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8154,7 +9183,7 @@ func (ec *executionContext) _Stats_craftsmanship(ctx context.Context, field grap
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_control(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RemoveEntity_id(ctx context.Context, field graphql.CollectedField, obj *RemoveEntity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8162,7 +9191,7 @@ func (ec *executionContext) _Stats_control(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RemoveEntity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8172,7 +9201,7 @@ func (ec *executionContext) _Stats_control(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Control, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8184,12 +9213,12 @@ func (ec *executionContext) _Stats_control(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNUint2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_gathering(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RemoveEntity_reason(ctx context.Context, field graphql.CollectedField, obj *RemoveEntity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8197,7 +9226,7 @@ func (ec *executionContext) _Stats_gathering(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RemoveEntity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8207,7 +9236,7 @@ func (ec *executionContext) _Stats_gathering(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Gathering, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8219,12 +9248,12 @@ func (ec *executionContext) _Stats_gathering(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(DespawnReason)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNDespawnReason2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDespawnReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_perception(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RemoveEntity_time(ctx context.Context, field graphql.CollectedField, obj *RemoveEntity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8232,7 +9261,7 @@ func (ec *executionContext) _Stats_perception(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stats",
+		Object:     "RemoveEntity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8242,7 +9271,7 @@ func (ec *executionContext) _Stats_perception(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Perception, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8254,12 +9283,12 @@ func (ec *executionContext) _Stats_perception(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_id(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _RemoveStatus_index(ctx context.Context, field graphql.CollectedField, obj *RemoveStatus) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8267,7 +9296,7 @@ func (ec *executionContext) _Status_id(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "RemoveStatus",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8277,7 +9306,7 @@ func (ec *executionContext) _Status_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Index, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8294,7 +9323,7 @@ func (ec *executionContext) _Status_id(ctx context.Context, field graphql.Collec
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_param(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _RemoveStream_id(ctx context.Context, field graphql.CollectedField, obj *RemoveStream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8302,7 +9331,7 @@ func (ec *executionContext) _Status_param(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "RemoveStream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8312,7 +9341,7 @@ func (ec *executionContext) _Status_param(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Param, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8329,7 +9358,7 @@ func (ec *executionContext) _Status_param(ctx context.Context, field graphql.Col
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_name(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _Resources_hp(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8337,7 +9366,7 @@ func (ec *executionContext) _Status_name(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "Resources",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8347,7 +9376,7 @@ func (ec *executionContext) _Status_name(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Hp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8359,12 +9388,12 @@ func (ec *executionContext) _Status_name(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_description(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _Resources_mp(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8372,7 +9401,7 @@ func (ec *executionContext) _Status_description(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "Resources",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8382,7 +9411,7 @@ func (ec *executionContext) _Status_description(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Mp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8394,12 +9423,12 @@ func (ec *executionContext) _Status_description(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_startedTime(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _Resources_tp(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8407,7 +9436,7 @@ func (ec *executionContext) _Status_startedTime(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "Resources",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8417,7 +9446,7 @@ func (ec *executionContext) _Status_startedTime(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartedTime, nil
+		return obj.Tp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8429,12 +9458,12 @@ func (ec *executionContext) _Status_startedTime(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_duration(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _Resources_maxHP(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8442,7 +9471,7 @@ func (ec *executionContext) _Status_duration(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "Resources",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8452,7 +9481,7 @@ func (ec *executionContext) _Status_duration(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Duration, nil
+		return obj.MaxHp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8464,12 +9493,12 @@ func (ec *executionContext) _Status_duration(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_actorID(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _Resources_maxMP(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8477,7 +9506,7 @@ func (ec *executionContext) _Status_actorID(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "Resources",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8487,7 +9516,7 @@ func (ec *executionContext) _Status_actorID(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActorID, nil
+		return obj.MaxMp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8499,12 +9528,12 @@ func (ec *executionContext) _Status_actorID(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_lastTick(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
+func (ec *executionContext) _Resources_lastTick(ctx context.Context, field graphql.CollectedField, obj *Resources) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8512,7 +9541,7 @@ func (ec *executionContext) _Status_lastTick(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Status",
+		Object:     "Resources",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8539,7 +9568,7 @@ func (ec *executionContext) _Status_lastTick(ctx context.Context, field graphql.
 	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_id(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _SetEntities_entities(ctx context.Context, field graphql.CollectedField, obj *SetEntities) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8547,7 +9576,7 @@ func (ec *executionContext) _Stream_id(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "SetEntities",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8557,7 +9586,7 @@ func (ec *executionContext) _Stream_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Entities, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8569,12 +9598,12 @@ func (ec *executionContext) _Stream_id(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]Entity)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_serverID(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_strength(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8582,7 +9611,7 @@ func (ec *executionContext) _Stream_serverID(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8592,7 +9621,7 @@ func (ec *executionContext) _Stream_serverID(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServerID, nil
+		return obj.Strength, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8609,7 +9638,7 @@ func (ec *executionContext) _Stream_serverID(ctx context.Context, field graphql.
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_instanceNum(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_dexterity(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8617,7 +9646,7 @@ func (ec *executionContext) _Stream_instanceNum(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8627,7 +9656,7 @@ func (ec *executionContext) _Stream_instanceNum(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InstanceNum, nil
+		return obj.Dexterity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8644,7 +9673,7 @@ func (ec *executionContext) _Stream_instanceNum(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_characterID(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_vitality(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8652,7 +9681,7 @@ func (ec *executionContext) _Stream_characterID(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8662,7 +9691,7 @@ func (ec *executionContext) _Stream_characterID(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CharacterID, nil
+		return obj.Vitality, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8674,12 +9703,12 @@ func (ec *executionContext) _Stream_characterID(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_homeWorld(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_intelligence(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8687,7 +9716,7 @@ func (ec *executionContext) _Stream_homeWorld(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8697,7 +9726,7 @@ func (ec *executionContext) _Stream_homeWorld(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HomeWorld, nil
+		return obj.Intelligence, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8709,12 +9738,12 @@ func (ec *executionContext) _Stream_homeWorld(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(World)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNWorld2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_currentWorld(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_mind(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8722,7 +9751,7 @@ func (ec *executionContext) _Stream_currentWorld(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8732,7 +9761,7 @@ func (ec *executionContext) _Stream_currentWorld(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrentWorld, nil
+		return obj.Mind, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8744,12 +9773,12 @@ func (ec *executionContext) _Stream_currentWorld(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(World)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNWorld2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_place(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_piety(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8757,7 +9786,7 @@ func (ec *executionContext) _Stream_place(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8767,7 +9796,7 @@ func (ec *executionContext) _Stream_place(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Place, nil
+		return obj.Piety, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8779,12 +9808,12 @@ func (ec *executionContext) _Stream_place(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Place)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNPlace2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_enmity(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_hp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8792,7 +9821,7 @@ func (ec *executionContext) _Stream_enmity(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8802,7 +9831,7 @@ func (ec *executionContext) _Stream_enmity(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Enmity, nil
+		return obj.Hp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8814,12 +9843,12 @@ func (ec *executionContext) _Stream_enmity(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Enmity)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNEnmity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_craftingInfo(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_mp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8827,7 +9856,7 @@ func (ec *executionContext) _Stream_craftingInfo(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8837,21 +9866,24 @@ func (ec *executionContext) _Stream_craftingInfo(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CraftingInfo, nil
+		return obj.Mp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*CraftingInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOCraftingInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐCraftingInfo(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_stats(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_tp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8859,7 +9891,7 @@ func (ec *executionContext) _Stream_stats(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8869,21 +9901,24 @@ func (ec *executionContext) _Stream_stats(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Stats, nil
+		return obj.Tp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*Stats)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOStats2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStats(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stream_entities(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_gp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8891,17 +9926,17 @@ func (ec *executionContext) _Stream_entities(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Stream",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entities(), nil
+		return obj.Gp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8913,12 +9948,12 @@ func (ec *executionContext) _Stream_entities(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]Entity)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _StreamEvent_streamID(ctx context.Context, field graphql.CollectedField, obj *StreamEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_cp(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8926,7 +9961,7 @@ func (ec *executionContext) _StreamEvent_streamID(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "StreamEvent",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8936,7 +9971,7 @@ func (ec *executionContext) _StreamEvent_streamID(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StreamID, nil
+		return obj.Cp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8953,7 +9988,7 @@ func (ec *executionContext) _StreamEvent_streamID(ctx context.Context, field gra
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _StreamEvent_type(ctx context.Context, field graphql.CollectedField, obj *StreamEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_delay(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8961,7 +9996,7 @@ func (ec *executionContext) _StreamEvent_type(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "StreamEvent",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -8971,7 +10006,7 @@ func (ec *executionContext) _StreamEvent_type(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Delay, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8983,102 +10018,82 @@ func (ec *executionContext) _StreamEvent_type(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(StreamEventType)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStreamEventType2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEventType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_streamEvent(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _Stats_tenacity(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().StreamEvent(rctx)
+		return obj.Tenacity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *StreamEvent)
-		if !ok {
-			return nil
-		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalNStreamEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEvent(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_entityEvent(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _Stats_attackPower(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().EntityEvent(rctx)
+		return obj.AttackPower, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *EntityEvent)
-		if !ok {
-			return nil
-		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalNEntityEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateCastingInfo_castingInfo(ctx context.Context, field graphql.CollectedField, obj *UpdateCastingInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_defense(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9086,7 +10101,7 @@ func (ec *executionContext) _UpdateCastingInfo_castingInfo(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateCastingInfo",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9096,21 +10111,24 @@ func (ec *executionContext) _UpdateCastingInfo_castingInfo(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CastingInfo, nil
+		return obj.Defense, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*CastingInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOCastingInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐCastingInfo(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateClass_classJob(ctx context.Context, field graphql.CollectedField, obj *UpdateClass) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_directHitRate(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9118,7 +10136,7 @@ func (ec *executionContext) _UpdateClass_classJob(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateClass",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9128,7 +10146,7 @@ func (ec *executionContext) _UpdateClass_classJob(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClassJob, nil
+		return obj.DirectHitRate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9140,12 +10158,12 @@ func (ec *executionContext) _UpdateClass_classJob(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ClassJob)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNClassJob2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐClassJob(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateClass_level(ctx context.Context, field graphql.CollectedField, obj *UpdateClass) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_evasion(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9153,7 +10171,7 @@ func (ec *executionContext) _UpdateClass_level(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateClass",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9163,7 +10181,7 @@ func (ec *executionContext) _UpdateClass_level(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Level, nil
+		return obj.Evasion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9180,7 +10198,7 @@ func (ec *executionContext) _UpdateClass_level(ctx context.Context, field graphq
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateCraftingInfo_craftingInfo(ctx context.Context, field graphql.CollectedField, obj *UpdateCraftingInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_magicDefense(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9188,7 +10206,7 @@ func (ec *executionContext) _UpdateCraftingInfo_craftingInfo(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateCraftingInfo",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9198,21 +10216,24 @@ func (ec *executionContext) _UpdateCraftingInfo_craftingInfo(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CraftingInfo, nil
+		return obj.MagicDefense, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*CraftingInfo)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOCraftingInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐCraftingInfo(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateEnmity_enmity(ctx context.Context, field graphql.CollectedField, obj *UpdateEnmity) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_criticalHit(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9220,7 +10241,7 @@ func (ec *executionContext) _UpdateEnmity_enmity(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateEnmity",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9230,7 +10251,7 @@ func (ec *executionContext) _UpdateEnmity_enmity(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Enmity, nil
+		return obj.CriticalHit, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9242,12 +10263,12 @@ func (ec *executionContext) _UpdateEnmity_enmity(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Enmity)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNEnmity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateIDs_serverID(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_attackMagicPotency(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9255,7 +10276,7 @@ func (ec *executionContext) _UpdateIDs_serverID(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateIDs",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9265,7 +10286,7 @@ func (ec *executionContext) _UpdateIDs_serverID(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServerID, nil
+		return obj.AttackMagicPotency, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9282,7 +10303,7 @@ func (ec *executionContext) _UpdateIDs_serverID(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateIDs_instanceNum(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_healingMagicPotency(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9290,7 +10311,7 @@ func (ec *executionContext) _UpdateIDs_instanceNum(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateIDs",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9300,7 +10321,7 @@ func (ec *executionContext) _UpdateIDs_instanceNum(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InstanceNum, nil
+		return obj.HealingMagicPotency, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9317,7 +10338,7 @@ func (ec *executionContext) _UpdateIDs_instanceNum(ctx context.Context, field gr
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateIDs_characterID(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_elementalBonus(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9325,7 +10346,7 @@ func (ec *executionContext) _UpdateIDs_characterID(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateIDs",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9335,7 +10356,7 @@ func (ec *executionContext) _UpdateIDs_characterID(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CharacterID, nil
+		return obj.ElementalBonus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9347,12 +10368,12 @@ func (ec *executionContext) _UpdateIDs_characterID(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateIDs_homeWorld(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_determination(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9360,7 +10381,7 @@ func (ec *executionContext) _UpdateIDs_homeWorld(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateIDs",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9370,7 +10391,7 @@ func (ec *executionContext) _UpdateIDs_homeWorld(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HomeWorld, nil
+		return obj.Determination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9382,12 +10403,12 @@ func (ec *executionContext) _UpdateIDs_homeWorld(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*World)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNWorld2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateIDs_currentWorld(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_skillSpeed(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9395,7 +10416,7 @@ func (ec *executionContext) _UpdateIDs_currentWorld(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateIDs",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9405,7 +10426,7 @@ func (ec *executionContext) _UpdateIDs_currentWorld(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrentWorld, nil
+		return obj.SkillSpeed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9417,12 +10438,12 @@ func (ec *executionContext) _UpdateIDs_currentWorld(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*World)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNWorld2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateLastAction_action(ctx context.Context, field graphql.CollectedField, obj *UpdateLastAction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_spellSpeed(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9430,7 +10451,7 @@ func (ec *executionContext) _UpdateLastAction_action(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateLastAction",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9440,7 +10461,7 @@ func (ec *executionContext) _UpdateLastAction_action(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Action, nil
+		return obj.SpellSpeed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9452,12 +10473,12 @@ func (ec *executionContext) _UpdateLastAction_action(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Action)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNAction2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAction(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateLocation_location(ctx context.Context, field graphql.CollectedField, obj *UpdateLocation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_haste(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9465,7 +10486,7 @@ func (ec *executionContext) _UpdateLocation_location(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateLocation",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9475,7 +10496,7 @@ func (ec *executionContext) _UpdateLocation_location(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.Haste, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9487,12 +10508,12 @@ func (ec *executionContext) _UpdateLocation_location(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Location)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLocation2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐLocation(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateLockonMarker_lockonMarker(ctx context.Context, field graphql.CollectedField, obj *UpdateLockonMarker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_craftsmanship(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9500,7 +10521,7 @@ func (ec *executionContext) _UpdateLockonMarker_lockonMarker(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateLockonMarker",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9510,7 +10531,7 @@ func (ec *executionContext) _UpdateLockonMarker_lockonMarker(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LockonMarker, nil
+		return obj.Craftsmanship, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9527,7 +10548,7 @@ func (ec *executionContext) _UpdateLockonMarker_lockonMarker(ctx context.Context
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateMap_place(ctx context.Context, field graphql.CollectedField, obj *UpdateMap) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_control(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9535,7 +10556,7 @@ func (ec *executionContext) _UpdateMap_place(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateMap",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9545,7 +10566,7 @@ func (ec *executionContext) _UpdateMap_place(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Place, nil
+		return obj.Control, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9557,12 +10578,12 @@ func (ec *executionContext) _UpdateMap_place(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Place)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNPlace2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateResources_resources(ctx context.Context, field graphql.CollectedField, obj *UpdateResources) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_gathering(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9570,7 +10591,7 @@ func (ec *executionContext) _UpdateResources_resources(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateResources",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9580,7 +10601,7 @@ func (ec *executionContext) _UpdateResources_resources(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Resources, nil
+		return obj.Gathering, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9592,12 +10613,12 @@ func (ec *executionContext) _UpdateResources_resources(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Resources)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNResources2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐResources(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateStats_stats(ctx context.Context, field graphql.CollectedField, obj *UpdateStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stats_perception(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9605,7 +10626,7 @@ func (ec *executionContext) _UpdateStats_stats(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateStats",
+		Object:     "Stats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9615,7 +10636,7 @@ func (ec *executionContext) _UpdateStats_stats(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Stats, nil
+		return obj.Perception, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9627,12 +10648,12 @@ func (ec *executionContext) _UpdateStats_stats(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Stats)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStats2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStats(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpdateTarget_targetID(ctx context.Context, field graphql.CollectedField, obj *UpdateTarget) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_id(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9640,7 +10661,7 @@ func (ec *executionContext) _UpdateTarget_targetID(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpdateTarget",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9650,7 +10671,7 @@ func (ec *executionContext) _UpdateTarget_targetID(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TargetID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9662,12 +10683,12 @@ func (ec *executionContext) _UpdateTarget_targetID(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpsertStatus_index(ctx context.Context, field graphql.CollectedField, obj *UpsertStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_param(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9675,7 +10696,7 @@ func (ec *executionContext) _UpsertStatus_index(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpsertStatus",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9685,7 +10706,7 @@ func (ec *executionContext) _UpsertStatus_index(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Index, nil
+		return obj.Param, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9702,7 +10723,7 @@ func (ec *executionContext) _UpsertStatus_index(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UpsertStatus_status(ctx context.Context, field graphql.CollectedField, obj *UpsertStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_name(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9710,7 +10731,7 @@ func (ec *executionContext) _UpsertStatus_status(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UpsertStatus",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9720,7 +10741,7 @@ func (ec *executionContext) _UpsertStatus_status(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9732,12 +10753,12 @@ func (ec *executionContext) _UpsertStatus_status(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Status)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNStatus2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _World_id(ctx context.Context, field graphql.CollectedField, obj *World) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_description(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9745,7 +10766,7 @@ func (ec *executionContext) _World_id(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "World",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9755,7 +10776,7 @@ func (ec *executionContext) _World_id(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9767,12 +10788,12 @@ func (ec *executionContext) _World_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _World_name(ctx context.Context, field graphql.CollectedField, obj *World) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_startedTime(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9780,7 +10801,7 @@ func (ec *executionContext) _World_name(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "World",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9790,7 +10811,7 @@ func (ec *executionContext) _World_name(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.StartedTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9802,12 +10823,12 @@ func (ec *executionContext) _World_name(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_duration(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9815,7 +10836,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9825,7 +10846,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Duration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9837,12 +10858,12 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_actorID(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9850,7 +10871,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9860,21 +10881,24 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.ActorID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNUint2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_lastTick(ctx context.Context, field graphql.CollectedField, obj *Status) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9882,7 +10906,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Status",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9892,7 +10916,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.LastTick, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9904,12 +10928,12 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_id(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9917,7 +10941,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9927,7 +10951,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9939,12 +10963,12 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_serverID(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9952,7 +10976,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9962,7 +10986,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.ServerID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9974,12 +10998,12 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_instanceNum(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9987,7 +11011,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -9997,7 +11021,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.InstanceNum, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10009,12 +11033,12 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_characterID(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10022,7 +11046,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10032,21 +11056,24 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.CharacterID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNUint2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_homeWorld(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10054,17 +11081,17 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.HomeWorld, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10076,12 +11103,12 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(World)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNWorld2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_currentWorld(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10089,31 +11116,34 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.CurrentWorld, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(World)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNWorld2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_place(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10121,7 +11151,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10131,7 +11161,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Place, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10143,12 +11173,12 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(Place)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPlace2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_enmity(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10156,7 +11186,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10166,21 +11196,24 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Enmity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(Enmity)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNEnmity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_craftingInfo(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10188,7 +11221,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10196,33 +11229,23 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Field_args_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.CraftingInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*CraftingInfo)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOCraftingInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐCraftingInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_stats(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10230,7 +11253,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10240,24 +11263,21 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Stats, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*Stats)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOStats2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_source(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10265,34 +11285,31 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Source, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*StreamSource)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOStreamSource2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_status(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10300,17 +11317,17 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10319,12 +11336,12 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*Health)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOHealth2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealth(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_restored(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10332,7 +11349,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10342,7 +11359,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Restored, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10354,12 +11371,12 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_entities(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10367,31 +11384,34 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Entities(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]Entity)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Stream_departed(ctx context.Context, field graphql.CollectedField, obj *Stream) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10399,7 +11419,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Stream",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10409,7 +11429,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Departed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10421,12 +11441,12 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]DepartedEntity)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNDepartedEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDepartedEntityᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamCommand_name(ctx context.Context, field graphql.CollectedField, obj *StreamCommand) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10434,7 +11454,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "StreamCommand",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -10444,21 +11464,24 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamCommand_description(ctx context.Context, field graphql.CollectedField, obj *StreamCommand) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10466,17 +11489,17 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "StreamCommand",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10488,12 +11511,12 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamCommand_schema(ctx context.Context, field graphql.CollectedField, obj *StreamCommand) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10501,17 +11524,17 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "StreamCommand",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.Schema, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10523,12 +11546,12 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamEvent_streamID(ctx context.Context, field graphql.CollectedField, obj *StreamEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10536,31 +11559,34 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "StreamEvent",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.StreamID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamEvent_type(ctx context.Context, field graphql.CollectedField, obj *StreamEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10568,31 +11594,34 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "StreamEvent",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(StreamEventType)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNStreamEventType2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamSource_adapter(ctx context.Context, field graphql.CollectedField, obj *StreamSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10600,17 +11629,17 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "StreamSource",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.Adapter, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10622,12 +11651,12 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _StreamSource_key(ctx context.Context, field graphql.CollectedField, obj *StreamSource) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10635,17 +11664,17 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "StreamSource",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10659,74 +11688,100 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_streamEvent(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Subscription",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return ec.resolvers.Subscription().StreamEvent(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *StreamEvent)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNStreamEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEvent(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_entityEvent(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Subscription",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Subscription().EntityEvent(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *EntityEvent)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNEntityEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThrottleStat_datatype(ctx context.Context, field graphql.CollectedField, obj *ThrottleStat) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10734,38 +11789,34 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ThrottleStat",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
+		return obj.Datatype, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThrottleStat_policy(ctx context.Context, field graphql.CollectedField, obj *ThrottleStat) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10773,31 +11824,34 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ThrottleStat",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Policy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(ThrottlePolicy)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNThrottlePolicy2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottlePolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _ThrottleStat_dropped(ctx context.Context, field graphql.CollectedField, obj *ThrottleStat) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10805,31 +11859,34 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ThrottleStat",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.Dropped, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _UpdateCastingInfo_castingInfo(ctx context.Context, field graphql.CollectedField, obj *UpdateCastingInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10837,38 +11894,66 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "UpdateCastingInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CastingInfo, nil
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	fc.Args = args
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*CastingInfo)
+	fc.Result = res
+	return ec.marshalOCastingInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐCastingInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateClass_classJob(ctx context.Context, field graphql.CollectedField, obj *UpdateClass) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateClass",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+		return obj.ClassJob, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(*ClassJob)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNClassJob2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐClassJob(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _UpdateClass_level(ctx context.Context, field graphql.CollectedField, obj *UpdateClass) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10876,31 +11961,34 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "UpdateClass",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.Level, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _UpdateCraftingInfo_craftingInfo(ctx context.Context, field graphql.CollectedField, obj *UpdateCraftingInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10908,17 +11996,17 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "UpdateCraftingInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.CraftingInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10927,225 +12015,2426 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*CraftingInfo)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOCraftingInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐCraftingInfo(ctx, field.Selections, res)
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _UpdateEnmity_enmity(ctx context.Context, field graphql.CollectedField, obj *UpdateEnmity) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateEnmity",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
 
-func (ec *executionContext) unmarshalInputStreamRequest(ctx context.Context, obj interface{}) (StreamRequest, error) {
-	var it StreamRequest
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enmity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
+	res := resTmp.(*Enmity)
+	fc.Result = res
+	return ec.marshalNEnmity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx, field.Selections, res)
+}
 
-	for k, v := range asMap {
-		switch k {
-		case "streamID":
-			var err error
+func (ec *executionContext) _UpdateIDs_serverID(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateIDs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
-			it.StreamID, err = ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "data":
-			var err error
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServerID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("data"))
-			it.Data, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _UpdateIDs_instanceNum(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateIDs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InstanceNum, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _UpdateIDs_characterID(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateIDs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
 
-func (ec *executionContext) _EntityEventType(ctx context.Context, sel ast.SelectionSet, obj EntityEventType) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CharacterID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
-	case AddEntity:
-		return ec._AddEntity(ctx, sel, &obj)
-	case *AddEntity:
-		if obj == nil {
-			return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._AddEntity(ctx, sel, obj)
-	case RemoveEntity:
-		return ec._RemoveEntity(ctx, sel, &obj)
-	case *RemoveEntity:
-		if obj == nil {
-			return graphql.Null
+		return graphql.Null
+	}
+	res := resTmp.(uint64)
+	fc.Result = res
+	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateIDs_homeWorld(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return ec._RemoveEntity(ctx, sel, obj)
-	case SetEntities:
-		return ec._SetEntities(ctx, sel, &obj)
-	case *SetEntities:
-		if obj == nil {
-			return graphql.Null
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateIDs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HomeWorld, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._SetEntities(ctx, sel, obj)
-	case UpdateTarget:
-		return ec._UpdateTarget(ctx, sel, &obj)
-	case *UpdateTarget:
-		if obj == nil {
-			return graphql.Null
+		return graphql.Null
+	}
+	res := resTmp.(*World)
+	fc.Result = res
+	return ec.marshalNWorld2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateIDs_currentWorld(ctx context.Context, field graphql.CollectedField, obj *UpdateIDs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return ec._UpdateTarget(ctx, sel, obj)
-	case UpdateClass:
-		return ec._UpdateClass(ctx, sel, &obj)
-	case *UpdateClass:
-		if obj == nil {
-			return graphql.Null
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateIDs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CurrentWorld, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._UpdateClass(ctx, sel, obj)
-	case UpdateLastAction:
-		return ec._UpdateLastAction(ctx, sel, &obj)
-	case *UpdateLastAction:
-		if obj == nil {
-			return graphql.Null
+		return graphql.Null
+	}
+	res := resTmp.(*World)
+	fc.Result = res
+	return ec.marshalNWorld2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐWorld(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateLastAction_action(ctx context.Context, field graphql.CollectedField, obj *UpdateLastAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return ec._UpdateLastAction(ctx, sel, obj)
-	case UpdateCastingInfo:
-		return ec._UpdateCastingInfo(ctx, sel, &obj)
-	case *UpdateCastingInfo:
-		if obj == nil {
-			return graphql.Null
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateLastAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._UpdateCastingInfo(ctx, sel, obj)
-	case UpsertStatus:
-		return ec._UpsertStatus(ctx, sel, &obj)
-	case *UpsertStatus:
-		if obj == nil {
-			return graphql.Null
+		return graphql.Null
+	}
+	res := resTmp.(*Action)
+	fc.Result = res
+	return ec.marshalNAction2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateLocation_location(ctx context.Context, field graphql.CollectedField, obj *UpdateLocation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return ec._UpsertStatus(ctx, sel, obj)
-	case RemoveStatus:
-		return ec._RemoveStatus(ctx, sel, &obj)
-	case *RemoveStatus:
-		if obj == nil {
-			return graphql.Null
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateLocation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._RemoveStatus(ctx, sel, obj)
-	case UpdateLocation:
-		return ec._UpdateLocation(ctx, sel, &obj)
-	case *UpdateLocation:
-		if obj == nil {
-			return graphql.Null
+		return graphql.Null
+	}
+	res := resTmp.(*Location)
+	fc.Result = res
+	return ec.marshalNLocation2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateLockonMarker_lockonMarker(ctx context.Context, field graphql.CollectedField, obj *UpdateLockonMarker) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return ec._UpdateLocation(ctx, sel, obj)
-	case UpdateResources:
-		return ec._UpdateResources(ctx, sel, &obj)
-	case *UpdateResources:
-		if obj == nil {
-			return graphql.Null
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateLockonMarker",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LockonMarker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._UpdateResources(ctx, sel, obj)
-	case UpdateLockonMarker:
-		return ec._UpdateLockonMarker(ctx, sel, &obj)
-	case *UpdateLockonMarker:
-		if obj == nil {
-			return graphql.Null
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateMap_place(ctx context.Context, field graphql.CollectedField, obj *UpdateMap) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateMap",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Place, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Place)
+	fc.Result = res
+	return ec.marshalNPlace2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateResources_resources(ctx context.Context, field graphql.CollectedField, obj *UpdateResources) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateResources",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Resources, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Resources)
+	fc.Result = res
+	return ec.marshalNResources2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐResources(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateStats_stats(ctx context.Context, field graphql.CollectedField, obj *UpdateStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateStats",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Stats, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Stats)
+	fc.Result = res
+	return ec.marshalNStats2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStats(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateStreamStatus_status(ctx context.Context, field graphql.CollectedField, obj *UpdateStreamStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateStreamStatus",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Health)
+	fc.Result = res
+	return ec.marshalNHealth2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealth(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpdateTarget_targetID(ctx context.Context, field graphql.CollectedField, obj *UpdateTarget) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpdateTarget",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TargetID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint64)
+	fc.Result = res
+	return ec.marshalNUint2uint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpsertStatus_index(ctx context.Context, field graphql.CollectedField, obj *UpsertStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpsertStatus",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Index, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UpsertStatus_status(ctx context.Context, field graphql.CollectedField, obj *UpsertStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UpsertStatus",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Status)
+	fc.Result = res
+	return ec.marshalNStatus2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _World_id(ctx context.Context, field graphql.CollectedField, obj *World) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "World",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _World_name(ctx context.Context, field graphql.CollectedField, obj *World) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "World",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Field_args_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputStreamRequest(ctx context.Context, obj interface{}) (StreamRequest, error) {
+	var it StreamRequest
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "streamID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("streamID"))
+			it.StreamID, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "data":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("data"))
+			it.Data, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _EntityEventType(ctx context.Context, sel ast.SelectionSet, obj EntityEventType) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case AddEntity:
+		return ec._AddEntity(ctx, sel, &obj)
+	case *AddEntity:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AddEntity(ctx, sel, obj)
+	case RemoveEntity:
+		return ec._RemoveEntity(ctx, sel, &obj)
+	case *RemoveEntity:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RemoveEntity(ctx, sel, obj)
+	case SetEntities:
+		return ec._SetEntities(ctx, sel, &obj)
+	case *SetEntities:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SetEntities(ctx, sel, obj)
+	case UpdateTarget:
+		return ec._UpdateTarget(ctx, sel, &obj)
+	case *UpdateTarget:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateTarget(ctx, sel, obj)
+	case UpdateClass:
+		return ec._UpdateClass(ctx, sel, &obj)
+	case *UpdateClass:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateClass(ctx, sel, obj)
+	case UpdateLastAction:
+		return ec._UpdateLastAction(ctx, sel, &obj)
+	case *UpdateLastAction:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateLastAction(ctx, sel, obj)
+	case UpdateCastingInfo:
+		return ec._UpdateCastingInfo(ctx, sel, &obj)
+	case *UpdateCastingInfo:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateCastingInfo(ctx, sel, obj)
+	case UpsertStatus:
+		return ec._UpsertStatus(ctx, sel, &obj)
+	case *UpsertStatus:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpsertStatus(ctx, sel, obj)
+	case RemoveStatus:
+		return ec._RemoveStatus(ctx, sel, &obj)
+	case *RemoveStatus:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RemoveStatus(ctx, sel, obj)
+	case UpdateLocation:
+		return ec._UpdateLocation(ctx, sel, &obj)
+	case *UpdateLocation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateLocation(ctx, sel, obj)
+	case UpdateResources:
+		return ec._UpdateResources(ctx, sel, &obj)
+	case *UpdateResources:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateResources(ctx, sel, obj)
+	case UpdateLockonMarker:
+		return ec._UpdateLockonMarker(ctx, sel, &obj)
+	case *UpdateLockonMarker:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateLockonMarker(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _StreamEventType(ctx context.Context, sel ast.SelectionSet, obj StreamEventType) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case AddStream:
+		return ec._AddStream(ctx, sel, &obj)
+	case *AddStream:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AddStream(ctx, sel, obj)
+	case RemoveStream:
+		return ec._RemoveStream(ctx, sel, &obj)
+	case *RemoveStream:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RemoveStream(ctx, sel, obj)
+	case UpdateIDs:
+		return ec._UpdateIDs(ctx, sel, &obj)
+	case *UpdateIDs:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateIDs(ctx, sel, obj)
+	case UpdateMap:
+		return ec._UpdateMap(ctx, sel, &obj)
+	case *UpdateMap:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateMap(ctx, sel, obj)
+	case UpdateCraftingInfo:
+		return ec._UpdateCraftingInfo(ctx, sel, &obj)
+	case *UpdateCraftingInfo:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateCraftingInfo(ctx, sel, obj)
+	case UpdateEnmity:
+		return ec._UpdateEnmity(ctx, sel, &obj)
+	case *UpdateEnmity:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateEnmity(ctx, sel, obj)
+	case UpdateStats:
+		return ec._UpdateStats(ctx, sel, &obj)
+	case *UpdateStats:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateStats(ctx, sel, obj)
+	case UpdateStreamStatus:
+		return ec._UpdateStreamStatus(ctx, sel, &obj)
+	case *UpdateStreamStatus:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UpdateStreamStatus(ctx, sel, obj)
+	case ChatEvent:
+		return ec._ChatEvent(ctx, sel, &obj)
+	case *ChatEvent:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ChatEvent(ctx, sel, obj)
+	case HookMessage:
+		return ec._HookMessage(ctx, sel, &obj)
+	case *HookMessage:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._HookMessage(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var actionImplementors = []string{"Action"}
+
+func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, obj *Action) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, actionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Action")
+		case "targetID":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_targetID(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "globalCounter":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_globalCounter(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "animationLockTime":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_animationLockTime(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hiddenAnimation":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_hiddenAnimation(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "location":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_location(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "id":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_id(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "variation":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_variation(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "effectDisplayType":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_effectDisplayType(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isAoE":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_isAoE(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "effects":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_effects(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "effectFlags":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_effectFlags(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "useTime":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Action_useTime(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var actionEffectImplementors = []string{"ActionEffect"}
+
+func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.SelectionSet, obj *ActionEffect) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, actionEffectImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ActionEffect")
+		case "targetID":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_targetID(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_type(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hitSeverity":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_hitSeverity(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "param":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_param(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bonusPercent":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_bonusPercent(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "valueMultiplier":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_valueMultiplier(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "flags":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_flags(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ActionEffect_value(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._UpdateLockonMarker(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) _StreamEventType(ctx context.Context, sel ast.SelectionSet, obj StreamEventType) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case AddStream:
-		return ec._AddStream(ctx, sel, &obj)
-	case *AddStream:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AddStream(ctx, sel, obj)
-	case RemoveStream:
-		return ec._RemoveStream(ctx, sel, &obj)
-	case *RemoveStream:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._RemoveStream(ctx, sel, obj)
-	case UpdateIDs:
-		return ec._UpdateIDs(ctx, sel, &obj)
-	case *UpdateIDs:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._UpdateIDs(ctx, sel, obj)
-	case UpdateMap:
-		return ec._UpdateMap(ctx, sel, &obj)
-	case *UpdateMap:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._UpdateMap(ctx, sel, obj)
-	case UpdateCraftingInfo:
-		return ec._UpdateCraftingInfo(ctx, sel, &obj)
-	case *UpdateCraftingInfo:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._UpdateCraftingInfo(ctx, sel, obj)
-	case UpdateEnmity:
-		return ec._UpdateEnmity(ctx, sel, &obj)
-	case *UpdateEnmity:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._UpdateEnmity(ctx, sel, obj)
-	case UpdateStats:
-		return ec._UpdateStats(ctx, sel, &obj)
-	case *UpdateStats:
-		if obj == nil {
-			return graphql.Null
+var adapterImplementors = []string{"Adapter"}
+
+func (ec *executionContext) _Adapter(ctx context.Context, sel ast.SelectionSet, obj *Adapter) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, adapterImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Adapter")
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Adapter_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "health":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Adapter_health(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._UpdateStats(ctx, sel, obj)
-	case ChatEvent:
-		return ec._ChatEvent(ctx, sel, &obj)
-	case *ChatEvent:
-		if obj == nil {
-			return graphql.Null
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var addEntityImplementors = []string{"AddEntity", "EntityEventType"}
+
+func (ec *executionContext) _AddEntity(ctx context.Context, sel ast.SelectionSet, obj *AddEntity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addEntityImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddEntity")
+		case "entity":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._AddEntity_entity(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._ChatEvent(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion ************************** interface.gotpl ***************************
+var addStreamImplementors = []string{"AddStream", "StreamEventType"}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) _AddStream(ctx context.Context, sel ast.SelectionSet, obj *AddStream) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addStreamImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddStream")
+		case "stream":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._AddStream_stream(ctx, field, obj)
+			}
 
-var actionImplementors = []string{"Action"}
+			out.Values[i] = innerFunc(ctx)
 
-func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, obj *Action) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, actionImplementors)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var castingInfoImplementors = []string{"CastingInfo"}
+
+func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionSet, obj *CastingInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, castingInfoImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Action")
+			out.Values[i] = graphql.MarshalString("CastingInfo")
+		case "actionID":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_actionID(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "actionName":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_actionName(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startTime":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_startTime(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "castTime":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_castTime(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "targetID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_targetID(ctx, field, obj)
+				return ec._CastingInfo_targetID(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "location":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_location(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "castType":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_castType(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "effectRange":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_effectRange(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "xAxisModifier":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CastingInfo_xAxisModifier(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11153,9 +14442,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
+		case "omen":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_name(ctx, field, obj)
+				return ec._CastingInfo_omen(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11163,9 +14452,30 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "globalCounter":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var chatEventImplementors = []string{"ChatEvent", "StreamEventType"}
+
+func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet, obj *ChatEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatEventImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChatEvent")
+		case "channelID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_globalCounter(ctx, field, obj)
+				return ec._ChatEvent_channelID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11173,9 +14483,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "animationLockTime":
+		case "channelWorld":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_animationLockTime(ctx, field, obj)
+				return ec._ChatEvent_channelWorld(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11183,9 +14493,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "hiddenAnimation":
+		case "channelType":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_hiddenAnimation(ctx, field, obj)
+				return ec._ChatEvent_channelType(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11193,9 +14503,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "location":
+		case "contentID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_location(ctx, field, obj)
+				return ec._ChatEvent_contentID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11203,9 +14513,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "id":
+		case "entityID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_id(ctx, field, obj)
+				return ec._ChatEvent_entityID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11213,9 +14523,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "variation":
+		case "world":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_variation(ctx, field, obj)
+				return ec._ChatEvent_world(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11223,9 +14533,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "effectDisplayType":
+		case "name":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_effectDisplayType(ctx, field, obj)
+				return ec._ChatEvent_name(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11233,9 +14543,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isAoE":
+		case "message":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_isAoE(ctx, field, obj)
+				return ec._ChatEvent_message(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11243,9 +14553,30 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "effects":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var classJobImplementors = []string{"ClassJob"}
+
+func (ec *executionContext) _ClassJob(ctx context.Context, sel ast.SelectionSet, obj *ClassJob) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, classJobImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ClassJob")
+		case "id":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_effects(ctx, field, obj)
+				return ec._ClassJob_id(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11253,9 +14584,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "effectFlags":
+		case "name":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_effectFlags(ctx, field, obj)
+				return ec._ClassJob_name(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11263,9 +14594,9 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "useTime":
+		case "abbreviation":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Action_useTime(ctx, field, obj)
+				return ec._ClassJob_abbreviation(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11284,19 +14615,19 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var actionEffectImplementors = []string{"ActionEffect"}
+var craftingInfoImplementors = []string{"CraftingInfo"}
 
-func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.SelectionSet, obj *ActionEffect) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, actionEffectImplementors)
+func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.SelectionSet, obj *CraftingInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, craftingInfoImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ActionEffect")
-		case "targetID":
+			out.Values[i] = graphql.MarshalString("CraftingInfo")
+		case "recipe":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_targetID(ctx, field, obj)
+				return ec._CraftingInfo_recipe(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11304,9 +14635,9 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "type":
+		case "lastCraftActionID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_type(ctx, field, obj)
+				return ec._CraftingInfo_lastCraftActionID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11314,9 +14645,9 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "hitSeverity":
+		case "lastCraftActionName":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_hitSeverity(ctx, field, obj)
+				return ec._CraftingInfo_lastCraftActionName(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11324,9 +14655,9 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "param":
+		case "stepNum":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_param(ctx, field, obj)
+				return ec._CraftingInfo_stepNum(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11334,9 +14665,9 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "bonusPercent":
+		case "progress":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_bonusPercent(ctx, field, obj)
+				return ec._CraftingInfo_progress(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11344,9 +14675,9 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "valueMultiplier":
+		case "progressDelta":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_valueMultiplier(ctx, field, obj)
+				return ec._CraftingInfo_progressDelta(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11354,9 +14685,9 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "flags":
+		case "quality":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_flags(ctx, field, obj)
+				return ec._CraftingInfo_quality(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11364,9 +14695,89 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "value":
+		case "qualityDelta":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ActionEffect_value(ctx, field, obj)
+				return ec._CraftingInfo_qualityDelta(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hqChance":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_hqChance(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "durability":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_durability(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "durabilityDelta":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_durabilityDelta(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "currentCondition":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_currentCondition(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "previousCondition":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_previousCondition(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "completed":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_completed(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "failed":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_failed(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reuseProc":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._CraftingInfo_reuseProc(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11385,19 +14796,39 @@ func (ec *executionContext) _ActionEffect(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var addEntityImplementors = []string{"AddEntity", "EntityEventType"}
+var departedEntityImplementors = []string{"DepartedEntity"}
 
-func (ec *executionContext) _AddEntity(ctx context.Context, sel ast.SelectionSet, obj *AddEntity) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addEntityImplementors)
+func (ec *executionContext) _DepartedEntity(ctx context.Context, sel ast.SelectionSet, obj *DepartedEntity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, departedEntityImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddEntity")
+			out.Values[i] = graphql.MarshalString("DepartedEntity")
 		case "entity":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._AddEntity_entity(ctx, field, obj)
+				return ec._DepartedEntity_entity(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "despawnTime":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._DepartedEntity_despawnTime(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reason":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._DepartedEntity_reason(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11416,19 +14847,29 @@ func (ec *executionContext) _AddEntity(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var addStreamImplementors = []string{"AddStream", "StreamEventType"}
+var enmityImplementors = []string{"Enmity"}
 
-func (ec *executionContext) _AddStream(ctx context.Context, sel ast.SelectionSet, obj *AddStream) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addStreamImplementors)
+func (ec *executionContext) _Enmity(ctx context.Context, sel ast.SelectionSet, obj *Enmity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, enmityImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddStream")
-		case "stream":
+			out.Values[i] = graphql.MarshalString("Enmity")
+		case "targetHateRanking":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._AddStream_stream(ctx, field, obj)
+				return ec._Enmity_targetHateRanking(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "nearbyEnemyHate":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Enmity_nearbyEnemyHate(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11447,19 +14888,19 @@ func (ec *executionContext) _AddStream(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var castingInfoImplementors = []string{"CastingInfo"}
+var entityImplementors = []string{"Entity"}
 
-func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionSet, obj *CastingInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, castingInfoImplementors)
+func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, obj *Entity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, entityImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CastingInfo")
-		case "actionID":
+			out.Values[i] = graphql.MarshalString("Entity")
+		case "id":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_actionID(ctx, field, obj)
+				return ec._Entity_id(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11467,9 +14908,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "actionName":
+		case "index":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_actionName(ctx, field, obj)
+				return ec._Entity_index(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11477,9 +14918,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "startTime":
+		case "name":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_startTime(ctx, field, obj)
+				return ec._Entity_name(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11487,9 +14928,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "castTime":
+		case "targetID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_castTime(ctx, field, obj)
+				return ec._Entity_targetID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11497,9 +14938,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "targetID":
+		case "ownerID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_targetID(ctx, field, obj)
+				return ec._Entity_ownerID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11507,9 +14948,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "location":
+		case "level":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_location(ctx, field, obj)
+				return ec._Entity_level(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11517,9 +14958,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "castType":
+		case "classJob":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_castType(ctx, field, obj)
+				return ec._Entity_classJob(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11527,9 +14968,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "effectRange":
+		case "isNPC":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_effectRange(ctx, field, obj)
+				return ec._Entity_isNPC(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11537,9 +14978,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "xAxisModifier":
+		case "isEnemy":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_xAxisModifier(ctx, field, obj)
+				return ec._Entity_isEnemy(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11547,9 +14988,9 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "omen":
+		case "isPet":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CastingInfo_omen(ctx, field, obj)
+				return ec._Entity_isPet(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11557,40 +14998,16 @@ func (ec *executionContext) _CastingInfo(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var chatEventImplementors = []string{"ChatEvent", "StreamEventType"}
-
-func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet, obj *ChatEvent) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, chatEventImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ChatEvent")
-		case "channelID":
+		case "bNPCInfo":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_channelID(ctx, field, obj)
+				return ec._Entity_bNPCInfo(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "channelWorld":
+		case "resources":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_channelWorld(ctx, field, obj)
+				return ec._Entity_resources(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11598,9 +15015,9 @@ func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "channelType":
+		case "location":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_channelType(ctx, field, obj)
+				return ec._Entity_location(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11608,19 +15025,16 @@ func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "contentID":
+		case "lastAction":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_contentID(ctx, field, obj)
+				return ec._Entity_lastAction(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "entityID":
+		case "statuses":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_entityID(ctx, field, obj)
+				return ec._Entity_statuses(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11628,9 +15042,9 @@ func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "world":
+		case "lockonMarker":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_world(ctx, field, obj)
+				return ec._Entity_lockonMarker(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11638,19 +15052,16 @@ func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
+		case "castingInfo":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_name(ctx, field, obj)
+				return ec._Entity_castingInfo(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "message":
+		case "rawSpawnJSONData":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ChatEvent_message(ctx, field, obj)
+				return ec._Entity_rawSpawnJSONData(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11669,19 +15080,19 @@ func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var classJobImplementors = []string{"ClassJob"}
+var entityEventImplementors = []string{"EntityEvent"}
 
-func (ec *executionContext) _ClassJob(ctx context.Context, sel ast.SelectionSet, obj *ClassJob) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, classJobImplementors)
+func (ec *executionContext) _EntityEvent(ctx context.Context, sel ast.SelectionSet, obj *EntityEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, entityEventImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ClassJob")
-		case "id":
+			out.Values[i] = graphql.MarshalString("EntityEvent")
+		case "streamID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ClassJob_id(ctx, field, obj)
+				return ec._EntityEvent_streamID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11689,9 +15100,9 @@ func (ec *executionContext) _ClassJob(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
+		case "entityID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ClassJob_name(ctx, field, obj)
+				return ec._EntityEvent_entityID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11699,9 +15110,9 @@ func (ec *executionContext) _ClassJob(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "abbreviation":
+		case "type":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._ClassJob_abbreviation(ctx, field, obj)
+				return ec._EntityEvent_type(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11720,19 +15131,19 @@ func (ec *executionContext) _ClassJob(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var craftingInfoImplementors = []string{"CraftingInfo"}
+var hateEntryImplementors = []string{"HateEntry"}
 
-func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.SelectionSet, obj *CraftingInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, craftingInfoImplementors)
+func (ec *executionContext) _HateEntry(ctx context.Context, sel ast.SelectionSet, obj *HateEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hateEntryImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CraftingInfo")
-		case "recipe":
+			out.Values[i] = graphql.MarshalString("HateEntry")
+		case "enemyID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_recipe(ctx, field, obj)
+				return ec._HateEntry_enemyID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11740,9 +15151,9 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "lastCraftActionID":
+		case "hatePercent":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_lastCraftActionID(ctx, field, obj)
+				return ec._HateEntry_hatePercent(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11750,9 +15161,30 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "lastCraftActionName":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var hateRankingImplementors = []string{"HateRanking"}
+
+func (ec *executionContext) _HateRanking(ctx context.Context, sel ast.SelectionSet, obj *HateRanking) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hateRankingImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HateRanking")
+		case "actorID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_lastCraftActionName(ctx, field, obj)
+				return ec._HateRanking_actorID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11760,9 +15192,9 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "stepNum":
+		case "hate":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_stepNum(ctx, field, obj)
+				return ec._HateRanking_hate(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11770,9 +15202,30 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "progress":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var healthImplementors = []string{"Health"}
+
+func (ec *executionContext) _Health(ctx context.Context, sel ast.SelectionSet, obj *Health) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, healthImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Health")
+		case "state":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_progress(ctx, field, obj)
+				return ec._Health_state(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11780,39 +15233,30 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "progressDelta":
+		case "lastError":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_progressDelta(ctx, field, obj)
+				return ec._Health_lastError(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "quality":
+		case "lastPacketTime":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_quality(ctx, field, obj)
+				return ec._Health_lastPacketTime(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "qualityDelta":
+		case "pingRTT":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_qualityDelta(ctx, field, obj)
+				return ec._Health_pingRTT(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "hqChance":
+		case "packetsPerSecond":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_hqChance(ctx, field, obj)
+				return ec._Health_packetsPerSecond(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11820,9 +15264,9 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "durability":
+		case "discardedBytes":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_durability(ctx, field, obj)
+				return ec._Health_discardedBytes(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11830,9 +15274,30 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "durabilityDelta":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var hookMessageImplementors = []string{"HookMessage", "StreamEventType"}
+
+func (ec *executionContext) _HookMessage(ctx context.Context, sel ast.SelectionSet, obj *HookMessage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hookMessageImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HookMessage")
+		case "time":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_durabilityDelta(ctx, field, obj)
+				return ec._HookMessage_time(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11840,9 +15305,9 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "currentCondition":
+		case "severity":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_currentCondition(ctx, field, obj)
+				return ec._HookMessage_severity(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11850,9 +15315,9 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "previousCondition":
+		case "message":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_previousCondition(ctx, field, obj)
+				return ec._HookMessage_message(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11860,9 +15325,30 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "completed":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var journalEntryImplementors = []string{"JournalEntry"}
+
+func (ec *executionContext) _JournalEntry(ctx context.Context, sel ast.SelectionSet, obj *JournalEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, journalEntryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JournalEntry")
+		case "cursor":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_completed(ctx, field, obj)
+				return ec._JournalEntry_cursor(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11870,9 +15356,9 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "failed":
+		case "time":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_failed(ctx, field, obj)
+				return ec._JournalEntry_time(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11880,16 +15366,20 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "reuseProc":
+		case "streamEvent":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._CraftingInfo_reuseProc(ctx, field, obj)
+				return ec._JournalEntry_streamEvent(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "entityEvent":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._JournalEntry_entityEvent(ctx, field, obj)
 			}
+
+			out.Values[i] = innerFunc(ctx)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11901,19 +15391,19 @@ func (ec *executionContext) _CraftingInfo(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var enmityImplementors = []string{"Enmity"}
+var journalPageImplementors = []string{"JournalPage"}
 
-func (ec *executionContext) _Enmity(ctx context.Context, sel ast.SelectionSet, obj *Enmity) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, enmityImplementors)
+func (ec *executionContext) _JournalPage(ctx context.Context, sel ast.SelectionSet, obj *JournalPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, journalPageImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Enmity")
-		case "targetHateRanking":
+			out.Values[i] = graphql.MarshalString("JournalPage")
+		case "entries":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Enmity_targetHateRanking(ctx, field, obj)
+				return ec._JournalPage_entries(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11921,16 +15411,13 @@ func (ec *executionContext) _Enmity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "nearbyEnemyHate":
+		case "nextCursor":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Enmity_nearbyEnemyHate(ctx, field, obj)
+				return ec._JournalPage_nextCursor(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11942,19 +15429,19 @@ func (ec *executionContext) _Enmity(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var entityImplementors = []string{"Entity"}
+var locationImplementors = []string{"Location"}
 
-func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, obj *Entity) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, entityImplementors)
+func (ec *executionContext) _Location(ctx context.Context, sel ast.SelectionSet, obj *Location) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, locationImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Entity")
-		case "id":
+			out.Values[i] = graphql.MarshalString("Location")
+		case "x":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_id(ctx, field, obj)
+				return ec._Location_x(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11962,9 +15449,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "index":
+		case "y":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_index(ctx, field, obj)
+				return ec._Location_y(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11972,9 +15459,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
+		case "z":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_name(ctx, field, obj)
+				return ec._Location_z(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11982,9 +15469,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "targetID":
+		case "orientation":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_targetID(ctx, field, obj)
+				return ec._Location_orientation(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -11992,9 +15479,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "ownerID":
+		case "lastUpdated":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_ownerID(ctx, field, obj)
+				return ec._Location_lastUpdated(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12002,9 +15489,30 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "level":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mapInfoImplementors = []string{"MapInfo"}
+
+func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet, obj *MapInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mapInfoImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MapInfo")
+		case "key":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_level(ctx, field, obj)
+				return ec._MapInfo_key(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12012,9 +15520,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "classJob":
+		case "id":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_classJob(ctx, field, obj)
+				return ec._MapInfo_id(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12022,9 +15530,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isNPC":
+		case "SizeFactor":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_isNPC(ctx, field, obj)
+				return ec._MapInfo_SizeFactor(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12032,9 +15540,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isEnemy":
+		case "OffsetX":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_isEnemy(ctx, field, obj)
+				return ec._MapInfo_OffsetX(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12042,9 +15550,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isPet":
+		case "OffsetY":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_isPet(ctx, field, obj)
+				return ec._MapInfo_OffsetY(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12052,16 +15560,19 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "bNPCInfo":
+		case "PlaceName":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_bNPCInfo(ctx, field, obj)
+				return ec._MapInfo_PlaceName(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-		case "resources":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "PlaceNameSub":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_resources(ctx, field, obj)
+				return ec._MapInfo_PlaceNameSub(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12069,9 +15580,9 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "location":
+		case "TerritoryType":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_location(ctx, field, obj)
+				return ec._MapInfo_TerritoryType(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12079,46 +15590,62 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "lastAction":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_lastAction(ctx, field, obj)
-			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = innerFunc(ctx)
+var mutationImplementors = []string{"Mutation"}
 
-		case "statuses":
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "sendStreamRequest":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_statuses(ctx, field, obj)
+				return ec._Mutation_sendStreamRequest(ctx, field)
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, innerFunc)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "lockonMarker":
+		case "attachProcess":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_lockonMarker(ctx, field, obj)
+				return ec._Mutation_attachProcess(ctx, field)
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, innerFunc)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "castingInfo":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_castingInfo(ctx, field, obj)
-			}
-
-			out.Values[i] = innerFunc(ctx)
-
-		case "rawSpawnJSONData":
+		case "detachProcess":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Entity_rawSpawnJSONData(ctx, field, obj)
+				return ec._Mutation_detachProcess(ctx, field)
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, innerFunc)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -12134,19 +15661,19 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var entityEventImplementors = []string{"EntityEvent"}
+var nPCInfoImplementors = []string{"NPCInfo"}
 
-func (ec *executionContext) _EntityEvent(ctx context.Context, sel ast.SelectionSet, obj *EntityEvent) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, entityEventImplementors)
+func (ec *executionContext) _NPCInfo(ctx context.Context, sel ast.SelectionSet, obj *NPCInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, nPCInfoImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("EntityEvent")
-		case "streamID":
+			out.Values[i] = graphql.MarshalString("NPCInfo")
+		case "nameID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._EntityEvent_streamID(ctx, field, obj)
+				return ec._NPCInfo_nameID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12154,9 +15681,9 @@ func (ec *executionContext) _EntityEvent(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "entityID":
+		case "baseID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._EntityEvent_entityID(ctx, field, obj)
+				return ec._NPCInfo_baseID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12164,9 +15691,33 @@ func (ec *executionContext) _EntityEvent(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "type":
+		case "modelID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._EntityEvent_type(ctx, field, obj)
+				return ec._NPCInfo_modelID(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._NPCInfo_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "size":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._NPCInfo_size(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "error":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._NPCInfo_error(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12185,19 +15736,19 @@ func (ec *executionContext) _EntityEvent(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var hateEntryImplementors = []string{"HateEntry"}
+var opcodeMappingImplementors = []string{"OpcodeMapping"}
 
-func (ec *executionContext) _HateEntry(ctx context.Context, sel ast.SelectionSet, obj *HateEntry) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, hateEntryImplementors)
+func (ec *executionContext) _OpcodeMapping(ctx context.Context, sel ast.SelectionSet, obj *OpcodeMapping) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, opcodeMappingImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HateEntry")
-		case "enemyID":
+			out.Values[i] = graphql.MarshalString("OpcodeMapping")
+		case "direction":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._HateEntry_enemyID(ctx, field, obj)
+				return ec._OpcodeMapping_direction(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12205,9 +15756,9 @@ func (ec *executionContext) _HateEntry(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "hatePercent":
+		case "channel":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._HateEntry_hatePercent(ctx, field, obj)
+				return ec._OpcodeMapping_channel(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12215,30 +15766,19 @@ func (ec *executionContext) _HateEntry(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
+		case "opcode":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._OpcodeMapping_opcode(ctx, field, obj)
+			}
 
-var hateRankingImplementors = []string{"HateRanking"}
+			out.Values[i] = innerFunc(ctx)
 
-func (ec *executionContext) _HateRanking(ctx context.Context, sel ast.SelectionSet, obj *HateRanking) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, hateRankingImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("HateRanking")
-		case "actorID":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "datatype":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._HateRanking_actorID(ctx, field, obj)
+				return ec._OpcodeMapping_datatype(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12246,9 +15786,9 @@ func (ec *executionContext) _HateRanking(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "hate":
+		case "source":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._HateRanking_hate(ctx, field, obj)
+				return ec._OpcodeMapping_source(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12267,59 +15807,40 @@ func (ec *executionContext) _HateRanking(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var locationImplementors = []string{"Location"}
+var opcodeTableImplementors = []string{"OpcodeTable"}
 
-func (ec *executionContext) _Location(ctx context.Context, sel ast.SelectionSet, obj *Location) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, locationImplementors)
+func (ec *executionContext) _OpcodeTable(ctx context.Context, sel ast.SelectionSet, obj *OpcodeTable) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, opcodeTableImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Location")
-		case "x":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Location_x(ctx, field, obj)
-			}
-
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "y":
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OpcodeTable")
+		case "file":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Location_y(ctx, field, obj)
+				return ec._OpcodeTable_file(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "z":
+		case "loadedAt":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Location_z(ctx, field, obj)
+				return ec._OpcodeTable_loadedAt(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "orientation":
+		case "lastError":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Location_orientation(ctx, field, obj)
+				return ec._OpcodeTable_lastError(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "lastUpdated":
+		case "mappings":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Location_lastUpdated(ctx, field, obj)
+				return ec._OpcodeTable_mappings(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12338,19 +15859,19 @@ func (ec *executionContext) _Location(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var mapInfoImplementors = []string{"MapInfo"}
+var placeImplementors = []string{"Place"}
 
-func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet, obj *MapInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mapInfoImplementors)
+func (ec *executionContext) _Place(ctx context.Context, sel ast.SelectionSet, obj *Place) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, placeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MapInfo")
-		case "key":
+			out.Values[i] = graphql.MarshalString("Place")
+		case "mapID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_key(ctx, field, obj)
+				return ec._Place_mapID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12358,9 +15879,9 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "id":
+		case "territoryID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_id(ctx, field, obj)
+				return ec._Place_territoryID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12368,9 +15889,9 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "SizeFactor":
+		case "maps":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_SizeFactor(ctx, field, obj)
+				return ec._Place_maps(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12378,19 +15899,30 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "OffsetX":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_OffsetX(ctx, field, obj)
-			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = innerFunc(ctx)
+var processCandidateImplementors = []string{"ProcessCandidate"}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "OffsetY":
+func (ec *executionContext) _ProcessCandidate(ctx context.Context, sel ast.SelectionSet, obj *ProcessCandidate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processCandidateImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessCandidate")
+		case "pid":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_OffsetY(ctx, field, obj)
+				return ec._ProcessCandidate_pid(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12398,9 +15930,9 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "PlaceName":
+		case "adapter":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_PlaceName(ctx, field, obj)
+				return ec._ProcessCandidate_adapter(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12408,9 +15940,9 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "PlaceNameSub":
+		case "rule":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_PlaceNameSub(ctx, field, obj)
+				return ec._ProcessCandidate_rule(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -12418,16 +15950,13 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "TerritoryType":
+		case "streamID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._MapInfo_TerritoryType(ctx, field, obj)
+				return ec._ProcessCandidate_streamID(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12439,12 +15968,12 @@ func (ec *executionContext) _MapInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
 	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
+		Object: "Query",
 	})
 
 	out := graphql.NewFieldSet(fields)
@@ -12457,174 +15986,169 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "sendStreamRequest":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "apiVersion":
+			field := field
+
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_sendStreamRequest(ctx, field)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_apiVersion(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, innerFunc)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var nPCInfoImplementors = []string{"NPCInfo"}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "streams":
+			field := field
 
-func (ec *executionContext) _NPCInfo(ctx context.Context, sel ast.SelectionSet, obj *NPCInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, nPCInfoImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("NPCInfo")
-		case "nameID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._NPCInfo_nameID(ctx, field, obj)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_streams(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "baseID":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._NPCInfo_baseID(ctx, field, obj)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "stream":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "modelID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._NPCInfo_modelID(ctx, field, obj)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_stream(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._NPCInfo_name(ctx, field, obj)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "entity":
+			field := field
 
-		case "size":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._NPCInfo_size(ctx, field, obj)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_entity(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "adapters":
+			field := field
 
-		case "error":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._NPCInfo_error(ctx, field, obj)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_adapters(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var placeImplementors = []string{"Place"}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "streamCommands":
+			field := field
 
-func (ec *executionContext) _Place(ctx context.Context, sel ast.SelectionSet, obj *Place) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, placeImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Place")
-		case "mapID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Place_mapID(ctx, field, obj)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_streamCommands(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "territoryID":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Place_territoryID(ctx, field, obj)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
-			out.Values[i] = innerFunc(ctx)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "opcodeTable":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "maps":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Place_maps(ctx, field, obj)
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_opcodeTable(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
 
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "apiVersion":
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "throttleStats":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12633,7 +16157,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_apiVersion(ctx, field)
+				res = ec._Query_throttleStats(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -12647,7 +16171,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "streams":
+		case "hookMessages":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12656,7 +16180,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_streams(ctx, field)
+				res = ec._Query_hookMessages(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -12670,7 +16194,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "stream":
+		case "candidateProcesses":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12679,7 +16203,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_stream(ctx, field)
+				res = ec._Query_candidateProcesses(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -12693,7 +16217,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
-		case "entity":
+		case "events":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -12702,7 +16226,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_entity(ctx, field)
+				res = ec._Query_events(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -12869,6 +16393,26 @@ func (ec *executionContext) _RemoveEntity(ctx context.Context, sel ast.Selection
 
 			out.Values[i] = innerFunc(ctx)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reason":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._RemoveEntity_reason(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._RemoveEntity_time(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -13583,6 +17127,30 @@ func (ec *executionContext) _Stream(ctx context.Context, sel ast.SelectionSet, o
 
 			out.Values[i] = innerFunc(ctx)
 
+		case "source":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Stream_source(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "status":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Stream_status(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "restored":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Stream_restored(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "entities":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Stream_entities(ctx, field, obj)
@@ -13590,6 +17158,67 @@ func (ec *executionContext) _Stream(ctx context.Context, sel ast.SelectionSet, o
 
 			out.Values[i] = innerFunc(ctx)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "departed":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Stream_departed(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var streamCommandImplementors = []string{"StreamCommand"}
+
+func (ec *executionContext) _StreamCommand(ctx context.Context, sel ast.SelectionSet, obj *StreamCommand) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, streamCommandImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StreamCommand")
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._StreamCommand_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._StreamCommand_description(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "schema":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._StreamCommand_schema(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -13616,7 +17245,121 @@ func (ec *executionContext) _StreamEvent(ctx context.Context, sel ast.SelectionS
 			out.Values[i] = graphql.MarshalString("StreamEvent")
 		case "streamID":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._StreamEvent_streamID(ctx, field, obj)
+				return ec._StreamEvent_streamID(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._StreamEvent_type(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var streamSourceImplementors = []string{"StreamSource"}
+
+func (ec *executionContext) _StreamSource(ctx context.Context, sel ast.SelectionSet, obj *StreamSource) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, streamSourceImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StreamSource")
+		case "adapter":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._StreamSource_adapter(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "key":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._StreamSource_key(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "streamEvent":
+		return ec._Subscription_streamEvent(ctx, fields[0])
+	case "entityEvent":
+		return ec._Subscription_entityEvent(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var throttleStatImplementors = []string{"ThrottleStat"}
+
+func (ec *executionContext) _ThrottleStat(ctx context.Context, sel ast.SelectionSet, obj *ThrottleStat) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, throttleStatImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ThrottleStat")
+		case "datatype":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ThrottleStat_datatype(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "policy":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._ThrottleStat_policy(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -13624,9 +17367,9 @@ func (ec *executionContext) _StreamEvent(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "type":
+		case "dropped":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._StreamEvent_type(ctx, field, obj)
+				return ec._ThrottleStat_dropped(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -13645,28 +17388,6 @@ func (ec *executionContext) _StreamEvent(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var subscriptionImplementors = []string{"Subscription"}
-
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
-	}
-
-	switch fields[0].Name {
-	case "streamEvent":
-		return ec._Subscription_streamEvent(ctx, fields[0])
-	case "entityEvent":
-		return ec._Subscription_entityEvent(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
-	}
-}
-
 var updateCastingInfoImplementors = []string{"UpdateCastingInfo", "EntityEventType"}
 
 func (ec *executionContext) _UpdateCastingInfo(ctx context.Context, sel ast.SelectionSet, obj *UpdateCastingInfo) graphql.Marshaler {
@@ -14052,6 +17773,37 @@ func (ec *executionContext) _UpdateStats(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var updateStreamStatusImplementors = []string{"UpdateStreamStatus", "StreamEventType"}
+
+func (ec *executionContext) _UpdateStreamStatus(ctx context.Context, sel ast.SelectionSet, obj *UpdateStreamStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateStreamStatusImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdateStreamStatus")
+		case "status":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._UpdateStreamStatus_status(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var updateTargetImplementors = []string{"UpdateTarget", "EntityEventType"}
 
 func (ec *executionContext) _UpdateTarget(ctx context.Context, sel ast.SelectionSet, obj *UpdateTarget) graphql.Marshaler {
@@ -14083,19 +17835,224 @@ func (ec *executionContext) _UpdateTarget(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var upsertStatusImplementors = []string{"UpsertStatus", "EntityEventType"}
+var upsertStatusImplementors = []string{"UpsertStatus", "EntityEventType"}
+
+func (ec *executionContext) _UpsertStatus(ctx context.Context, sel ast.SelectionSet, obj *UpsertStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, upsertStatusImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpsertStatus")
+		case "index":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._UpsertStatus_index(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._UpsertStatus_status(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var worldImplementors = []string{"World"}
+
+func (ec *executionContext) _World(ctx context.Context, sel ast.SelectionSet, obj *World) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, worldImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("World")
+		case "id":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._World_id(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._World_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Directive_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Directive_description(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "locations":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Directive_locations(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Directive_args(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isRepeatable":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Directive_isRepeatable(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___EnumValue_name(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___EnumValue_description(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "isDeprecated":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___EnumValue_isDeprecated(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deprecationReason":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___EnumValue_deprecationReason(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _UpsertStatus(ctx context.Context, sel ast.SelectionSet, obj *UpsertStatus) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, upsertStatusImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("UpsertStatus")
-		case "index":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._UpsertStatus_index(ctx, field, obj)
+				return ec.___Field_name(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14103,40 +18060,26 @@ func (ec *executionContext) _UpsertStatus(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "status":
+		case "description":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._UpsertStatus_status(ctx, field, obj)
+				return ec.___Field_description(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "args":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Field_args(ctx, field, obj)
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var worldImplementors = []string{"World"}
+			out.Values[i] = innerFunc(ctx)
 
-func (ec *executionContext) _World(ctx context.Context, sel ast.SelectionSet, obj *World) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, worldImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("World")
-		case "id":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._World_id(ctx, field, obj)
+				return ec.___Field_type(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14144,9 +18087,9 @@ func (ec *executionContext) _World(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
+		case "isDeprecated":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._World_name(ctx, field, obj)
+				return ec.___Field_isDeprecated(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14154,6 +18097,13 @@ func (ec *executionContext) _World(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "deprecationReason":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Field_deprecationReason(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14165,19 +18115,19 @@ func (ec *executionContext) _World(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
+			out.Values[i] = graphql.MarshalString("__InputValue")
 		case "name":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Directive_name(ctx, field, obj)
+				return ec.___InputValue_name(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14187,24 +18137,14 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 			}
 		case "description":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Directive_description(ctx, field, obj)
-			}
-
-			out.Values[i] = innerFunc(ctx)
-
-		case "locations":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Directive_locations(ctx, field, obj)
+				return ec.___InputValue_description(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
+		case "type":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Directive_args(ctx, field, obj)
+				return ec.___InputValue_type(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14212,16 +18152,13 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isRepeatable":
+		case "defaultValue":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Directive_isRepeatable(ctx, field, obj)
+				return ec.___InputValue_defaultValue(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14233,19 +18170,19 @@ func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___EnumValue_name(ctx, field, obj)
+				return ec.___Schema_types(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14253,30 +18190,40 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "description":
+		case "queryType":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___EnumValue_description(ctx, field, obj)
+				return ec.___Schema_queryType(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-		case "isDeprecated":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "mutationType":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___EnumValue_isDeprecated(ctx, field, obj)
+				return ec.___Schema_mutationType(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "subscriptionType":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Schema_subscriptionType(ctx, field, obj)
 			}
-		case "deprecationReason":
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "directives":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___EnumValue_deprecationReason(ctx, field, obj)
+				return ec.___Schema_directives(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14288,19 +18235,19 @@ func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var __FieldImplementors = []string{"__Field"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Field_name(ctx, field, obj)
+				return ec.___Type_kind(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
@@ -14308,287 +18255,498 @@ func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "description":
+		case "name":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Field_description(ctx, field, obj)
+				return ec.___Type_name(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-		case "args":
+		case "description":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Field_args(ctx, field, obj)
+				return ec.___Type_description(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "fields":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Type_fields(ctx, field, obj)
 			}
-		case "type":
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "interfaces":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Field_type(ctx, field, obj)
+				return ec.___Type_interfaces(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "possibleTypes":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Type_possibleTypes(ctx, field, obj)
 			}
-		case "isDeprecated":
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "enumValues":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Field_isDeprecated(ctx, field, obj)
+				return ec.___Type_enumValues(ctx, field, obj)
 			}
 
 			out.Values[i] = innerFunc(ctx)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "inputFields":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				return ec.___Type_inputFields(ctx, field, obj)
 			}
-		case "deprecationReason":
+
+			out.Values[i] = innerFunc(ctx)
+
+		case "ofType":
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Field_deprecationReason(ctx, field, obj)
+				return ec.___Type_ofType(ctx, field, obj)
+			}
+
+			out.Values[i] = innerFunc(ctx)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAction2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAction(ctx context.Context, sel ast.SelectionSet, v *Action) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Action(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNActionEffect2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐActionEffect(ctx context.Context, sel ast.SelectionSet, v ActionEffect) graphql.Marshaler {
+	return ec._ActionEffect(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNActionEffect2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐActionEffectᚄ(ctx context.Context, sel ast.SelectionSet, v []ActionEffect) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNActionEffect2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐActionEffect(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = innerFunc(ctx)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___InputValue_name(ctx, field, obj)
-			}
+	return ret
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) marshalNAdapter2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAdapter(ctx context.Context, sel ast.SelectionSet, v Adapter) graphql.Marshaler {
+	return ec._Adapter(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___InputValue_description(ctx, field, obj)
+func (ec *executionContext) marshalNAdapter2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAdapterᚄ(ctx context.Context, sel ast.SelectionSet, v []Adapter) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAdapter2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAdapter(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = innerFunc(ctx)
-
-		case "type":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___InputValue_type(ctx, field, obj)
-			}
+	}
+	wg.Wait()
 
-			out.Values[i] = innerFunc(ctx)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "defaultValue":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___InputValue_defaultValue(ctx, field, obj)
-			}
+	return ret
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return res
+}
+
+func (ec *executionContext) marshalNClassJob2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐClassJob(ctx context.Context, sel ast.SelectionSet, v *ClassJob) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._ClassJob(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNDepartedEntity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDepartedEntity(ctx context.Context, sel ast.SelectionSet, v DepartedEntity) graphql.Marshaler {
+	return ec._DepartedEntity(ctx, sel, &v)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Schema_types(ctx, field, obj)
+func (ec *executionContext) marshalNDepartedEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDepartedEntityᚄ(ctx context.Context, sel ast.SelectionSet, v []DepartedEntity) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNDepartedEntity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDepartedEntity(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = innerFunc(ctx)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Schema_queryType(ctx, field, obj)
-			}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = innerFunc(ctx)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Schema_mutationType(ctx, field, obj)
-			}
+func (ec *executionContext) unmarshalNDespawnReason2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDespawnReason(ctx context.Context, v interface{}) (DespawnReason, error) {
+	var res DespawnReason
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) marshalNDespawnReason2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐDespawnReason(ctx context.Context, sel ast.SelectionSet, v DespawnReason) graphql.Marshaler {
+	return v
+}
 
-		case "subscriptionType":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Schema_subscriptionType(ctx, field, obj)
-			}
+func (ec *executionContext) marshalNEnmity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx context.Context, sel ast.SelectionSet, v Enmity) graphql.Marshaler {
+	return ec._Enmity(ctx, sel, &v)
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) marshalNEnmity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx context.Context, sel ast.SelectionSet, v *Enmity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Enmity(ctx, sel, v)
+}
 
-		case "directives":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Schema_directives(ctx, field, obj)
+func (ec *executionContext) marshalNEntity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx context.Context, sel ast.SelectionSet, v Entity) graphql.Marshaler {
+	return ec._Entity(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityᚄ(ctx context.Context, sel ast.SelectionSet, v []Entity) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNEntity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = innerFunc(ctx)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx context.Context, sel ast.SelectionSet, v *Entity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._Entity(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_kind(ctx, field, obj)
-			}
-
-			out.Values[i] = innerFunc(ctx)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_name(ctx, field, obj)
-			}
-
-			out.Values[i] = innerFunc(ctx)
-
-		case "description":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_description(ctx, field, obj)
-			}
+func (ec *executionContext) marshalNEntityEvent2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx context.Context, sel ast.SelectionSet, v EntityEvent) graphql.Marshaler {
+	return ec._EntityEvent(ctx, sel, &v)
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) marshalNEntityEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx context.Context, sel ast.SelectionSet, v *EntityEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._EntityEvent(ctx, sel, v)
+}
 
-		case "fields":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_fields(ctx, field, obj)
-			}
+func (ec *executionContext) marshalNEntityEventType2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEventType(ctx context.Context, sel ast.SelectionSet, v EntityEventType) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._EntityEventType(ctx, sel, v)
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "interfaces":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_interfaces(ctx, field, obj)
-			}
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) marshalNHateEntry2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateEntry(ctx context.Context, sel ast.SelectionSet, v HateEntry) graphql.Marshaler {
+	return ec._HateEntry(ctx, sel, &v)
+}
 
-		case "possibleTypes":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_possibleTypes(ctx, field, obj)
+func (ec *executionContext) marshalNHateEntry2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []HateEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNHateEntry2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = innerFunc(ctx)
-
-		case "enumValues":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_enumValues(ctx, field, obj)
-			}
+	}
+	wg.Wait()
 
-			out.Values[i] = innerFunc(ctx)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-		case "inputFields":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_inputFields(ctx, field, obj)
-			}
+	return ret
+}
 
-			out.Values[i] = innerFunc(ctx)
+func (ec *executionContext) marshalNHateRanking2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateRanking(ctx context.Context, sel ast.SelectionSet, v HateRanking) graphql.Marshaler {
+	return ec._HateRanking(ctx, sel, &v)
+}
 
-		case "ofType":
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				return ec.___Type_ofType(ctx, field, obj)
+func (ec *executionContext) marshalNHateRanking2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateRankingᚄ(ctx context.Context, sel ast.SelectionSet, v []HateRanking) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNHateRanking2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateRanking(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = innerFunc(ctx)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-// endregion **************************** object.gotpl ****************************
 
-// region    ***************************** type.gotpl *****************************
+	return ret
+}
 
-func (ec *executionContext) marshalNAction2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐAction(ctx context.Context, sel ast.SelectionSet, v *Action) graphql.Marshaler {
+func (ec *executionContext) marshalNHealth2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealth(ctx context.Context, sel ast.SelectionSet, v *Health) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Action(ctx, sel, v)
+	return ec._Health(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNActionEffect2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐActionEffect(ctx context.Context, sel ast.SelectionSet, v ActionEffect) graphql.Marshaler {
-	return ec._ActionEffect(ctx, sel, &v)
+func (ec *executionContext) unmarshalNHealthState2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealthState(ctx context.Context, v interface{}) (HealthState, error) {
+	var res HealthState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNActionEffect2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐActionEffectᚄ(ctx context.Context, sel ast.SelectionSet, v []ActionEffect) graphql.Marshaler {
+func (ec *executionContext) marshalNHealthState2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealthState(ctx context.Context, sel ast.SelectionSet, v HealthState) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNHookMessage2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessage(ctx context.Context, sel ast.SelectionSet, v HookMessage) graphql.Marshaler {
+	return ec._HookMessage(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNHookMessage2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessageᚄ(ctx context.Context, sel ast.SelectionSet, v []HookMessage) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14612,7 +18770,7 @@ func (ec *executionContext) marshalNActionEffect2ᚕgithubᚗcomᚋff14wedᚋaet
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNActionEffect2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐActionEffect(ctx, sel, v[i])
+			ret[i] = ec.marshalNHookMessage2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessage(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14632,50 +18790,51 @@ func (ec *executionContext) marshalNActionEffect2ᚕgithubᚗcomᚋff14wedᚋaet
 	return ret
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNHookMessageSeverity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessageSeverity(ctx context.Context, v interface{}) (HookMessageSeverity, error) {
+	var res HookMessageSeverity
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNHookMessageSeverity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHookMessageSeverity(ctx context.Context, sel ast.SelectionSet, v HookMessageSeverity) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNClassJob2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐClassJob(ctx context.Context, sel ast.SelectionSet, v *ClassJob) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
 	}
-	return ec._ClassJob(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) marshalNEnmity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx context.Context, sel ast.SelectionSet, v Enmity) graphql.Marshaler {
-	return ec._Enmity(ctx, sel, &v)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNEnmity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEnmity(ctx context.Context, sel ast.SelectionSet, v *Enmity) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
 	}
-	return ec._Enmity(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) marshalNEntity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx context.Context, sel ast.SelectionSet, v Entity) graphql.Marshaler {
-	return ec._Entity(ctx, sel, &v)
+func (ec *executionContext) marshalNJournalEntry2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalEntry(ctx context.Context, sel ast.SelectionSet, v JournalEntry) graphql.Marshaler {
+	return ec._JournalEntry(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityᚄ(ctx context.Context, sel ast.SelectionSet, v []Entity) graphql.Marshaler {
+func (ec *executionContext) marshalNJournalEntry2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []JournalEntry) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14699,7 +18858,7 @@ func (ec *executionContext) marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherome
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNEntity2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx, sel, v[i])
+			ret[i] = ec.marshalNJournalEntry2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalEntry(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14719,60 +18878,35 @@ func (ec *executionContext) marshalNEntity2ᚕgithubᚗcomᚋff14wedᚋaetherome
 	return ret
 }
 
-func (ec *executionContext) marshalNEntity2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntity(ctx context.Context, sel ast.SelectionSet, v *Entity) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Entity(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNEntityEvent2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx context.Context, sel ast.SelectionSet, v EntityEvent) graphql.Marshaler {
-	return ec._EntityEvent(ctx, sel, &v)
+func (ec *executionContext) marshalNJournalPage2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalPage(ctx context.Context, sel ast.SelectionSet, v JournalPage) graphql.Marshaler {
+	return ec._JournalPage(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNEntityEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx context.Context, sel ast.SelectionSet, v *EntityEvent) graphql.Marshaler {
+func (ec *executionContext) marshalNJournalPage2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐJournalPage(ctx context.Context, sel ast.SelectionSet, v *JournalPage) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._EntityEvent(ctx, sel, v)
+	return ec._JournalPage(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNEntityEventType2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEventType(ctx context.Context, sel ast.SelectionSet, v EntityEventType) graphql.Marshaler {
+func (ec *executionContext) marshalNLocation2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐLocation(ctx context.Context, sel ast.SelectionSet, v *Location) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._EntityEventType(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return graphql.WrapContextMarshaler(ctx, res)
+	return ec._Location(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNHateEntry2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateEntry(ctx context.Context, sel ast.SelectionSet, v HateEntry) graphql.Marshaler {
-	return ec._HateEntry(ctx, sel, &v)
+func (ec *executionContext) marshalNMapInfo2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfo(ctx context.Context, sel ast.SelectionSet, v MapInfo) graphql.Marshaler {
+	return ec._MapInfo(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNHateEntry2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []HateEntry) graphql.Marshaler {
+func (ec *executionContext) marshalNMapInfo2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfoᚄ(ctx context.Context, sel ast.SelectionSet, v []MapInfo) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14796,7 +18930,7 @@ func (ec *executionContext) marshalNHateEntry2ᚕgithubᚗcomᚋff14wedᚋaether
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNHateEntry2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateEntry(ctx, sel, v[i])
+			ret[i] = ec.marshalNMapInfo2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfo(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14816,11 +18950,31 @@ func (ec *executionContext) marshalNHateEntry2ᚕgithubᚗcomᚋff14wedᚋaether
 	return ret
 }
 
-func (ec *executionContext) marshalNHateRanking2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateRanking(ctx context.Context, sel ast.SelectionSet, v HateRanking) graphql.Marshaler {
-	return ec._HateRanking(ctx, sel, &v)
+func (ec *executionContext) unmarshalNOpcodeChannel2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeChannel(ctx context.Context, v interface{}) (OpcodeChannel, error) {
+	var res OpcodeChannel
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNHateRanking2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateRankingᚄ(ctx context.Context, sel ast.SelectionSet, v []HateRanking) graphql.Marshaler {
+func (ec *executionContext) marshalNOpcodeChannel2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeChannel(ctx context.Context, sel ast.SelectionSet, v OpcodeChannel) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNOpcodeDirection2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeDirection(ctx context.Context, v interface{}) (OpcodeDirection, error) {
+	var res OpcodeDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNOpcodeDirection2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeDirection(ctx context.Context, sel ast.SelectionSet, v OpcodeDirection) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNOpcodeMapping2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeMapping(ctx context.Context, sel ast.SelectionSet, v OpcodeMapping) graphql.Marshaler {
+	return ec._OpcodeMapping(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOpcodeMapping2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeMappingᚄ(ctx context.Context, sel ast.SelectionSet, v []OpcodeMapping) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14844,7 +18998,7 @@ func (ec *executionContext) marshalNHateRanking2ᚕgithubᚗcomᚋff14wedᚋaeth
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNHateRanking2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHateRanking(ctx, sel, v[i])
+			ret[i] = ec.marshalNOpcodeMapping2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeMapping(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14864,51 +19018,49 @@ func (ec *executionContext) marshalNHateRanking2ᚕgithubᚗcomᚋff14wedᚋaeth
 	return ret
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalNOpcodeSource2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeSource(ctx context.Context, v interface{}) (OpcodeSource, error) {
+	var res OpcodeSource
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNOpcodeSource2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeSource(ctx context.Context, sel ast.SelectionSet, v OpcodeSource) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNOpcodeTable2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeTable(ctx context.Context, sel ast.SelectionSet, v OpcodeTable) graphql.Marshaler {
+	return ec._OpcodeTable(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNOpcodeTable2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐOpcodeTable(ctx context.Context, sel ast.SelectionSet, v *OpcodeTable) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._OpcodeTable(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNLocation2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐLocation(ctx context.Context, sel ast.SelectionSet, v *Location) graphql.Marshaler {
+func (ec *executionContext) marshalNPlace2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx context.Context, sel ast.SelectionSet, v Place) graphql.Marshaler {
+	return ec._Place(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPlace2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx context.Context, sel ast.SelectionSet, v *Place) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Location(ctx, sel, v)
+	return ec._Place(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMapInfo2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfo(ctx context.Context, sel ast.SelectionSet, v MapInfo) graphql.Marshaler {
-	return ec._MapInfo(ctx, sel, &v)
+func (ec *executionContext) marshalNProcessCandidate2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐProcessCandidate(ctx context.Context, sel ast.SelectionSet, v ProcessCandidate) graphql.Marshaler {
+	return ec._ProcessCandidate(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNMapInfo2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfoᚄ(ctx context.Context, sel ast.SelectionSet, v []MapInfo) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessCandidate2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐProcessCandidateᚄ(ctx context.Context, sel ast.SelectionSet, v []ProcessCandidate) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14932,7 +19084,7 @@ func (ec *executionContext) marshalNMapInfo2ᚕgithubᚗcomᚋff14wedᚋaetherom
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMapInfo2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐMapInfo(ctx, sel, v[i])
+			ret[i] = ec.marshalNProcessCandidate2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐProcessCandidate(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14952,20 +19104,6 @@ func (ec *executionContext) marshalNMapInfo2ᚕgithubᚗcomᚋff14wedᚋaetherom
 	return ret
 }
 
-func (ec *executionContext) marshalNPlace2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx context.Context, sel ast.SelectionSet, v Place) graphql.Marshaler {
-	return ec._Place(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNPlace2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐPlace(ctx context.Context, sel ast.SelectionSet, v *Place) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Place(ctx, sel, v)
-}
-
 func (ec *executionContext) marshalNRecipeInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐRecipeInfo(ctx context.Context, sel ast.SelectionSet, v *RecipeInfo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -15102,6 +19240,54 @@ func (ec *executionContext) marshalNStream2ᚖgithubᚗcomᚋff14wedᚋaetherome
 	return ec._Stream(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNStreamCommand2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamCommand(ctx context.Context, sel ast.SelectionSet, v StreamCommand) graphql.Marshaler {
+	return ec._StreamCommand(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNStreamCommand2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamCommandᚄ(ctx context.Context, sel ast.SelectionSet, v []StreamCommand) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStreamCommand2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamCommand(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNStreamEvent2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEvent(ctx context.Context, sel ast.SelectionSet, v StreamEvent) graphql.Marshaler {
 	return ec._StreamEvent(ctx, sel, &v)
 }
@@ -15146,6 +19332,64 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNThrottlePolicy2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottlePolicy(ctx context.Context, v interface{}) (ThrottlePolicy, error) {
+	var res ThrottlePolicy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNThrottlePolicy2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottlePolicy(ctx context.Context, sel ast.SelectionSet, v ThrottlePolicy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNThrottleStat2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottleStat(ctx context.Context, sel ast.SelectionSet, v ThrottleStat) graphql.Marshaler {
+	return ec._ThrottleStat(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNThrottleStat2ᚕgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottleStatᚄ(ctx context.Context, sel ast.SelectionSet, v []ThrottleStat) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNThrottleStat2githubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐThrottleStat(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNTimestamp2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
 	res, err := UnmarshalTimestamp(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15498,6 +19742,13 @@ func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v in
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOEntityEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐEntityEvent(ctx context.Context, sel ast.SelectionSet, v *EntityEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._EntityEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -15506,6 +19757,29 @@ func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel as
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) marshalOHealth2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐHealth(ctx context.Context, sel ast.SelectionSet, v *Health) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Health(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
 func (ec *executionContext) marshalONPCInfo2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐNPCInfo(ctx context.Context, sel ast.SelectionSet, v *NPCInfo) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -15527,6 +19801,20 @@ func (ec *executionContext) marshalOStatus2ᚖgithubᚗcomᚋff14wedᚋaetherome
 	return ec._Status(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOStreamEvent2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamEvent(ctx context.Context, sel ast.SelectionSet, v *StreamEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StreamEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOStreamSource2ᚖgithubᚗcomᚋff14wedᚋaetherometerᚋcoreᚋmodelsᚐStreamSource(ctx context.Context, sel ast.SelectionSet, v *StreamSource) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StreamSource(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15537,6 +19825,26 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -15553,6 +19861,30 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalOTimestamp2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := UnmarshalTimestamp(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTimestamp2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := MarshalTimestamp(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOUint2ᚖuint64(ctx context.Context, v interface{}) (*uint64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := UnmarshalUint(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
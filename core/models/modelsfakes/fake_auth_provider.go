@@ -9,6 +9,17 @@ import (
 )
 
 type FakeAuthProvider struct {
+	AuthorizeAdminStub        func(context.Context) error
+	authorizeAdminMutex       sync.RWMutex
+	authorizeAdminArgsForCall []struct {
+		arg1 context.Context
+	}
+	authorizeAdminReturns struct {
+		result1 error
+	}
+	authorizeAdminReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AuthorizePluginTokenStub        func(context.Context) error
 	authorizePluginTokenMutex       sync.RWMutex
 	authorizePluginTokenArgsForCall []struct {
@@ -24,21 +35,83 @@ type FakeAuthProvider struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAuthProvider) AuthorizeAdmin(arg1 context.Context) error {
+	fake.authorizeAdminMutex.Lock()
+	ret, specificReturn := fake.authorizeAdminReturnsOnCall[len(fake.authorizeAdminArgsForCall)]
+	fake.authorizeAdminArgsForCall = append(fake.authorizeAdminArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AuthorizeAdminStub
+	fakeReturns := fake.authorizeAdminReturns
+	fake.recordInvocation("AuthorizeAdmin", []interface{}{arg1})
+	fake.authorizeAdminMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuthProvider) AuthorizeAdminCallCount() int {
+	fake.authorizeAdminMutex.RLock()
+	defer fake.authorizeAdminMutex.RUnlock()
+	return len(fake.authorizeAdminArgsForCall)
+}
+
+func (fake *FakeAuthProvider) AuthorizeAdminCalls(stub func(context.Context) error) {
+	fake.authorizeAdminMutex.Lock()
+	defer fake.authorizeAdminMutex.Unlock()
+	fake.AuthorizeAdminStub = stub
+}
+
+func (fake *FakeAuthProvider) AuthorizeAdminArgsForCall(i int) context.Context {
+	fake.authorizeAdminMutex.RLock()
+	defer fake.authorizeAdminMutex.RUnlock()
+	argsForCall := fake.authorizeAdminArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuthProvider) AuthorizeAdminReturns(result1 error) {
+	fake.authorizeAdminMutex.Lock()
+	defer fake.authorizeAdminMutex.Unlock()
+	fake.AuthorizeAdminStub = nil
+	fake.authorizeAdminReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthProvider) AuthorizeAdminReturnsOnCall(i int, result1 error) {
+	fake.authorizeAdminMutex.Lock()
+	defer fake.authorizeAdminMutex.Unlock()
+	fake.AuthorizeAdminStub = nil
+	if fake.authorizeAdminReturnsOnCall == nil {
+		fake.authorizeAdminReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authorizeAdminReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAuthProvider) AuthorizePluginToken(arg1 context.Context) error {
 	fake.authorizePluginTokenMutex.Lock()
 	ret, specificReturn := fake.authorizePluginTokenReturnsOnCall[len(fake.authorizePluginTokenArgsForCall)]
 	fake.authorizePluginTokenArgsForCall = append(fake.authorizePluginTokenArgsForCall, struct {
 		arg1 context.Context
 	}{arg1})
+	stub := fake.AuthorizePluginTokenStub
+	fakeReturns := fake.authorizePluginTokenReturns
 	fake.recordInvocation("AuthorizePluginToken", []interface{}{arg1})
 	fake.authorizePluginTokenMutex.Unlock()
-	if fake.AuthorizePluginTokenStub != nil {
-		return fake.AuthorizePluginTokenStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.authorizePluginTokenReturns
 	return fakeReturns.result1
 }
 
@@ -87,6 +160,8 @@ func (fake *FakeAuthProvider) AuthorizePluginTokenReturnsOnCall(i int, result1 e
 func (fake *FakeAuthProvider) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.authorizeAdminMutex.RLock()
+	defer fake.authorizeAdminMutex.RUnlock()
 	fake.authorizePluginTokenMutex.RLock()
 	defer fake.authorizePluginTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,17 +2,20 @@
 package modelsfakes
 
 import (
+	"context"
 	"sync"
+	"time"
 
 	"github.com/ff14wed/aetherometer/core/models"
 )
 
 type FakeStoreProvider struct {
-	EntityStub        func(int, uint64) (*models.Entity, error)
+	EntityStub        func(context.Context, int, uint64) (*models.Entity, error)
 	entityMutex       sync.RWMutex
 	entityArgsForCall []struct {
-		arg1 int
-		arg2 uint64
+		arg1 context.Context
+		arg2 int
+		arg3 uint64
 	}
 	entityReturns struct {
 		result1 *models.Entity
@@ -22,6 +25,22 @@ type FakeStoreProvider struct {
 		result1 *models.Entity
 		result2 error
 	}
+	EntityAtStub        func(context.Context, int, uint64, time.Time) (*models.Entity, error)
+	entityAtMutex       sync.RWMutex
+	entityAtArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 uint64
+		arg4 time.Time
+	}
+	entityAtReturns struct {
+		result1 *models.Entity
+		result2 error
+	}
+	entityAtReturnsOnCall map[int]struct {
+		result1 *models.Entity
+		result2 error
+	}
 	EntityEventSourceStub        func() models.EntityEventSource
 	entityEventSourceMutex       sync.RWMutex
 	entityEventSourceArgsForCall []struct {
@@ -32,10 +51,11 @@ type FakeStoreProvider struct {
 	entityEventSourceReturnsOnCall map[int]struct {
 		result1 models.EntityEventSource
 	}
-	StreamStub        func(int) (*models.Stream, error)
+	StreamStub        func(context.Context, int) (*models.Stream, error)
 	streamMutex       sync.RWMutex
 	streamArgsForCall []struct {
-		arg1 int
+		arg1 context.Context
+		arg2 int
 	}
 	streamReturns struct {
 		result1 *models.Stream
@@ -45,6 +65,21 @@ type FakeStoreProvider struct {
 		result1 *models.Stream
 		result2 error
 	}
+	StreamAtStub        func(context.Context, int, time.Time) (*models.Stream, error)
+	streamAtMutex       sync.RWMutex
+	streamAtArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	streamAtReturns struct {
+		result1 *models.Stream
+		result2 error
+	}
+	streamAtReturnsOnCall map[int]struct {
+		result1 *models.Stream
+		result2 error
+	}
 	StreamEventSourceStub        func() models.StreamEventSource
 	streamEventSourceMutex       sync.RWMutex
 	streamEventSourceArgsForCall []struct {
@@ -55,9 +90,10 @@ type FakeStoreProvider struct {
 	streamEventSourceReturnsOnCall map[int]struct {
 		result1 models.StreamEventSource
 	}
-	StreamsStub        func() ([]models.Stream, error)
+	StreamsStub        func(context.Context) ([]models.Stream, error)
 	streamsMutex       sync.RWMutex
 	streamsArgsForCall []struct {
+		arg1 context.Context
 	}
 	streamsReturns struct {
 		result1 []models.Stream
@@ -71,17 +107,18 @@ type FakeStoreProvider struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeStoreProvider) Entity(arg1 int, arg2 uint64) (*models.Entity, error) {
+func (fake *FakeStoreProvider) Entity(arg1 context.Context, arg2 int, arg3 uint64) (*models.Entity, error) {
 	fake.entityMutex.Lock()
 	ret, specificReturn := fake.entityReturnsOnCall[len(fake.entityArgsForCall)]
 	fake.entityArgsForCall = append(fake.entityArgsForCall, struct {
-		arg1 int
-		arg2 uint64
-	}{arg1, arg2})
-	fake.recordInvocation("Entity", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 int
+		arg3 uint64
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Entity", []interface{}{arg1, arg2, arg3})
 	fake.entityMutex.Unlock()
 	if fake.EntityStub != nil {
-		return fake.EntityStub(arg1, arg2)
+		return fake.EntityStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -96,17 +133,17 @@ func (fake *FakeStoreProvider) EntityCallCount() int {
 	return len(fake.entityArgsForCall)
 }
 
-func (fake *FakeStoreProvider) EntityCalls(stub func(int, uint64) (*models.Entity, error)) {
+func (fake *FakeStoreProvider) EntityCalls(stub func(context.Context, int, uint64) (*models.Entity, error)) {
 	fake.entityMutex.Lock()
 	defer fake.entityMutex.Unlock()
 	fake.EntityStub = stub
 }
 
-func (fake *FakeStoreProvider) EntityArgsForCall(i int) (int, uint64) {
+func (fake *FakeStoreProvider) EntityArgsForCall(i int) (context.Context, int, uint64) {
 	fake.entityMutex.RLock()
 	defer fake.entityMutex.RUnlock()
 	argsForCall := fake.entityArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeStoreProvider) EntityReturns(result1 *models.Entity, result2 error) {
@@ -135,6 +172,72 @@ func (fake *FakeStoreProvider) EntityReturnsOnCall(i int, result1 *models.Entity
 	}{result1, result2}
 }
 
+func (fake *FakeStoreProvider) EntityAt(arg1 context.Context, arg2 int, arg3 uint64, arg4 time.Time) (*models.Entity, error) {
+	fake.entityAtMutex.Lock()
+	ret, specificReturn := fake.entityAtReturnsOnCall[len(fake.entityAtArgsForCall)]
+	fake.entityAtArgsForCall = append(fake.entityAtArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 uint64
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("EntityAt", []interface{}{arg1, arg2, arg3, arg4})
+	fake.entityAtMutex.Unlock()
+	if fake.EntityAtStub != nil {
+		return fake.EntityAtStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.entityAtReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreProvider) EntityAtCallCount() int {
+	fake.entityAtMutex.RLock()
+	defer fake.entityAtMutex.RUnlock()
+	return len(fake.entityAtArgsForCall)
+}
+
+func (fake *FakeStoreProvider) EntityAtCalls(stub func(context.Context, int, uint64, time.Time) (*models.Entity, error)) {
+	fake.entityAtMutex.Lock()
+	defer fake.entityAtMutex.Unlock()
+	fake.EntityAtStub = stub
+}
+
+func (fake *FakeStoreProvider) EntityAtArgsForCall(i int) (context.Context, int, uint64, time.Time) {
+	fake.entityAtMutex.RLock()
+	defer fake.entityAtMutex.RUnlock()
+	argsForCall := fake.entityAtArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStoreProvider) EntityAtReturns(result1 *models.Entity, result2 error) {
+	fake.entityAtMutex.Lock()
+	defer fake.entityAtMutex.Unlock()
+	fake.EntityAtStub = nil
+	fake.entityAtReturns = struct {
+		result1 *models.Entity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreProvider) EntityAtReturnsOnCall(i int, result1 *models.Entity, result2 error) {
+	fake.entityAtMutex.Lock()
+	defer fake.entityAtMutex.Unlock()
+	fake.EntityAtStub = nil
+	if fake.entityAtReturnsOnCall == nil {
+		fake.entityAtReturnsOnCall = make(map[int]struct {
+			result1 *models.Entity
+			result2 error
+		})
+	}
+	fake.entityAtReturnsOnCall[i] = struct {
+		result1 *models.Entity
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreProvider) EntityEventSource() models.EntityEventSource {
 	fake.entityEventSourceMutex.Lock()
 	ret, specificReturn := fake.entityEventSourceReturnsOnCall[len(fake.entityEventSourceArgsForCall)]
@@ -187,16 +290,17 @@ func (fake *FakeStoreProvider) EntityEventSourceReturnsOnCall(i int, result1 mod
 	}{result1}
 }
 
-func (fake *FakeStoreProvider) Stream(arg1 int) (*models.Stream, error) {
+func (fake *FakeStoreProvider) Stream(arg1 context.Context, arg2 int) (*models.Stream, error) {
 	fake.streamMutex.Lock()
 	ret, specificReturn := fake.streamReturnsOnCall[len(fake.streamArgsForCall)]
 	fake.streamArgsForCall = append(fake.streamArgsForCall, struct {
-		arg1 int
-	}{arg1})
-	fake.recordInvocation("Stream", []interface{}{arg1})
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("Stream", []interface{}{arg1, arg2})
 	fake.streamMutex.Unlock()
 	if fake.StreamStub != nil {
-		return fake.StreamStub(arg1)
+		return fake.StreamStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -211,17 +315,17 @@ func (fake *FakeStoreProvider) StreamCallCount() int {
 	return len(fake.streamArgsForCall)
 }
 
-func (fake *FakeStoreProvider) StreamCalls(stub func(int) (*models.Stream, error)) {
+func (fake *FakeStoreProvider) StreamCalls(stub func(context.Context, int) (*models.Stream, error)) {
 	fake.streamMutex.Lock()
 	defer fake.streamMutex.Unlock()
 	fake.StreamStub = stub
 }
 
-func (fake *FakeStoreProvider) StreamArgsForCall(i int) int {
+func (fake *FakeStoreProvider) StreamArgsForCall(i int) (context.Context, int) {
 	fake.streamMutex.RLock()
 	defer fake.streamMutex.RUnlock()
 	argsForCall := fake.streamArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeStoreProvider) StreamReturns(result1 *models.Stream, result2 error) {
@@ -250,6 +354,71 @@ func (fake *FakeStoreProvider) StreamReturnsOnCall(i int, result1 *models.Stream
 	}{result1, result2}
 }
 
+func (fake *FakeStoreProvider) StreamAt(arg1 context.Context, arg2 int, arg3 time.Time) (*models.Stream, error) {
+	fake.streamAtMutex.Lock()
+	ret, specificReturn := fake.streamAtReturnsOnCall[len(fake.streamAtArgsForCall)]
+	fake.streamAtArgsForCall = append(fake.streamAtArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("StreamAt", []interface{}{arg1, arg2, arg3})
+	fake.streamAtMutex.Unlock()
+	if fake.StreamAtStub != nil {
+		return fake.StreamAtStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.streamAtReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStoreProvider) StreamAtCallCount() int {
+	fake.streamAtMutex.RLock()
+	defer fake.streamAtMutex.RUnlock()
+	return len(fake.streamAtArgsForCall)
+}
+
+func (fake *FakeStoreProvider) StreamAtCalls(stub func(context.Context, int, time.Time) (*models.Stream, error)) {
+	fake.streamAtMutex.Lock()
+	defer fake.streamAtMutex.Unlock()
+	fake.StreamAtStub = stub
+}
+
+func (fake *FakeStoreProvider) StreamAtArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.streamAtMutex.RLock()
+	defer fake.streamAtMutex.RUnlock()
+	argsForCall := fake.streamAtArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStoreProvider) StreamAtReturns(result1 *models.Stream, result2 error) {
+	fake.streamAtMutex.Lock()
+	defer fake.streamAtMutex.Unlock()
+	fake.StreamAtStub = nil
+	fake.streamAtReturns = struct {
+		result1 *models.Stream
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStoreProvider) StreamAtReturnsOnCall(i int, result1 *models.Stream, result2 error) {
+	fake.streamAtMutex.Lock()
+	defer fake.streamAtMutex.Unlock()
+	fake.StreamAtStub = nil
+	if fake.streamAtReturnsOnCall == nil {
+		fake.streamAtReturnsOnCall = make(map[int]struct {
+			result1 *models.Stream
+			result2 error
+		})
+	}
+	fake.streamAtReturnsOnCall[i] = struct {
+		result1 *models.Stream
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStoreProvider) StreamEventSource() models.StreamEventSource {
 	fake.streamEventSourceMutex.Lock()
 	ret, specificReturn := fake.streamEventSourceReturnsOnCall[len(fake.streamEventSourceArgsForCall)]
@@ -302,15 +471,16 @@ func (fake *FakeStoreProvider) StreamEventSourceReturnsOnCall(i int, result1 mod
 	}{result1}
 }
 
-func (fake *FakeStoreProvider) Streams() ([]models.Stream, error) {
+func (fake *FakeStoreProvider) Streams(arg1 context.Context) ([]models.Stream, error) {
 	fake.streamsMutex.Lock()
 	ret, specificReturn := fake.streamsReturnsOnCall[len(fake.streamsArgsForCall)]
 	fake.streamsArgsForCall = append(fake.streamsArgsForCall, struct {
-	}{})
-	fake.recordInvocation("Streams", []interface{}{})
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Streams", []interface{}{arg1})
 	fake.streamsMutex.Unlock()
 	if fake.StreamsStub != nil {
-		return fake.StreamsStub()
+		return fake.StreamsStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -325,12 +495,19 @@ func (fake *FakeStoreProvider) StreamsCallCount() int {
 	return len(fake.streamsArgsForCall)
 }
 
-func (fake *FakeStoreProvider) StreamsCalls(stub func() ([]models.Stream, error)) {
+func (fake *FakeStoreProvider) StreamsCalls(stub func(context.Context) ([]models.Stream, error)) {
 	fake.streamsMutex.Lock()
 	defer fake.streamsMutex.Unlock()
 	fake.StreamsStub = stub
 }
 
+func (fake *FakeStoreProvider) StreamsArgsForCall(i int) context.Context {
+	fake.streamsMutex.RLock()
+	defer fake.streamsMutex.RUnlock()
+	argsForCall := fake.streamsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeStoreProvider) StreamsReturns(result1 []models.Stream, result2 error) {
 	fake.streamsMutex.Lock()
 	defer fake.streamsMutex.Unlock()
@@ -362,10 +539,14 @@ func (fake *FakeStoreProvider) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.entityMutex.RLock()
 	defer fake.entityMutex.RUnlock()
+	fake.entityAtMutex.RLock()
+	defer fake.entityAtMutex.RUnlock()
 	fake.entityEventSourceMutex.RLock()
 	defer fake.entityEventSourceMutex.RUnlock()
 	fake.streamMutex.RLock()
 	defer fake.streamMutex.RUnlock()
+	fake.streamAtMutex.RLock()
+	defer fake.streamAtMutex.RUnlock()
 	fake.streamEventSourceMutex.RLock()
 	defer fake.streamEventSourceMutex.RUnlock()
 	fake.streamsMutex.RLock()
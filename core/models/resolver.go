@@ -3,6 +3,8 @@ package models
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 )
 
 // AetherometerAPIVersion returns the current semantic version of the API. Generally,
@@ -10,28 +12,170 @@ import (
 // Minor breaking changes are introduced with new minor versions of the API.
 // Major API changes and rewrites will be introduced with new major versions
 // of the API
-const AetherometerAPIVersion = "v0.3.2"
+const AetherometerAPIVersion = "v0.4.0"
 
 // StreamRequestHandler defines the type of a client request handler that can
 // be attached to the resolver.
 type StreamRequestHandler func(streamID int, data []byte) (resp string, err error)
 
+// AdapterLister defines the type of a function that lists the adapters
+// currently running on the server, along with their health if available.
+type AdapterLister func() []Adapter
+
+// StreamCommandLister defines the type of a function that lists the commands
+// supported by a stream's requests.
+type StreamCommandLister func(streamID int) ([]StreamCommand, error)
+
+// OpcodeTableReporter defines the type of a function that reports the opcode
+// mappings currently used to parse network blocks.
+type OpcodeTableReporter func() OpcodeTable
+
+// ThrottleStatsLister defines the type of a function that lists the number of
+// blocks dropped by a stream's throttle for each throttled datatype.
+type ThrottleStatsLister func(streamID int) ([]ThrottleStat, error)
+
+// HookMessageLister defines the type of a function that lists the most recent
+// messages sent by the hook of a stream.
+type HookMessageLister func(streamID int) ([]HookMessage, error)
+
+// CandidateProcessLister defines the type of a function that lists the
+// processes that adapters can attach to, along with the streams of the
+// processes that they are attached to.
+type CandidateProcessLister func() []ProcessCandidate
+
+// ProcessAttacher defines the type of a function that attaches an adapter to
+// a candidate process. The stream of the process is only created once the
+// adapter finishes connecting to it.
+type ProcessAttacher func(pid int) error
+
+// ProcessDetacher defines the type of a function that detaches an adapter from
+// the process of a stream and shuts down the stream.
+type ProcessDetacher func(streamID int) error
+
+// EventQuery selects the events of a stream to read back from the event
+// journal. From is inclusive and To is exclusive. Types lists the names of
+// the event types to return, such as "UpdateLocation", and EntityID restricts
+// the events to entity events of a single entity. After is the cursor of the
+// last entry of the previous page, if any.
+type EventQuery struct {
+	StreamID int
+	From     *time.Time
+	To       *time.Time
+	Types    []string
+	EntityID *uint64
+	After    string
+	Limit    int
+}
+
+// EventJournalReader defines the type of a function that reads back the
+// events recorded by the event journal.
+type EventJournalReader func(query EventQuery) (*JournalPage, error)
+
 // Resolver is a resolver for the queried data
 type Resolver struct {
 	sp      StoreProvider
 	auth    AuthProvider
 	handler StreamRequestHandler
+	lister  AdapterLister
+	cmds    StreamCommandLister
+	opcodes OpcodeTableReporter
+	stats   ThrottleStatsLister
+	msgs    HookMessageLister
+	procs   CandidateProcessLister
+	attach  ProcessAttacher
+	detach  ProcessDetacher
+	journal EventJournalReader
 }
 
 // NewResolver creates a new query resolver
 // It takes the sp as an argument to use as a backing store for the queried
-// data
+// data. The other dependencies of the queries and mutations are provided with
+// the options.
 func NewResolver(
 	sp StoreProvider,
 	auth AuthProvider,
 	streamRequestHandler StreamRequestHandler,
+	opts ...ResolverOption,
 ) *Resolver {
-	return &Resolver{sp: sp, auth: auth, handler: streamRequestHandler}
+	r := &Resolver{sp: sp, auth: auth, handler: streamRequestHandler}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ResolverOption defines an optional dependency of the Resolver
+type ResolverOption func(r *Resolver)
+
+// WithAdapterLister sets the function used to list the running adapters.
+func WithAdapterLister(lister AdapterLister) ResolverOption {
+	return func(r *Resolver) {
+		r.lister = lister
+	}
+}
+
+// WithStreamCommandLister sets the function used to list the commands
+// supported by a stream.
+func WithStreamCommandLister(lister StreamCommandLister) ResolverOption {
+	return func(r *Resolver) {
+		r.cmds = lister
+	}
+}
+
+// WithOpcodeTableReporter sets the function used to report the opcode
+// mappings.
+func WithOpcodeTableReporter(reporter OpcodeTableReporter) ResolverOption {
+	return func(r *Resolver) {
+		r.opcodes = reporter
+	}
+}
+
+// WithThrottleStatsLister sets the function used to list the throttle stats
+// of a stream.
+func WithThrottleStatsLister(lister ThrottleStatsLister) ResolverOption {
+	return func(r *Resolver) {
+		r.stats = lister
+	}
+}
+
+// WithHookMessageLister sets the function used to list the hook messages of
+// a stream.
+func WithHookMessageLister(lister HookMessageLister) ResolverOption {
+	return func(r *Resolver) {
+		r.msgs = lister
+	}
+}
+
+// WithCandidateProcessLister sets the function used to list the processes
+// that adapters can attach to.
+func WithCandidateProcessLister(lister CandidateProcessLister) ResolverOption {
+	return func(r *Resolver) {
+		r.procs = lister
+	}
+}
+
+// WithProcessAttacher sets the function used to attach an adapter to a
+// process.
+func WithProcessAttacher(attacher ProcessAttacher) ResolverOption {
+	return func(r *Resolver) {
+		r.attach = attacher
+	}
+}
+
+// WithProcessDetacher sets the function used to detach an adapter from the
+// process of a stream.
+func WithProcessDetacher(detacher ProcessDetacher) ResolverOption {
+	return func(r *Resolver) {
+		r.detach = detacher
+	}
+}
+
+// WithEventJournalReader sets the function used to read back the events
+// recorded by the event journal.
+func WithEventJournalReader(reader EventJournalReader) ResolverOption {
+	return func(r *Resolver) {
+		r.journal = reader
+	}
 }
 
 // Mutation allows graphql to handle mutation requests for the system
@@ -63,6 +207,36 @@ func (r *mutationResolver) SendStreamRequest(ctx context.Context, req StreamRequ
 	return r.handler(req.StreamID, []byte(req.Data))
 }
 
+// AttachProcess attaches an adapter to the candidate process identified by
+// pid. It requires admin authorization.
+func (r *mutationResolver) AttachProcess(ctx context.Context, pid int) (bool, error) {
+	if err := r.auth.AuthorizeAdmin(ctx); err != nil {
+		return false, err
+	}
+	if r.attach == nil {
+		return false, errors.New("process attacher is missing")
+	}
+	if err := r.attach(pid); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DetachProcess detaches the adapter from the process of the stream
+// identified by streamID. It requires admin authorization.
+func (r *mutationResolver) DetachProcess(ctx context.Context, streamID int) (bool, error) {
+	if err := r.auth.AuthorizeAdmin(ctx); err != nil {
+		return false, err
+	}
+	if r.detach == nil {
+		return false, errors.New("process detacher is missing")
+	}
+	if err := r.detach(streamID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type queryResolver struct{ *Resolver }
 
 // APIVersion returns the version of the API (see AetherometerAPIVersion).
@@ -75,24 +249,150 @@ func (r *queryResolver) Streams(ctx context.Context) ([]Stream, error) {
 	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
 		return nil, err
 	}
-	return r.sp.Streams()
+	return r.sp.Streams(ctx)
 }
 
-// Stream returns the stream identified by streamID.
-func (r *queryResolver) Stream(ctx context.Context, streamID int) (*Stream, error) {
+// Adapters returns all of the adapters currently running on the server.
+func (r *queryResolver) Adapters(ctx context.Context) ([]Adapter, error) {
 	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
 		return nil, err
 	}
-	return r.sp.Stream(streamID)
+	if r.lister == nil {
+		return []Adapter{}, nil
+	}
+	return r.lister(), nil
+}
+
+// StreamCommands returns the commands that can be sent in requests to the
+// stream identified by streamID.
+func (r *queryResolver) StreamCommands(ctx context.Context, streamID int) ([]StreamCommand, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if r.cmds == nil {
+		return []StreamCommand{}, nil
+	}
+	return r.cmds(streamID)
+}
+
+// OpcodeTable returns the opcode mappings currently used to parse network
+// blocks, along with the override file they were loaded from.
+func (r *queryResolver) OpcodeTable(ctx context.Context) (*OpcodeTable, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if r.opcodes == nil {
+		return &OpcodeTable{Mappings: []OpcodeMapping{}}, nil
+	}
+	t := r.opcodes()
+	return &t, nil
+}
+
+// ThrottleStats returns the number of blocks dropped by the throttle of the
+// stream identified by streamID.
+func (r *queryResolver) ThrottleStats(ctx context.Context, streamID int) ([]ThrottleStat, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if r.stats == nil {
+		return []ThrottleStat{}, nil
+	}
+	return r.stats(streamID)
+}
+
+// HookMessages returns the most recent messages sent by the hook of the
+// stream identified by streamID.
+func (r *queryResolver) HookMessages(ctx context.Context, streamID int) ([]HookMessage, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if r.msgs == nil {
+		return []HookMessage{}, nil
+	}
+	return r.msgs(streamID)
+}
+
+// CandidateProcesses returns the processes that adapters can attach to.
+func (r *queryResolver) CandidateProcesses(ctx context.Context) ([]ProcessCandidate, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if r.procs == nil {
+		return []ProcessCandidate{}, nil
+	}
+	return r.procs(), nil
+}
+
+// Stream returns the stream identified by streamID. If at is provided, it
+// returns the state of the stream at that time instead.
+func (r *queryResolver) Stream(ctx context.Context, streamID int, at *time.Time) (*Stream, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if at != nil {
+		return r.sp.StreamAt(ctx, streamID, *at)
+	}
+	return r.sp.Stream(ctx, streamID)
 }
 
 // Entity returns the entity identified by entityID in the requested stream
-// identified by streamID.
-func (r *queryResolver) Entity(ctx context.Context, streamID int, entityID uint64) (*Entity, error) {
+// identified by streamID. If at is provided, it returns the state of the
+// entity at that time instead.
+func (r *queryResolver) Entity(ctx context.Context, streamID int, entityID uint64, at *time.Time) (*Entity, error) {
 	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
 		return nil, err
 	}
-	return r.sp.Entity(streamID, entityID)
+	if at != nil {
+		return r.sp.EntityAt(ctx, streamID, entityID, *at)
+	}
+	return r.sp.Entity(ctx, streamID, entityID)
+}
+
+// DefaultEventPageSize is the number of journal entries returned by the
+// events query when the number is not requested.
+const DefaultEventPageSize = 100
+
+// MaxEventPageSize is the largest number of journal entries that can be
+// requested from the events query at once.
+const MaxEventPageSize = 1000
+
+// Events returns the events of the stream identified by streamID that were
+// recorded by the event journal, oldest first. Pages are requested by passing
+// the nextCursor of the previous page as after.
+func (r *queryResolver) Events(
+	ctx context.Context,
+	streamID int,
+	from *time.Time,
+	to *time.Time,
+	types []string,
+	entityID *uint64,
+	after *string,
+	first *int,
+) (*JournalPage, error) {
+	if err := r.auth.AuthorizePluginToken(ctx); err != nil {
+		return nil, err
+	}
+	if r.journal == nil {
+		return nil, errors.New("event journal is disabled")
+	}
+	query := EventQuery{
+		StreamID: streamID,
+		From:     from,
+		To:       to,
+		Types:    types,
+		EntityID: entityID,
+		Limit:    DefaultEventPageSize,
+	}
+	if after != nil {
+		query.After = *after
+	}
+	if first != nil {
+		if *first < 1 || *first > MaxEventPageSize {
+			return nil, fmt.Errorf("first must be between 1 and %d", MaxEventPageSize)
+		}
+		query.Limit = *first
+	}
+	return r.journal(query)
 }
 
 type subscriptionResolver struct{ *Resolver }
@@ -3,6 +3,7 @@ package models_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/models/modelsfakes"
@@ -37,7 +38,7 @@ var _ = Describe("Models", func() {
 			stream2 = models.Stream{ID: 5678}
 			fakeStoreProvider.StreamsReturns([]models.Stream{stream1, stream2}, nil)
 
-			fakeStoreProvider.StreamStub = func(streamID int) (*models.Stream, error) {
+			fakeStoreProvider.StreamStub = func(_ context.Context, streamID int) (*models.Stream, error) {
 				if streamID == 1234 {
 					return &stream1, nil
 				} else if streamID == 5678 {
@@ -46,8 +47,8 @@ var _ = Describe("Models", func() {
 				return nil, errors.New("not found")
 			}
 
-			fakeStoreProvider.EntityStub = func(streamID int, entityID uint64) (*models.Entity, error) {
-				s, err := fakeStoreProvider.Stream(streamID)
+			fakeStoreProvider.EntityStub = func(ctx context.Context, streamID int, entityID uint64) (*models.Entity, error) {
+				s, err := fakeStoreProvider.Stream(ctx, streamID)
 				if err != nil {
 					return nil, err
 				}
@@ -70,6 +71,13 @@ var _ = Describe("Models", func() {
 				))
 			})
 
+			It("passes the request context to the store", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				_, _ = resolver.Query().Streams(ctx)
+				Expect(fakeStoreProvider.StreamsArgsForCall(0)).To(Equal(ctx))
+			})
+
 			Context("when the request is not authorized", func() {
 				BeforeEach(func() {
 					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
@@ -83,23 +91,360 @@ var _ = Describe("Models", func() {
 			})
 		})
 
+		Describe("Adapters", func() {
+			var adapters []models.Adapter
+
+			BeforeEach(func() {
+				adapters = []models.Adapter{
+					{Name: "Hook", Health: &models.Health{State: models.HealthStateConnected}},
+					{Name: "Replay"},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithAdapterLister(func() []models.Adapter {
+					return adapters
+				}))
+			})
+
+			It("returns the adapters provided by the adapter lister", func() {
+				Expect(resolver.Query().Adapters(context.Background())).To(Equal(adapters))
+			})
+
+			It("returns an empty list when the adapter lister is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				Expect(resolver.Query().Adapters(context.Background())).To(BeEmpty())
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					a, err := resolver.Query().Adapters(context.Background())
+					Expect(err).To(MatchError("Boom"))
+					Expect(a).To(BeNil())
+				})
+			})
+		})
+
+		Describe("StreamCommands", func() {
+			var commands []models.StreamCommand
+
+			BeforeEach(func() {
+				commands = []models.StreamCommand{
+					{Name: "ping", Description: "Pings the hook", Schema: `{"type": "object"}`},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithStreamCommandLister(func(streamID int) ([]models.StreamCommand, error) {
+					if streamID != 1234 {
+						return nil, errors.New("stream not found")
+					}
+					return commands, nil
+				}))
+			})
+
+			It("returns the commands provided by the command lister", func() {
+				Expect(resolver.Query().StreamCommands(context.Background(), 1234)).To(Equal(commands))
+			})
+
+			It("returns the error from the command lister", func() {
+				_, err := resolver.Query().StreamCommands(context.Background(), 5678)
+				Expect(err).To(MatchError("stream not found"))
+			})
+
+			It("returns an empty list when the command lister is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				Expect(resolver.Query().StreamCommands(context.Background(), 1234)).To(BeEmpty())
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					c, err := resolver.Query().StreamCommands(context.Background(), 1234)
+					Expect(err).To(MatchError("Boom"))
+					Expect(c).To(BeNil())
+				})
+			})
+		})
+
+		Describe("OpcodeTable", func() {
+			var table models.OpcodeTable
+
+			BeforeEach(func() {
+				file := "opcodes.toml"
+				table = models.OpcodeTable{
+					File: &file,
+					Mappings: []models.OpcodeMapping{{
+						Direction: models.OpcodeDirectionIngress,
+						Channel:   models.OpcodeChannelZone,
+						Opcode:    0x1234,
+						Datatype:  "Movement",
+						Source:    models.OpcodeSourceOverride,
+					}},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithOpcodeTableReporter(func() models.OpcodeTable {
+					return table
+				}))
+			})
+
+			It("returns the table provided by the opcode table reporter", func() {
+				Expect(resolver.Query().OpcodeTable(context.Background())).To(Equal(&table))
+			})
+
+			It("returns an empty table when the opcode table reporter is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				Expect(resolver.Query().OpcodeTable(context.Background())).To(Equal(&models.OpcodeTable{
+					Mappings: []models.OpcodeMapping{},
+				}))
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					t, err := resolver.Query().OpcodeTable(context.Background())
+					Expect(err).To(MatchError("Boom"))
+					Expect(t).To(BeNil())
+				})
+			})
+		})
+
+		Describe("ThrottleStats", func() {
+			var stats []models.ThrottleStat
+
+			BeforeEach(func() {
+				stats = []models.ThrottleStat{
+					{Datatype: "Movement", Policy: models.ThrottlePolicyLatest, Dropped: 12},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithThrottleStatsLister(func(streamID int) ([]models.ThrottleStat, error) {
+					if streamID != 1234 {
+						return nil, errors.New("stream not found")
+					}
+					return stats, nil
+				}))
+			})
+
+			It("returns the stats provided by the throttle stats lister", func() {
+				Expect(resolver.Query().ThrottleStats(context.Background(), 1234)).To(Equal(stats))
+			})
+
+			It("returns the error from the throttle stats lister", func() {
+				_, err := resolver.Query().ThrottleStats(context.Background(), 5678)
+				Expect(err).To(MatchError("stream not found"))
+			})
+
+			It("returns an empty list when the throttle stats lister is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				Expect(resolver.Query().ThrottleStats(context.Background(), 1234)).To(BeEmpty())
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					s, err := resolver.Query().ThrottleStats(context.Background(), 1234)
+					Expect(err).To(MatchError("Boom"))
+					Expect(s).To(BeNil())
+				})
+			})
+		})
+
+		Describe("HookMessages", func() {
+			var messages []models.HookMessage
+
+			BeforeEach(func() {
+				messages = []models.HookMessage{
+					{Time: time.Unix(10, 0), Severity: models.HookMessageSeverityWarn, Message: "Signature scan failed"},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithHookMessageLister(func(streamID int) ([]models.HookMessage, error) {
+					if streamID != 1234 {
+						return nil, errors.New("stream not found")
+					}
+					return messages, nil
+				}))
+			})
+
+			It("returns the messages provided by the hook message lister", func() {
+				Expect(resolver.Query().HookMessages(context.Background(), 1234)).To(Equal(messages))
+			})
+
+			It("returns the error from the hook message lister", func() {
+				_, err := resolver.Query().HookMessages(context.Background(), 5678)
+				Expect(err).To(MatchError("stream not found"))
+			})
+
+			It("returns an empty list when the hook message lister is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				Expect(resolver.Query().HookMessages(context.Background(), 1234)).To(BeEmpty())
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					m, err := resolver.Query().HookMessages(context.Background(), 1234)
+					Expect(err).To(MatchError("Boom"))
+					Expect(m).To(BeNil())
+				})
+			})
+		})
+
+		Describe("CandidateProcesses", func() {
+			var candidates []models.ProcessCandidate
+
+			BeforeEach(func() {
+				streamID := 1
+				candidates = []models.ProcessCandidate{
+					{Pid: 1234, Adapter: "Hook", Rule: "game", StreamID: &streamID},
+					{Pid: 5678, Adapter: "Hook", Rule: "game"},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithCandidateProcessLister(func() []models.ProcessCandidate {
+					return candidates
+				}))
+			})
+
+			It("returns the processes provided by the candidate process lister", func() {
+				Expect(resolver.Query().CandidateProcesses(context.Background())).To(Equal(candidates))
+			})
+
+			It("returns an empty list when the candidate process lister is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				Expect(resolver.Query().CandidateProcesses(context.Background())).To(BeEmpty())
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					c, err := resolver.Query().CandidateProcesses(context.Background())
+					Expect(err).To(MatchError("Boom"))
+					Expect(c).To(BeNil())
+				})
+			})
+		})
+
+		Describe("Events", func() {
+			var (
+				page    *models.JournalPage
+				queries []models.EventQuery
+			)
+
+			BeforeEach(func() {
+				queries = nil
+				page = &models.JournalPage{
+					Entries: []models.JournalEntry{
+						{Cursor: "1", StreamEvent: &models.StreamEvent{StreamID: 1234, Type: models.RemoveStream{ID: 1234}}},
+					},
+				}
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithEventJournalReader(func(query models.EventQuery) (*models.JournalPage, error) {
+					queries = append(queries, query)
+					return page, nil
+				}))
+			})
+
+			It("returns the page read from the event journal", func() {
+				from := time.Unix(10, 0)
+				entityID := uint64(5678)
+				after := "41"
+				first := 20
+				Expect(resolver.Query().Events(
+					context.Background(), 1234, &from, nil, []string{"UpdateLocation"}, &entityID, &after, &first,
+				)).To(Equal(page))
+				Expect(queries).To(Equal([]models.EventQuery{{
+					StreamID: 1234,
+					From:     &from,
+					Types:    []string{"UpdateLocation"},
+					EntityID: &entityID,
+					After:    "41",
+					Limit:    20,
+				}}))
+			})
+
+			It("requests the default number of entries when first is not provided", func() {
+				_, err := resolver.Query().Events(context.Background(), 1234, nil, nil, nil, nil, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queries).To(HaveLen(1))
+				Expect(queries[0].Limit).To(Equal(models.DefaultEventPageSize))
+			})
+
+			It("returns an error when first is out of range", func() {
+				for _, first := range []int{0, models.MaxEventPageSize + 1} {
+					first := first
+					_, err := resolver.Query().Events(context.Background(), 1234, nil, nil, nil, nil, nil, &first)
+					Expect(err).To(MatchError("first must be between 1 and 1000"))
+				}
+				Expect(queries).To(BeEmpty())
+			})
+
+			It("returns an error when the event journal is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				_, err := resolver.Query().Events(context.Background(), 1234, nil, nil, nil, nil, nil, nil)
+				Expect(err).To(MatchError("event journal is disabled"))
+			})
+
+			Context("when the request is not authorized", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error", func() {
+					p, err := resolver.Query().Events(context.Background(), 1234, nil, nil, nil, nil, nil, nil)
+					Expect(err).To(MatchError("Boom"))
+					Expect(p).To(BeNil())
+					Expect(queries).To(BeEmpty())
+				})
+			})
+		})
+
 		Describe("Stream", func() {
 			It("returns the requested stream from the store", func() {
-				Expect(resolver.Query().Stream(context.Background(), 5678)).To(Equal(&stream2))
+				Expect(resolver.Query().Stream(context.Background(), 5678, nil)).To(Equal(&stream2))
 			})
 
 			It("returns an error if the requested stream does not exist", func() {
-				_, err := resolver.Query().Stream(context.Background(), 2345)
+				_, err := resolver.Query().Stream(context.Background(), 2345, nil)
 				Expect(err).To(MatchError("not found"))
 			})
 
+			It("passes the request context to the store", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				_, _ = resolver.Query().Stream(ctx, 5678, nil)
+				streamCtx, _ := fakeStoreProvider.StreamArgsForCall(0)
+				Expect(streamCtx).To(Equal(ctx))
+
+				at := time.Unix(100, 0)
+				_, _ = resolver.Query().Stream(ctx, 5678, &at)
+				streamAtCtx, _, _ := fakeStoreProvider.StreamAtArgsForCall(0)
+				Expect(streamAtCtx).To(Equal(ctx))
+			})
+
+			It("returns the state of the stream at the requested time from the store", func() {
+				at := time.Unix(100, 0)
+				fakeStoreProvider.StreamAtReturns(&stream1, nil)
+				Expect(resolver.Query().Stream(context.Background(), 1234, &at)).To(Equal(&stream1))
+				_, streamID, t := fakeStoreProvider.StreamAtArgsForCall(0)
+				Expect(streamID).To(Equal(1234))
+				Expect(t).To(Equal(at))
+				Expect(fakeStoreProvider.StreamCallCount()).To(BeZero())
+			})
+
 			Context("when the request is not authorized", func() {
 				BeforeEach(func() {
 					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
 				})
 
 				It("returns an authorization error", func() {
-					s, err := resolver.Query().Stream(context.Background(), 2345)
+					s, err := resolver.Query().Stream(context.Background(), 2345, nil)
 					Expect(err).To(MatchError("Boom"))
 					Expect(s).To(BeZero())
 				})
@@ -108,28 +453,53 @@ var _ = Describe("Models", func() {
 
 		Describe("Entity", func() {
 			It("returns the requested entity from the store", func() {
-				Expect(resolver.Query().Entity(context.Background(), 1234, 1)).To(Equal(
+				Expect(resolver.Query().Entity(context.Background(), 1234, 1, nil)).To(Equal(
 					&models.Entity{ID: 1, Name: "FooBar", Index: 2},
 				))
 			})
 
 			It("returns an error if the requested stream does not exist", func() {
-				_, err := resolver.Query().Entity(context.Background(), 2345, 1)
+				_, err := resolver.Query().Entity(context.Background(), 2345, 1, nil)
 				Expect(err).To(MatchError("not found"))
 			})
 
 			It("returns an error if the requested entity does not exist", func() {
-				_, err := resolver.Query().Entity(context.Background(), 1234, 3)
+				_, err := resolver.Query().Entity(context.Background(), 1234, 3, nil)
 				Expect(err).To(MatchError("not found"))
 			})
 
+			It("passes the request context to the store", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				_, _ = resolver.Query().Entity(ctx, 1234, 1, nil)
+				entityCtx, _, _ := fakeStoreProvider.EntityArgsForCall(0)
+				Expect(entityCtx).To(Equal(ctx))
+
+				at := time.Unix(100, 0)
+				_, _ = resolver.Query().Entity(ctx, 1234, 1, &at)
+				entityAtCtx, _, _, _ := fakeStoreProvider.EntityAtArgsForCall(0)
+				Expect(entityAtCtx).To(Equal(ctx))
+			})
+
+			It("returns the state of the entity at the requested time from the store", func() {
+				at := time.Unix(100, 0)
+				entity := &models.Entity{ID: 1, Name: "FooBar", Index: 2}
+				fakeStoreProvider.EntityAtReturns(entity, nil)
+				Expect(resolver.Query().Entity(context.Background(), 1234, 1, &at)).To(Equal(entity))
+				_, streamID, entityID, t := fakeStoreProvider.EntityAtArgsForCall(0)
+				Expect(streamID).To(Equal(1234))
+				Expect(entityID).To(Equal(uint64(1)))
+				Expect(t).To(Equal(at))
+				Expect(fakeStoreProvider.EntityCallCount()).To(BeZero())
+			})
+
 			Context("when the request is not authorized", func() {
 				BeforeEach(func() {
 					fakeAuthProvider.AuthorizePluginTokenReturns(errors.New("Boom"))
 				})
 
 				It("returns an authorization error", func() {
-					e, err := resolver.Query().Entity(context.Background(), 1234, 1)
+					e, err := resolver.Query().Entity(context.Background(), 1234, 1, nil)
 					Expect(err).To(MatchError("Boom"))
 					Expect(e).To(BeZero())
 				})
@@ -325,5 +695,72 @@ var _ = Describe("Models", func() {
 				})
 			})
 		})
+
+		Describe("AttachProcess and DetachProcess", func() {
+			var (
+				attachedPID      int
+				detachedStreamID int
+			)
+
+			BeforeEach(func() {
+				attachedPID = 0
+				detachedStreamID = 0
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil, models.WithProcessAttacher(func(pid int) error {
+					if pid != 1234 {
+						return errors.New("process 5678 is not a candidate process")
+					}
+					attachedPID = pid
+					return nil
+				}), models.WithProcessDetacher(func(streamID int) error {
+					if streamID != 1 {
+						return errors.New("stream provider 2 not found")
+					}
+					detachedStreamID = streamID
+					return nil
+				}))
+			})
+
+			It("attaches to the requested process", func() {
+				Expect(resolver.Mutation().AttachProcess(context.Background(), 1234)).To(BeTrue())
+				Expect(attachedPID).To(Equal(1234))
+			})
+
+			It("detaches from the process of the requested stream", func() {
+				Expect(resolver.Mutation().DetachProcess(context.Background(), 1)).To(BeTrue())
+				Expect(detachedStreamID).To(Equal(1))
+			})
+
+			It("returns the errors from the attacher and the detacher", func() {
+				ok, err := resolver.Mutation().AttachProcess(context.Background(), 5678)
+				Expect(err).To(MatchError("process 5678 is not a candidate process"))
+				Expect(ok).To(BeFalse())
+				ok, err = resolver.Mutation().DetachProcess(context.Background(), 2)
+				Expect(err).To(MatchError("stream provider 2 not found"))
+				Expect(ok).To(BeFalse())
+			})
+
+			It("returns an error when the attacher or the detacher is missing", func() {
+				resolver = models.NewResolver(fakeStoreProvider, fakeAuthProvider, nil)
+				_, err := resolver.Mutation().AttachProcess(context.Background(), 1234)
+				Expect(err).To(MatchError("process attacher is missing"))
+				_, err = resolver.Mutation().DetachProcess(context.Background(), 1)
+				Expect(err).To(MatchError("process detacher is missing"))
+			})
+
+			Context("when the request is not authorized as an admin", func() {
+				BeforeEach(func() {
+					fakeAuthProvider.AuthorizeAdminReturns(errors.New("Boom"))
+				})
+
+				It("returns an authorization error without attaching or detaching", func() {
+					_, err := resolver.Mutation().AttachProcess(context.Background(), 1234)
+					Expect(err).To(MatchError("Boom"))
+					_, err = resolver.Mutation().DetachProcess(context.Background(), 1)
+					Expect(err).To(MatchError("Boom"))
+					Expect(attachedPID).To(BeZero())
+					Expect(detachedStreamID).To(BeZero())
+				})
+			})
+		})
 	})
 })
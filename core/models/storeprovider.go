@@ -1,14 +1,23 @@
 package models
 
+import (
+	"context"
+	"time"
+)
+
 // StoreProvider describes the expected interface of a datastore that can
 // provide the backing API requests.
 // There is no normalization of the data expected in the store, so each
 // stream has its own independent state. Querying for any data requires
 // walking down the data hierarchy.
+// Queries are given the context of the request they serve, and give up
+// with the error of the context once it is done.
 type StoreProvider interface {
-	Streams() ([]Stream, error)
-	Stream(streamID int) (*Stream, error)
-	Entity(streamID int, entityID uint64) (*Entity, error)
+	Streams(ctx context.Context) ([]Stream, error)
+	Stream(ctx context.Context, streamID int) (*Stream, error)
+	Entity(ctx context.Context, streamID int, entityID uint64) (*Entity, error)
+	StreamAt(ctx context.Context, streamID int, t time.Time) (*Stream, error)
+	EntityAt(ctx context.Context, streamID int, entityID uint64, t time.Time) (*Entity, error)
 	StreamEventSource() StreamEventSource
 	EntityEventSource() EntityEventSource
 }
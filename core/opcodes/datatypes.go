@@ -0,0 +1,35 @@
+package opcodes
+
+import "github.com/ff14wed/xivnet/v3"
+
+//go:generate go run ../scripts/datatypesgen.go
+
+// GenericDatatype is the name of the datatype that leaves a block unparsed.
+// Mapping an opcode to it prevents the compiled table from parsing blocks
+// with that opcode, such as when the opcode has been reused by a game patch.
+const GenericDatatype = "Generic"
+
+// datatype describes an xivnet datatype along with the opcode that xivnet
+// assigns to it
+type datatype struct {
+	name      string
+	direction Direction
+	channel   Channel
+	opcode    uint16
+	factory   func() xivnet.BlockData
+}
+
+// datatypeFactories maps the name of each known datatype to its factory
+var datatypeFactories = func() map[string]func() xivnet.BlockData {
+	factories := make(map[string]func() xivnet.BlockData)
+	for _, d := range compiledDatatypes {
+		factories[d.name] = d.factory
+	}
+	return factories
+}()
+
+// IsDatatype returns whether the name belongs to a datatype known to xivnet
+func IsDatatype(name string) bool {
+	_, found := datatypeFactories[name]
+	return found
+}
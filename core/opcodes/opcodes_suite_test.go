@@ -0,0 +1,13 @@
+package opcodes_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestOpcodes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Opcodes Suite")
+}
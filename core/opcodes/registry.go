@@ -0,0 +1,147 @@
+package opcodes
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+)
+
+// Registry keeps track of the active opcode override Table. It is consulted
+// before the opcodes compiled into xivnet whenever a network block is parsed.
+type Registry struct {
+	lock      sync.RWMutex
+	table     *Table
+	lastError *string
+}
+
+// NewRegistry returns a new Registry without any overrides
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// SetTable replaces the active override Table and clears the last error. A
+// nil Table removes all overrides.
+func (r *Registry) SetTable(t *Table) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.table = t
+	r.lastError = nil
+}
+
+// SetError records the last error encountered when loading the override
+// Table. The active Table is kept.
+func (r *Registry) SetError(err error) {
+	if err == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	errStr := err.Error()
+	r.lastError = &errStr
+}
+
+// NewBlockData returns a new BlockData of the datatype that the opcode maps
+// to, using the override Table if it maps the opcode and the opcodes compiled
+// into xivnet otherwise. It returns nil if the block should be left unparsed,
+// including when the compiled opcode belongs to a datatype that the override
+// Table moved to another opcode.
+func (r *Registry) NewBlockData(channel Channel, opcode uint16, isEgress bool) xivnet.BlockData {
+	direction := Ingress
+	if isEgress {
+		direction = Egress
+	}
+
+	r.lock.RLock()
+	factory, found := r.table.lookup(direction, channel, opcode)
+	r.lock.RUnlock()
+
+	if found {
+		if factory == nil {
+			return nil
+		}
+		return factory()
+	}
+
+	switch channel {
+	case Zone:
+		return datatypes.NewBlockData(opcode, isEgress)
+	case Chat:
+		return datatypes.NewChatBlockData(opcode, isEgress)
+	}
+	return nil
+}
+
+// Report returns the active opcode mappings along with the file they were
+// loaded from.
+func (r *Registry) Report() models.OpcodeTable {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	report := models.OpcodeTable{
+		LastError: r.lastError,
+		Mappings:  []models.OpcodeMapping{},
+	}
+	if r.table != nil {
+		file := r.table.File
+		loadedAt := r.table.LoadedAt
+		report.File = &file
+		report.LoadedAt = &loadedAt
+	}
+
+	for _, d := range compiledDatatypes {
+		if d.opcode == datatypes.UndefinedOpcode {
+			continue
+		}
+		if _, found := r.table.lookup(d.direction, d.channel, d.opcode); found {
+			continue
+		}
+		report.Mappings = append(report.Mappings, newModelMapping(
+			d.direction, d.channel, d.opcode, d.name, models.OpcodeSourceCompiled,
+		))
+	}
+	if r.table != nil {
+		for _, m := range r.table.Mappings {
+			report.Mappings = append(report.Mappings, newModelMapping(
+				m.Direction, m.Channel, m.Opcode, m.Datatype, models.OpcodeSourceOverride,
+			))
+		}
+	}
+
+	sort.SliceStable(report.Mappings, func(i, j int) bool {
+		a, b := report.Mappings[i], report.Mappings[j]
+		if a.Direction != b.Direction {
+			return a.Direction == models.OpcodeDirectionIngress
+		}
+		if a.Channel != b.Channel {
+			return a.Channel == models.OpcodeChannelZone
+		}
+		return a.Opcode < b.Opcode
+	})
+	return report
+}
+
+func newModelMapping(
+	direction Direction,
+	channel Channel,
+	opcode uint16,
+	datatype string,
+	source models.OpcodeSource,
+) models.OpcodeMapping {
+	m := models.OpcodeMapping{
+		Direction: models.OpcodeDirectionIngress,
+		Channel:   models.OpcodeChannelZone,
+		Opcode:    int(opcode),
+		Datatype:  datatype,
+		Source:    source,
+	}
+	if direction == Egress {
+		m.Direction = models.OpcodeDirectionEgress
+	}
+	if channel == Chat {
+		m.Channel = models.OpcodeChannelChat
+	}
+	return m
+}
@@ -0,0 +1,213 @@
+package opcodes_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadTable", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(tmpDir, name)
+		Expect(os.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		return path
+	}
+
+	It("loads the mappings from a TOML file", func() {
+		path := writeFile("opcodes.toml", `
+[[opcodes]]
+direction = "ingress"
+opcode = 0x1234
+datatype = "Movement"
+
+[[opcodes]]
+direction = "egress"
+channel = "chat"
+opcode = 0x99
+datatype = "EgressChat"
+`)
+		t, err := opcodes.LoadTable(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.File).To(Equal(path))
+		Expect(t.Mappings).To(Equal([]opcodes.Mapping{
+			{Direction: opcodes.Ingress, Channel: opcodes.Zone, Opcode: 0x1234, Datatype: "Movement"},
+			{Direction: opcodes.Egress, Channel: opcodes.Chat, Opcode: 0x99, Datatype: "EgressChat"},
+		}))
+	})
+
+	It("loads the mappings from a JSON file", func() {
+		path := writeFile("opcodes.json", `{"opcodes": [
+			{"direction": "ingress", "channel": "zone", "opcode": 4660, "datatype": "Movement"}
+		]}`)
+		t, err := opcodes.LoadTable(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Mappings).To(Equal([]opcodes.Mapping{
+			{Direction: opcodes.Ingress, Channel: opcodes.Zone, Opcode: 0x1234, Datatype: "Movement"},
+		}))
+	})
+
+	It("returns an error if the file does not exist", func() {
+		_, err := opcodes.LoadTable(filepath.Join(tmpDir, "missing.toml"))
+		Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+	})
+
+	It("returns an error if the file cannot be decoded", func() {
+		_, err := opcodes.LoadTable(writeFile("opcodes.json", `{"opcodes": [`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("returns an error for invalid mappings",
+		func(mapping opcodes.Mapping, expectedErr string) {
+			_, err := opcodes.NewTable([]opcodes.Mapping{mapping})
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("unknown direction", opcodes.Mapping{Direction: "sideways", Opcode: 1, Datatype: "Movement"},
+			`opcode mapping 0: unknown direction "sideways"`),
+		Entry("unknown channel", opcodes.Mapping{Direction: opcodes.Ingress, Channel: "lobby", Opcode: 1, Datatype: "Movement"},
+			`opcode mapping 0: unknown channel "lobby"`),
+		Entry("unknown datatype", opcodes.Mapping{Direction: opcodes.Ingress, Opcode: 1, Datatype: "Teleport"},
+			`opcode mapping 0: unknown datatype "Teleport"`),
+	)
+
+	It("returns an error for duplicate mappings", func() {
+		_, err := opcodes.NewTable([]opcodes.Mapping{
+			{Direction: opcodes.Ingress, Opcode: 0x1234, Datatype: "Movement"},
+			{Direction: opcodes.Ingress, Channel: opcodes.Zone, Opcode: 0x1234, Datatype: "SetPos"},
+		})
+		Expect(err).To(MatchError("opcode mapping 1: duplicate mapping for ingress zone opcode 0x1234"))
+	})
+})
+
+var _ = Describe("Registry", func() {
+	var registry *opcodes.Registry
+
+	BeforeEach(func() {
+		registry = opcodes.NewRegistry()
+	})
+
+	Describe("NewBlockData", func() {
+		It("uses the compiled opcodes when there are no overrides", func() {
+			Expect(registry.NewBlockData(opcodes.Zone, datatypes.MovementOpcode, false)).To(BeAssignableToTypeOf(new(datatypes.Movement)))
+			Expect(registry.NewBlockData(opcodes.Zone, datatypes.EgressMovementOpcode, true)).To(BeAssignableToTypeOf(new(datatypes.EgressMovement)))
+			Expect(registry.NewBlockData(opcodes.Chat, datatypes.ChatOpcode, false)).To(BeAssignableToTypeOf(new(datatypes.Chat)))
+			Expect(registry.NewBlockData(opcodes.Zone, 0x1234, false)).To(BeNil())
+		})
+
+		Context("when there are overrides", func() {
+			BeforeEach(func() {
+				t, err := opcodes.NewTable([]opcodes.Mapping{
+					{Direction: opcodes.Ingress, Opcode: 0x1234, Datatype: "Movement"},
+					{Direction: opcodes.Ingress, Opcode: datatypes.MovementOpcode, Datatype: opcodes.GenericDatatype},
+					{Direction: opcodes.Egress, Channel: opcodes.Chat, Opcode: 0x1234, Datatype: "EgressChat"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				registry.SetTable(t)
+			})
+
+			It("uses the overrides before the compiled opcodes", func() {
+				Expect(registry.NewBlockData(opcodes.Zone, 0x1234, false)).To(BeAssignableToTypeOf(new(datatypes.Movement)))
+				Expect(registry.NewBlockData(opcodes.Zone, datatypes.MovementOpcode, false)).To(BeNil())
+				Expect(registry.NewBlockData(opcodes.Chat, 0x1234, true)).To(BeAssignableToTypeOf(new(datatypes.EgressChat)))
+				Expect(registry.NewBlockData(opcodes.Zone, 0x1234, true)).To(BeNil())
+				Expect(registry.NewBlockData(opcodes.Zone, datatypes.SetPosOpcode, false)).To(BeAssignableToTypeOf(new(datatypes.SetPos)))
+			})
+
+			It("stops parsing the compiled opcode of a datatype moved to another opcode", func() {
+				t, err := opcodes.NewTable([]opcodes.Mapping{
+					{Direction: opcodes.Ingress, Opcode: 0x1234, Datatype: "SetPos"},
+					{Direction: opcodes.Egress, Opcode: 0x1234, Datatype: "EgressMovement"},
+					{Direction: opcodes.Egress, Opcode: datatypes.EgressMovementOpcode, Datatype: "EgressMovement"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				registry.SetTable(t)
+
+				Expect(registry.NewBlockData(opcodes.Zone, 0x1234, false)).To(BeAssignableToTypeOf(new(datatypes.SetPos)))
+				Expect(registry.NewBlockData(opcodes.Zone, datatypes.SetPosOpcode, false)).To(BeNil())
+				Expect(registry.NewBlockData(opcodes.Zone, datatypes.MovementOpcode, false)).To(BeAssignableToTypeOf(new(datatypes.Movement)))
+
+				By("keeping the compiled opcode if the table maps it as well")
+				Expect(registry.NewBlockData(opcodes.Zone, datatypes.EgressMovementOpcode, true)).To(BeAssignableToTypeOf(new(datatypes.EgressMovement)))
+			})
+
+			It("uses the compiled opcodes once the overrides are removed", func() {
+				registry.SetTable(nil)
+				Expect(registry.NewBlockData(opcodes.Zone, 0x1234, false)).To(BeNil())
+				Expect(registry.NewBlockData(opcodes.Zone, datatypes.MovementOpcode, false)).To(BeAssignableToTypeOf(new(datatypes.Movement)))
+			})
+		})
+	})
+
+	Describe("Report", func() {
+		movementMapping := models.OpcodeMapping{
+			Direction: models.OpcodeDirectionIngress,
+			Channel:   models.OpcodeChannelZone,
+			Opcode:    datatypes.MovementOpcode,
+			Datatype:  "Movement",
+			Source:    models.OpcodeSourceCompiled,
+		}
+
+		It("reports the compiled opcodes when there are no overrides", func() {
+			report := registry.Report()
+			Expect(report.File).To(BeNil())
+			Expect(report.LoadedAt).To(BeNil())
+			Expect(report.LastError).To(BeNil())
+			Expect(report.Mappings).To(ContainElement(movementMapping))
+			Expect(report.Mappings).ToNot(ContainElement(HaveField("Opcode", int(datatypes.UndefinedOpcode))))
+			Expect(report.Mappings).To(HaveEach(HaveField("Source", models.OpcodeSourceCompiled)))
+		})
+
+		It("reports the overrides in place of the compiled opcodes they shadow", func() {
+			t, err := opcodes.NewTable([]opcodes.Mapping{
+				{Direction: opcodes.Ingress, Opcode: datatypes.MovementOpcode, Datatype: opcodes.GenericDatatype},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			t.File = "opcodes.toml"
+			registry.SetTable(t)
+
+			report := registry.Report()
+			Expect(report.File).To(HaveValue(Equal("opcodes.toml")))
+			Expect(report.LoadedAt).To(HaveValue(Equal(t.LoadedAt)))
+			Expect(report.Mappings).ToNot(ContainElement(movementMapping))
+			Expect(report.Mappings).To(ContainElement(models.OpcodeMapping{
+				Direction: models.OpcodeDirectionIngress,
+				Channel:   models.OpcodeChannelZone,
+				Opcode:    datatypes.MovementOpcode,
+				Datatype:  opcodes.GenericDatatype,
+				Source:    models.OpcodeSourceOverride,
+			}))
+		})
+
+		It("does not report the compiled opcodes of datatypes moved to another opcode", func() {
+			t, err := opcodes.NewTable([]opcodes.Mapping{
+				{Direction: opcodes.Ingress, Opcode: 0x1234, Datatype: "Movement"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			registry.SetTable(t)
+
+			report := registry.Report()
+			Expect(report.Mappings).ToNot(ContainElement(movementMapping))
+			Expect(report.Mappings).To(ContainElement(HaveField("Opcode", 0x1234)))
+		})
+
+		It("reports the last error until a new table is set", func() {
+			registry.SetError(errors.New("Boom"))
+			Expect(registry.Report().LastError).To(HaveValue(Equal("Boom")))
+
+			registry.SetTable(nil)
+			Expect(registry.Report().LastError).To(BeNil())
+		})
+	})
+})
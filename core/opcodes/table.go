@@ -0,0 +1,160 @@
+package opcodes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+)
+
+// Direction is the direction of the network blocks that an opcode applies to
+type Direction string
+
+// Directions of network blocks
+const (
+	Ingress Direction = "ingress"
+	Egress  Direction = "egress"
+)
+
+// Channel is the game connection of the network blocks that an opcode applies
+// to
+type Channel string
+
+// Channels of network blocks
+const (
+	Zone Channel = "zone"
+	Chat Channel = "chat"
+)
+
+// Mapping maps an opcode to the name of an xivnet datatype for blocks in one
+// direction on one channel. The channel defaults to the zone channel.
+type Mapping struct {
+	Direction Direction `toml:"direction" json:"direction"`
+	Channel   Channel   `toml:"channel,omitempty" json:"channel,omitempty"`
+	Opcode    uint16    `toml:"opcode" json:"opcode"`
+	Datatype  string    `toml:"datatype" json:"datatype"`
+}
+
+// tableFile is the format of an opcode override file
+type tableFile struct {
+	Opcodes []Mapping `toml:"opcodes" json:"opcodes"`
+}
+
+type opcodeKey struct {
+	direction Direction
+	channel   Channel
+	opcode    uint16
+}
+
+// Table is a set of opcode mappings that override the opcodes compiled into
+// xivnet. Mapping an opcode to a datatype moves the datatype to that opcode:
+// the opcode compiled into xivnet for the datatype, in the same direction and
+// on the same channel, is left unparsed unless the Table maps it as well.
+type Table struct {
+	File     string
+	LoadedAt time.Time
+	Mappings []Mapping
+
+	// A nil factory leaves the block unparsed
+	overrides map[opcodeKey]func() xivnet.BlockData
+	// shadowed holds the compiled opcodes of the datatypes that were moved to
+	// another opcode
+	shadowed map[opcodeKey]struct{}
+}
+
+// LoadTable reads the opcode override file at the path. The file is decoded
+// as JSON if it has a .json extension, and as TOML otherwise.
+func LoadTable(path string) (*Table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f tableFile
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &f)
+	} else {
+		_, err = toml.Decode(string(data), &f)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := NewTable(f.Opcodes)
+	if err != nil {
+		return nil, err
+	}
+	t.File = path
+	return t, nil
+}
+
+// NewTable validates the mappings and returns a Table with them.
+func NewTable(mappings []Mapping) (*Table, error) {
+	t := &Table{
+		LoadedAt:  time.Now(),
+		Mappings:  make([]Mapping, len(mappings)),
+		overrides: make(map[opcodeKey]func() xivnet.BlockData),
+		shadowed:  make(map[opcodeKey]struct{}),
+	}
+	for i, m := range mappings {
+		if m.Channel == "" {
+			m.Channel = Zone
+		}
+		if m.Direction != Ingress && m.Direction != Egress {
+			return nil, fmt.Errorf("opcode mapping %d: unknown direction %q", i, m.Direction)
+		}
+		if m.Channel != Zone && m.Channel != Chat {
+			return nil, fmt.Errorf("opcode mapping %d: unknown channel %q", i, m.Channel)
+		}
+		var factory func() xivnet.BlockData
+		if m.Datatype != GenericDatatype {
+			var found bool
+			factory, found = datatypeFactories[m.Datatype]
+			if !found {
+				return nil, fmt.Errorf("opcode mapping %d: unknown datatype %q", i, m.Datatype)
+			}
+		}
+
+		key := opcodeKey{direction: m.Direction, channel: m.Channel, opcode: m.Opcode}
+		if _, found := t.overrides[key]; found {
+			return nil, fmt.Errorf("opcode mapping %d: duplicate mapping for %s %s opcode %#x", i, m.Direction, m.Channel, m.Opcode)
+		}
+		t.overrides[key] = factory
+		t.Mappings[i] = m
+	}
+
+	for _, m := range t.Mappings {
+		for _, d := range compiledDatatypes {
+			if d.name != m.Datatype || d.direction != m.Direction || d.channel != m.Channel ||
+				d.opcode == m.Opcode || d.opcode == datatypes.UndefinedOpcode {
+				continue
+			}
+			key := opcodeKey{direction: d.direction, channel: d.channel, opcode: d.opcode}
+			if _, found := t.overrides[key]; !found {
+				t.shadowed[key] = struct{}{}
+			}
+		}
+	}
+	return t, nil
+}
+
+// lookup returns the factory for the opcode and whether the opcode is
+// overridden by this table. Shadowed opcodes are overridden with a nil
+// factory.
+func (t *Table) lookup(direction Direction, channel Channel, opcode uint16) (func() xivnet.BlockData, bool) {
+	if t == nil {
+		return nil, false
+	}
+	key := opcodeKey{direction: direction, channel: channel, opcode: opcode}
+	if factory, found := t.overrides[key]; found {
+		return factory, true
+	}
+	_, shadowed := t.shadowed[key]
+	return nil, shadowed
+}
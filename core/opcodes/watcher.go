@@ -0,0 +1,136 @@
+package opcodes
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/hub"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// Watcher loads an opcode override file into a Registry. It runs as a long
+// running service that hot reloads the file in response to file changes.
+// If the file does not exist, the Registry falls back to the opcodes
+// compiled into xivnet.
+type Watcher struct {
+	file     string
+	registry *Registry
+	logger   *zap.Logger
+
+	eventBatcher *hub.EventBatcher
+
+	ready     chan struct{}
+	readyOnce sync.Once
+	stop      chan struct{}
+	stopDone  chan struct{}
+}
+
+// NewWatcher creates a new Watcher for the opcode override file.
+func NewWatcher(file string, registry *Registry, logger *zap.Logger) *Watcher {
+	return &Watcher{
+		file:     file,
+		registry: registry,
+		logger:   logger.Named("opcode-watcher"),
+
+		eventBatcher: hub.NewEventBatcher(20 * time.Millisecond),
+
+		ready:    make(chan struct{}),
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// Serve runs the main loop for the watcher. It reloads the opcode override
+// file in response to file changes. The watcher is ready once the file has
+// been loaded, even if the file cannot be watched for changes.
+func (w *Watcher) Serve() {
+	defer close(w.stopDone)
+
+	w.load()
+	defer w.markReady()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		w.logger.Error("Unable to setup FS watcher", zap.Error(err))
+		return
+	}
+	defer watcher.Close()
+
+	// The directory is watched instead of the file so that the file can be
+	// created, replaced, or removed while the watcher is running.
+	err = watcher.Add(filepath.Dir(w.file))
+	if err != nil {
+		w.logger.Error("Unable to setup FS watcher", zap.Error(err))
+		return
+	}
+
+	w.logger.Info("Running")
+	w.markReady()
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(w.file) {
+				break
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) != 0 {
+				w.eventBatcher.Notify()
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			w.logger.Error("FS watcher error", zap.Error(err))
+		case <-w.eventBatcher.BatchedEvents():
+			w.logger.Info("Detected opcode file change")
+			w.load()
+		case <-w.stop:
+			w.logger.Info("Stopping...")
+			return
+		}
+	}
+}
+
+// load reads the opcode override file into the registry. The previously
+// loaded table is kept if the file is invalid.
+func (w *Watcher) load() {
+	t, err := LoadTable(w.file)
+	if errors.Is(err, os.ErrNotExist) {
+		w.logger.Info("Opcode file not found, using compiled opcodes", zap.String("file", w.file))
+		w.registry.SetTable(nil)
+		return
+	}
+	if err != nil {
+		w.logger.Error("Unable to load opcode file", zap.Error(err))
+		w.registry.SetError(err)
+		return
+	}
+	w.registry.SetTable(t)
+	w.logger.Info("Successfully applied opcode overrides", zap.Int("numMappings", len(t.Mappings)))
+}
+
+// markReady unblocks WaitUntilReady. It is safe to call more than once.
+func (w *Watcher) markReady() {
+	w.readyOnce.Do(func() {
+		close(w.ready)
+	})
+}
+
+// WaitUntilReady blocks until the watcher is up and running, or until it has
+// given up on watching the opcode file
+func (w *Watcher) WaitUntilReady() {
+	<-w.ready
+}
+
+// Stop will shutdown this service and wait on it to stop before returning
+func (w *Watcher) Stop() {
+	close(w.stop)
+	<-w.stopDone
+}
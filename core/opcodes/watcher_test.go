@@ -0,0 +1,142 @@
+package opcodes_test
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ff14wed/aetherometer/core/opcodes"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watcher", func() {
+	const movementOverride = `
+[[opcodes]]
+direction = "ingress"
+opcode = 0x1234
+datatype = "Movement"
+`
+
+	var (
+		watcher  *opcodes.Watcher
+		registry *opcodes.Registry
+		file     string
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+
+		supervisor *suture.Supervisor
+	)
+
+	parsesOverride := func() bool {
+		return registry.NewBlockData(opcodes.Zone, 0x1234, false) != nil
+	}
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("opcodewatchertest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"opcodewatchertest://"}
+		logger, err := zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		file = filepath.Join(GinkgoT().TempDir(), "opcodes.toml")
+		Expect(os.WriteFile(file, []byte(movementOverride), 0644)).To(Succeed())
+
+		registry = opcodes.NewRegistry()
+		watcher = opcodes.NewWatcher(file, registry, logger)
+
+		supervisor = suture.New("test-opcode-watcher", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		supervisor.ServeBackground()
+		_ = supervisor.Add(watcher)
+		watcher.WaitUntilReady()
+	})
+
+	AfterEach(func() {
+		supervisor.Stop()
+	})
+
+	It(`logs "Running" on startup`, func() {
+		Eventually(logBuf).Should(gbytes.Say("opcode-watcher.*Running"))
+	})
+
+	It(`logs "Stopping..." on shutdown`, func() {
+		supervisor.Stop()
+		Eventually(logBuf).Should(gbytes.Say("opcode-watcher.*Stopping..."))
+	})
+
+	It("loads the opcode file on startup", func() {
+		Expect(parsesOverride()).To(BeTrue())
+		Expect(registry.Report().File).To(HaveValue(Equal(file)))
+	})
+
+	It("reloads the opcode file when it changes", func() {
+		Expect(os.WriteFile(file, []byte(`
+[[opcodes]]
+direction = "ingress"
+opcode = 0x5678
+datatype = "SetPos"
+`), 0644)).To(Succeed())
+
+		Eventually(parsesOverride).Should(BeFalse())
+		Expect(registry.NewBlockData(opcodes.Zone, 0x5678, false)).To(BeAssignableToTypeOf(new(datatypes.SetPos)))
+		Eventually(logBuf).Should(gbytes.Say("opcode-watcher.*Successfully applied opcode overrides"))
+	})
+
+	It("keeps the previous overrides when the opcode file is invalid", func() {
+		Expect(os.WriteFile(file, []byte(`
+[[opcodes]]
+direction = "ingress"
+opcode = 0x5678
+datatype = "Teleport"
+`), 0644)).To(Succeed())
+
+		Eventually(registry.Report).Should(HaveField("LastError", HaveValue(ContainSubstring(`unknown datatype "Teleport"`))))
+		Expect(parsesOverride()).To(BeTrue())
+		Eventually(logBuf).Should(gbytes.Say("ERROR.*opcode-watcher.*Unable to load opcode file"))
+	})
+
+	It("falls back to the compiled opcodes when the opcode file is removed", func() {
+		Expect(os.Remove(file)).To(Succeed())
+
+		Eventually(parsesOverride).Should(BeFalse())
+		Expect(registry.Report().File).To(BeNil())
+
+		Expect(os.WriteFile(file, []byte(movementOverride), 0644)).To(Succeed())
+		Eventually(parsesOverride).Should(BeTrue())
+	})
+
+	Context("when the directory of the opcode file cannot be watched", func() {
+		It("is ready once the opcode file has been loaded", func() {
+			w := opcodes.NewWatcher(filepath.Join(GinkgoT().TempDir(), "missing", "opcodes.toml"), registry, zap.NewNop())
+			go w.Serve()
+
+			ready := make(chan struct{})
+			go func() {
+				w.WaitUntilReady()
+				close(ready)
+			}()
+			Eventually(ready).Should(BeClosed())
+			Expect(registry.Report().File).To(BeNil())
+		})
+	})
+})
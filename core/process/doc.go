@@ -2,5 +2,8 @@
 
 Package process contains some utilities to help adapters keep track
 of processes that start or shut down.
+
+On Linux, ProcFS enumerates the processes by walking /proc, so that the
+Scanner can find the game even when it is running under Wine.
 */
 package process
@@ -0,0 +1,167 @@
+package process
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ff14wed/aetherometer/core/config"
+)
+
+// Matcher selects the processes that match a list of rules. A process is
+// selected if it matches any of the include rules and none of the exclude
+// rules.
+type Matcher struct {
+	rules []matchRule
+}
+
+type matchRule struct {
+	name    string
+	exclude bool
+	pattern func(name string) bool
+	parent  func(name string) bool
+	cmdline *regexp.Regexp
+}
+
+// NewMatcher compiles the match rules into a Matcher. Exact and glob patterns
+// are matched case insensitively, while regular expressions are matched as
+// written.
+func NewMatcher(rules []config.ProcessMatchRule) (*Matcher, error) {
+	m := new(Matcher)
+	for i, r := range rules {
+		rule := matchRule{name: r.Name, exclude: r.Exclude}
+		if rule.name == "" {
+			rule.name = r.Pattern
+		}
+		var err error
+		rule.pattern, err = compilePattern(r.Match, r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("process rule %d: %s", i, err)
+		}
+		if r.Parent != "" {
+			rule.parent, err = compilePattern(r.Match, r.Parent)
+			if err != nil {
+				return nil, fmt.Errorf("process rule %d: %s", i, err)
+			}
+		}
+		if r.Cmdline != "" {
+			rule.cmdline, err = regexp.Compile(r.Cmdline)
+			if err != nil {
+				return nil, fmt.Errorf("process rule %d: %s", i, err)
+			}
+		}
+		m.rules = append(m.rules, rule)
+	}
+	return m, nil
+}
+
+// NewSubstringMatcher returns a Matcher with a single rule that selects the
+// processes whose name contains the given string, ignoring case. The rule is
+// named after the string.
+func NewSubstringMatcher(match string) *Matcher {
+	lowerMatch := strings.ToLower(match)
+	return &Matcher{rules: []matchRule{{
+		name: match,
+		pattern: func(name string) bool {
+			return strings.Contains(strings.ToLower(name), lowerMatch)
+		},
+	}}}
+}
+
+func compilePattern(mode, pattern string) (func(name string) bool, error) {
+	switch mode {
+	case "", config.ProcessMatchExact:
+		return func(name string) bool {
+			return strings.EqualFold(name, pattern)
+		}, nil
+	case config.ProcessMatchGlob:
+		lowerPattern := strings.ToLower(pattern)
+		if _, err := filepath.Match(lowerPattern, ""); err != nil {
+			return nil, fmt.Errorf(`invalid glob "%s": %s`, pattern, err)
+		}
+		return func(name string) bool {
+			matched, _ := filepath.Match(lowerPattern, strings.ToLower(name))
+			return matched
+		}, nil
+	case config.ProcessMatchRegex:
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString, nil
+	}
+	return nil, fmt.Errorf(`unknown match "%s"`, mode)
+}
+
+// Match returns the name of the rule that selected each matching process,
+// keyed by process ID. The first include rule that matches a process is
+// reported.
+func (m *Matcher) Match(infos []ProcessInfo) map[uint32]string {
+	byPID := make(map[uint32]*ProcessInfo, len(infos))
+	for i := range infos {
+		byPID[infos[i].PID] = &infos[i]
+	}
+
+	matches := make(map[uint32]string)
+	for i := range infos {
+		if rule := m.matchingRule(&infos[i], byPID); rule != "" {
+			matches[infos[i].PID] = rule
+		}
+	}
+	return matches
+}
+
+// MatchingPIDs returns the IDs of the matching processes, in the order that
+// they were provided.
+func (m *Matcher) MatchingPIDs(infos []ProcessInfo) []uint32 {
+	matches := m.Match(infos)
+	var pids []uint32
+	for _, info := range infos {
+		if _, found := matches[info.PID]; found {
+			pids = append(pids, info.PID)
+		}
+	}
+	return pids
+}
+
+func (m *Matcher) matchingRule(info *ProcessInfo, byPID map[uint32]*ProcessInfo) string {
+	var included string
+	for _, rule := range m.rules {
+		if !rule.matches(info, byPID) {
+			continue
+		}
+		if rule.exclude {
+			return ""
+		}
+		if included == "" {
+			included = rule.name
+		}
+	}
+	return included
+}
+
+func (r *matchRule) matches(info *ProcessInfo, byPID map[uint32]*ProcessInfo) bool {
+	if !anyName(info.Names, r.pattern) {
+		return false
+	}
+	if r.parent != nil {
+		parent, found := byPID[info.PPID]
+		if !found || info.PPID == 0 || !anyName(parent.Names, r.parent) {
+			return false
+		}
+	}
+	if r.cmdline != nil && !r.cmdline.MatchString(info.Cmdline) {
+		return false
+	}
+	return true
+}
+
+func anyName(names []string, match func(name string) bool) bool {
+	for _, name := range names {
+		if match(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package process_test
+
+import (
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/process"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Matcher", func() {
+	infos := []process.ProcessInfo{
+		{PID: 1, Names: []string{"explorer.exe"}},
+		{PID: 2, PPID: 1, Names: []string{"ffxivboot.exe"}},
+		{PID: 3, PPID: 2, Names: []string{"FFXIV_DX11.exe"}, Cmdline: "ffxiv_dx11.exe DEV.TestSID=abc"},
+		{PID: 4, PPID: 2, Names: []string{"ffxiv_dx11_launcher_helper.exe"}},
+		{PID: 5, PPID: 1, Names: []string{"ffxiv_dx11.exe"}, Cmdline: "ffxiv_dx11.exe DEV.TestSID=def"},
+		{PID: 6, PPID: 99, Names: []string{"ffxiv.exe"}},
+	}
+
+	match := func(rules ...config.ProcessMatchRule) map[uint32]string {
+		m, err := process.NewMatcher(rules)
+		Expect(err).ToNot(HaveOccurred())
+		return m.Match(infos)
+	}
+
+	It("matches exact names case insensitively", func() {
+		Expect(match(config.ProcessMatchRule{Pattern: "ffxiv_dx11.exe"})).To(Equal(map[uint32]string{
+			3: "ffxiv_dx11.exe",
+			5: "ffxiv_dx11.exe",
+		}))
+	})
+
+	It("matches globs", func() {
+		Expect(match(config.ProcessMatchRule{
+			Name: "game", Match: config.ProcessMatchGlob, Pattern: "FFXIV*.exe",
+		})).To(Equal(map[uint32]string{
+			2: "game", 3: "game", 4: "game", 5: "game", 6: "game",
+		}))
+	})
+
+	It("matches regular expressions", func() {
+		Expect(match(config.ProcessMatchRule{
+			Name: "game", Match: config.ProcessMatchRegex, Pattern: `^(?i)ffxiv(_dx11)?\.exe$`,
+		})).To(Equal(map[uint32]string{
+			3: "game", 5: "game", 6: "game",
+		}))
+	})
+
+	It("ignores processes matched by an exclude rule", func() {
+		Expect(match(
+			config.ProcessMatchRule{Name: "game", Match: config.ProcessMatchGlob, Pattern: "ffxiv*.exe"},
+			config.ProcessMatchRule{Match: config.ProcessMatchGlob, Pattern: "*_helper.exe", Exclude: true},
+			config.ProcessMatchRule{Pattern: "ffxivboot.exe", Exclude: true},
+		)).To(Equal(map[uint32]string{
+			3: "game", 5: "game", 6: "game",
+		}))
+	})
+
+	It("filters processes by the name of their parent", func() {
+		Expect(match(config.ProcessMatchRule{
+			Name: "launched", Match: config.ProcessMatchGlob, Pattern: "ffxiv*.exe", Parent: "ffxivboot.exe",
+		})).To(Equal(map[uint32]string{
+			3: "launched", 4: "launched",
+		}))
+	})
+
+	It("filters processes by their command line", func() {
+		Expect(match(
+			config.ProcessMatchRule{Pattern: "ffxiv_dx11.exe"},
+			config.ProcessMatchRule{Pattern: "ffxiv_dx11.exe", Cmdline: "TestSID=def", Exclude: true},
+		)).To(Equal(map[uint32]string{3: "ffxiv_dx11.exe"}))
+	})
+
+	It("reports the first include rule that matched", func() {
+		Expect(match(
+			config.ProcessMatchRule{Name: "dx11", Pattern: "ffxiv_dx11.exe"},
+			config.ProcessMatchRule{Name: "any", Match: config.ProcessMatchGlob, Pattern: "ffxiv*.exe"},
+		)).To(Equal(map[uint32]string{
+			2: "any", 3: "dx11", 4: "any", 5: "dx11", 6: "any",
+		}))
+	})
+
+	It("returns an error for invalid rules", func() {
+		_, err := process.NewMatcher([]config.ProcessMatchRule{
+			{Pattern: "ffxiv.exe"},
+			{Match: config.ProcessMatchRegex, Pattern: "ffxiv("},
+		})
+		Expect(err).To(MatchError(ContainSubstring("process rule 1:")))
+
+		_, err = process.NewMatcher([]config.ProcessMatchRule{{Match: "fuzzy", Pattern: "ffxiv"}})
+		Expect(err).To(MatchError(`process rule 0: unknown match "fuzzy"`))
+	})
+
+	Describe("NewSubstringMatcher", func() {
+		It("matches names containing the string and reports it as the rule", func() {
+			Expect(process.NewSubstringMatcher("FFXIV_dx11").Match(infos)).To(Equal(map[uint32]string{
+				3: "FFXIV_dx11", 4: "FFXIV_dx11", 5: "FFXIV_dx11",
+			}))
+		})
+	})
+})
@@ -2,7 +2,6 @@ package process
 
 import (
 	"fmt"
-	"strings"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Enumerator
@@ -13,18 +12,51 @@ type Enumerator interface {
 	EnumerateProcesses() (map[uint32]string, error)
 }
 
-// ListMatchingProcesses lists all IDs for processes that match the given string
-func ListMatchingProcesses(match string, pe Enumerator) ([]uint32, error) {
+// ProcessInfo describes a running process by all of the names that it may
+// be matched against. PPID and Cmdline are left empty if the Enumerator does
+// not know them.
+type ProcessInfo struct {
+	PID     uint32
+	PPID    uint32
+	Names   []string
+	Cmdline string
+}
+
+// InfoEnumerator is an optional interface for Enumerators that know more
+// than one name for each process, such as its command line
+type InfoEnumerator interface {
+	EnumerateProcessInfo() ([]ProcessInfo, error)
+}
+
+// ListProcesses returns the information about every process on the system.
+// If the Enumerator is not an InfoEnumerator, each process is only described
+// by the name returned by EnumerateProcesses.
+func ListProcesses(pe Enumerator) ([]ProcessInfo, error) {
+	if ie, ok := pe.(InfoEnumerator); ok {
+		infos, err := ie.EnumerateProcessInfo()
+		if err != nil {
+			return nil, fmt.Errorf("EnumerateProcessInfo error: %s", err.Error())
+		}
+		return infos, nil
+	}
+
 	procMap, err := pe.EnumerateProcesses()
 	if err != nil {
 		return nil, fmt.Errorf("EnumerateProcesses error: %s", err.Error())
 	}
-
-	var pids []uint32
+	infos := make([]ProcessInfo, 0, len(procMap))
 	for pid, procName := range procMap {
-		if strings.Contains(strings.ToLower(procName), strings.ToLower(match)) {
-			pids = append(pids, pid)
-		}
+		infos = append(infos, ProcessInfo{PID: pid, Names: []string{procName}})
+	}
+	return infos, nil
+}
+
+// ListMatchingProcesses lists all IDs for processes whose name contains the
+// given string. A process matches if any of its names match.
+func ListMatchingProcesses(match string, pe Enumerator) ([]uint32, error) {
+	infos, err := ListProcesses(pe)
+	if err != nil {
+		return nil, err
 	}
-	return pids, nil
+	return NewSubstringMatcher(match).MatchingPIDs(infos), nil
 }
@@ -0,0 +1,168 @@
+package process
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultProcRoot is the mount point of procfs on Linux
+const DefaultProcRoot = "/proc"
+
+// wineLoaders lists the executables that Wine uses to run Windows programs.
+// The real name of a Windows program running under one of these loaders is
+// only visible from its command line.
+var wineLoaders = map[string]bool{
+	"wine":             true,
+	"wine64":           true,
+	"wine-preloader":   true,
+	"wine64-preloader": true,
+}
+
+// ProcFS enumerates the processes on Linux by walking procfs. Each process
+// can be matched against its comm, its full command line, and the name of
+// the Windows executable if the process is running under Wine.
+type ProcFS struct {
+	root string
+}
+
+// NewProcFS returns a new ProcFS that walks the procfs mounted at root. If
+// root is empty, DefaultProcRoot is used.
+func NewProcFS(root string) *ProcFS {
+	if root == "" {
+		root = DefaultProcRoot
+	}
+	return &ProcFS{root: root}
+}
+
+// EnumerateProcesses returns a map of process IDs to the most descriptive
+// name of each process, which is the name of the Windows executable for
+// processes running under Wine and the comm for all other processes.
+func (p *ProcFS) EnumerateProcesses() (map[uint32]string, error) {
+	infos, err := p.EnumerateProcessInfo()
+	if err != nil {
+		return nil, err
+	}
+	procMap := make(map[uint32]string, len(infos))
+	for _, info := range infos {
+		procMap[info.PID] = info.Names[0]
+	}
+	return procMap, nil
+}
+
+// EnumerateProcessInfo returns all of the names of every process. Processes
+// that exit while procfs is being walked are skipped.
+func (p *ProcFS) EnumerateProcessInfo() ([]ProcessInfo, error) {
+	entries, err := os.ReadDir(p.root)
+	if err != nil {
+		return nil, err
+	}
+	var infos []ProcessInfo
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pid, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		info := p.processInfo(filepath.Join(p.root, entry.Name()))
+		if len(info.Names) == 0 {
+			continue
+		}
+		info.PID = uint32(pid)
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// processInfo reads the names of the process from its procfs directory, with
+// the most descriptive name first, along with its parent and command line.
+// The command line is not one of the names since it is only matched by the
+// cmdline regex of a rule.
+func (p *ProcFS) processInfo(dir string) ProcessInfo {
+	var comm string
+	if data, err := os.ReadFile(filepath.Join(dir, "comm")); err == nil {
+		comm = strings.TrimSpace(string(data))
+	}
+	var args []string
+	if data, err := os.ReadFile(filepath.Join(dir, "cmdline")); err == nil {
+		for _, arg := range bytes.Split(bytes.TrimRight(data, "\x00"), []byte{0}) {
+			if len(arg) > 0 {
+				args = append(args, string(arg))
+			}
+		}
+	}
+	// Reading the exe link requires permission to trace the process, so it
+	// is fine for it to be missing
+	exe, _ := os.Readlink(filepath.Join(dir, "exe"))
+
+	var info ProcessInfo
+	if wineExe := wineExeName(comm, exe, args); wineExe != "" {
+		info.Names = append(info.Names, wineExe)
+	}
+	if comm != "" {
+		info.Names = append(info.Names, comm)
+	}
+	if len(args) > 0 {
+		info.Cmdline = strings.Join(args, " ")
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "stat")); err == nil {
+		info.PPID = parentPID(data)
+	}
+	return info
+}
+
+// parentPID parses the parent process ID out of the contents of the stat
+// file. The comm field is skipped by looking for its closing parenthesis
+// since it may contain spaces.
+func parentPID(stat []byte) uint32 {
+	end := bytes.LastIndexByte(stat, ')')
+	if end < 0 {
+		return 0
+	}
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 2 {
+		return 0
+	}
+	ppid, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(ppid)
+}
+
+// wineExeName returns the name of the Windows executable run by the process
+// if it is running under Wine, or an empty string otherwise
+func wineExeName(comm, exe string, args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	underLoader := wineLoaders[comm] || wineLoaders[filepath.Base(exe)]
+	program := args[0]
+	if wineLoaders[windowsBase(program)] {
+		// The loader was invoked directly with the Windows program as its
+		// first argument
+		if len(args) < 2 {
+			return ""
+		}
+		underLoader = true
+		program = args[1]
+	}
+	name := windowsBase(program)
+	if !underLoader && !strings.HasSuffix(strings.ToLower(name), ".exe") {
+		return ""
+	}
+	return name
+}
+
+// windowsBase returns the last element of a path that may use either
+// Windows or Unix separators
+func windowsBase(path string) string {
+	if i := strings.LastIndexAny(path, `\/`); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
@@ -0,0 +1,132 @@
+package process_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/process"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProcFS", func() {
+	var (
+		root   string
+		procFS *process.ProcFS
+	)
+
+	addProcess := func(pid, ppid, comm, exe string, args ...string) {
+		dir := filepath.Join(root, pid)
+		Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "comm"), []byte(comm+"\n"), 0644)).To(Succeed())
+		stat := fmt.Sprintf("%s (%s) S %s %s 0 0 -1\n", pid, comm, ppid, pid)
+		Expect(os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0644)).To(Succeed())
+		cmdline := strings.Join(args, "\x00") + "\x00"
+		Expect(os.WriteFile(filepath.Join(dir, "cmdline"), []byte(cmdline), 0644)).To(Succeed())
+		if exe != "" {
+			Expect(os.Symlink(exe, filepath.Join(dir, "exe"))).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		procFS = process.NewProcFS(root)
+
+		addProcess("1", "0", "systemd", "/usr/lib/systemd/systemd", "/sbin/init", "splash")
+		addProcess("200", "1", "ffxiv_dx11.exe", "/usr/bin/wine64-preloader",
+			`C:\Program Files (x86)\SquareEnix\FINAL FANTASY XIV\game\ffxiv_dx11.exe`, "DEV.TestSID=0")
+		addProcess("300", "1", "wine64-preloader", "",
+			"/usr/bin/wine64-preloader", `Z:\games\ffxiv\game\ffxiv_dx11.exe`)
+		addProcess("400", "1", "python3", "/usr/bin/python3.11", "python3", "hook_relay.py", "--port", "13346")
+
+		Expect(os.MkdirAll(filepath.Join(root, "self"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(root, "uptime"), []byte("1.0 1.0\n"), 0644)).To(Succeed())
+	})
+
+	Describe("EnumerateProcessInfo", func() {
+		It("returns the names of each process with the Wine executable first", func() {
+			infos, err := procFS.EnumerateProcessInfo()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infos).To(ConsistOf(
+				process.ProcessInfo{
+					PID:     1,
+					Names:   []string{"systemd"},
+					Cmdline: "/sbin/init splash",
+				},
+				process.ProcessInfo{
+					PID:     200,
+					PPID:    1,
+					Names:   []string{"ffxiv_dx11.exe", "ffxiv_dx11.exe"},
+					Cmdline: `C:\Program Files (x86)\SquareEnix\FINAL FANTASY XIV\game\ffxiv_dx11.exe DEV.TestSID=0`,
+				},
+				process.ProcessInfo{
+					PID:     300,
+					PPID:    1,
+					Names:   []string{"ffxiv_dx11.exe", "wine64-preloader"},
+					Cmdline: `/usr/bin/wine64-preloader Z:\games\ffxiv\game\ffxiv_dx11.exe`,
+				},
+				process.ProcessInfo{
+					PID:     400,
+					PPID:    1,
+					Names:   []string{"python3"},
+					Cmdline: "python3 hook_relay.py --port 13346",
+				},
+			))
+		})
+
+		It("skips processes that exit while procfs is being walked", func() {
+			Expect(os.MkdirAll(filepath.Join(root, "500"), 0755)).To(Succeed())
+			infos, err := procFS.EnumerateProcessInfo()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infos).To(HaveLen(4))
+		})
+
+		It("returns an error if the root does not exist", func() {
+			_, err := process.NewProcFS(filepath.Join(root, "missing")).EnumerateProcessInfo()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("EnumerateProcesses", func() {
+		It("returns the most descriptive name of each process", func() {
+			procMap, err := procFS.EnumerateProcesses()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(procMap).To(Equal(map[uint32]string{
+				1:   "systemd",
+				200: "ffxiv_dx11.exe",
+				300: "ffxiv_dx11.exe",
+				400: "python3",
+			}))
+		})
+	})
+
+	Describe("ListMatchingProcesses", func() {
+		It("matches the Wine executable name", func() {
+			Expect(process.ListMatchingProcesses("FFXIV_DX11", procFS)).To(ConsistOf(uint32(200), uint32(300)))
+		})
+
+		It("matches the comm", func() {
+			Expect(process.ListMatchingProcesses("systemd", procFS)).To(ConsistOf(uint32(1)))
+		})
+
+		It("does not match the command line", func() {
+			Expect(process.ListMatchingProcesses("hook_relay", procFS)).To(BeEmpty())
+			Expect(process.ListMatchingProcesses("TestSID", procFS)).To(BeEmpty())
+		})
+	})
+
+	Describe("Matcher", func() {
+		It("matches the command line through the cmdline regex of a rule", func() {
+			matcher, err := process.NewMatcher([]config.ProcessMatchRule{
+				{Match: config.ProcessMatchGlob, Pattern: "*", Cmdline: `TestSID=\d`},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			infos, err := procFS.EnumerateProcessInfo()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matcher.MatchingPIDs(infos)).To(ConsistOf(uint32(200)))
+		})
+	})
+})
@@ -1,66 +1,85 @@
 package process
 
 import (
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
 )
 
-// Scanner polls the system for all running process names that match a given
-// string and emits events to notify of any changes in this list.
+// AddEvent notifies of a new process that matched one of the rules of the
+// Scanner's Matcher
+type AddEvent struct {
+	PID  uint32
+	Rule string
+}
+
+// Scanner polls the system for all running processes that are selected by a
+// Matcher and emits events to notify of any changes in this list.
 type Scanner struct {
-	processName string
-	scanTicker  <-chan time.Time
-	pe          Enumerator
-	logger      *zap.Logger
+	matcher    *Matcher
+	autoAttach bool
+	scanTicker <-chan time.Time
+	pe         Enumerator
+	logger     *zap.Logger
 
-	addProcEventChan chan uint32
+	addProcEventChan chan AddEvent
 	remProcEventChan chan uint32
 
-	procCache map[uint32]struct{}
+	procCache     map[uint32]string
+	procCacheLock sync.Mutex
 
 	stop chan struct{}
 }
 
 // NewScanner creates a new process scanner.
-// - processName is a substring match query for the process name
+// - matcher selects the processes that the Scanner reports
 // - scanTicker provides a mechanism for the Scanner to iterate on a given
 //   interval
 // - pe provides the Scanner with an API for enumerating processes
 // - eventBufSize determines the size of this event channel
 // - logger provides the scanner with a logger
 func NewScanner(
-	processName string,
+	matcher *Matcher,
 	scanTicker <-chan time.Time,
 	pe Enumerator,
 	eventBufSize int,
 	logger *zap.Logger,
 ) *Scanner {
 	return &Scanner{
-		processName: processName,
-		scanTicker:  scanTicker,
-		pe:          pe,
-		logger:      logger.Named("scanner"),
+		matcher:    matcher,
+		autoAttach: true,
+		scanTicker: scanTicker,
+		pe:         pe,
+		logger:     logger.Named("scanner"),
 
-		addProcEventChan: make(chan uint32, eventBufSize),
+		addProcEventChan: make(chan AddEvent, eventBufSize),
 		remProcEventChan: make(chan uint32, eventBufSize),
 
-		procCache: make(map[uint32]struct{}),
+		procCache: make(map[uint32]string),
 
 		stop: make(chan struct{}),
 	}
 }
 
+// DisableAutoAttach stops the Scanner from emitting add events for new
+// matching processes, so that they are only listed by Candidates. Remove
+// events are still emitted when matching processes exit. It must be called
+// before the Scanner is running.
+func (s *Scanner) DisableAutoAttach() {
+	s.autoAttach = false
+}
+
 // Serve is responsible for running the process scanner
 func (s *Scanner) Serve() {
 	s.logger.Info("Running")
 	for {
 		// Tick first then wait on the ticker
-		pidList, err := ListMatchingProcesses(s.processName, s.pe)
+		infos, err := ListProcesses(s.pe)
 		if err != nil {
 			s.logger.Error("Nonfatal error", zap.Error(err))
 		}
-		s.updatePIDs(pidList)
+		s.updatePIDs(s.matcher.Match(infos))
 		select {
 		case <-s.stop:
 			s.logger.Info("Stopping...")
@@ -76,26 +95,44 @@ func (s *Scanner) Stop() {
 	close(s.stop)
 }
 
-func (s *Scanner) updatePIDs(pidList []uint32) {
-	newProcCache := make(map[uint32]struct{})
-	for _, pid := range pidList {
-		newProcCache[pid] = struct{}{}
-		if _, ok := s.procCache[pid]; !ok {
-			s.addProcEventChan <- pid
+func (s *Scanner) updatePIDs(matches map[uint32]string) {
+	s.procCacheLock.Lock()
+	procCache := s.procCache
+	s.procCache = matches
+	s.procCacheLock.Unlock()
+
+	for pid, rule := range matches {
+		if _, ok := procCache[pid]; ok {
+			continue
+		}
+		s.logger.Info("Found matching process", zap.Uint32("pid", pid), zap.String("rule", rule))
+		if s.autoAttach {
+			s.addProcEventChan <- AddEvent{PID: pid, Rule: rule}
 		}
 	}
-	for pid := range s.procCache {
-		if _, ok := newProcCache[pid]; !ok {
+	for pid := range procCache {
+		if _, ok := matches[pid]; !ok {
 			s.remProcEventChan <- pid
 		}
 	}
-	s.procCache = newProcCache
+}
+
+// Candidates returns the processes that matched during the most recent scan,
+// along with the name of the rule that matched each of them.
+func (s *Scanner) Candidates() map[uint32]string {
+	s.procCacheLock.Lock()
+	defer s.procCacheLock.Unlock()
+	candidates := make(map[uint32]string, len(s.procCache))
+	for pid, rule := range s.procCache {
+		candidates[pid] = rule
+	}
+	return candidates
 }
 
 // ProcessAddEventListener returns a channel on which subscribers can listen
 // for new process events. Scanner does not broadcast events to all subscribers;
 // only one will be notified of a given change.
-func (s *Scanner) ProcessAddEventListener() <-chan uint32 {
+func (s *Scanner) ProcessAddEventListener() <-chan AddEvent {
 	return s.addProcEventChan
 }
 
@@ -3,6 +3,7 @@ package process_test
 import (
 	"time"
 
+	"github.com/ff14wed/aetherometer/core/config"
 	"github.com/ff14wed/aetherometer/core/process"
 	"github.com/ff14wed/aetherometer/core/process/processfakes"
 	"github.com/thejerf/suture"
@@ -37,7 +38,7 @@ var _ = Describe("Scanner", func() {
 		logger, err := zapCfg.Build()
 		Expect(err).ToNot(HaveOccurred())
 
-		scanner = process.NewScanner("foo", ticker, pp, 10, logger)
+		scanner = process.NewScanner(process.NewSubstringMatcher("foo"), ticker, pp, 10, logger)
 
 		supervisor = suture.New("test-scanner", suture.Spec{
 			Log: func(line string) {
@@ -54,12 +55,14 @@ var _ = Describe("Scanner", func() {
 	})
 
 	It("notifies clients of all running PIDs that match the string on startup", func() {
-		var pid1, pid2, pid3 uint32
-		Eventually(scanner.ProcessAddEventListener()).Should(Receive(&pid1))
-		Eventually(scanner.ProcessAddEventListener()).Should(Receive(&pid2))
-		Eventually(scanner.ProcessAddEventListener()).Should(Receive(&pid3))
-		Expect([]uint32{pid1, pid2, pid3}).To(ConsistOf(
-			uint32(1), uint32(2), uint32(3),
+		var e1, e2, e3 process.AddEvent
+		Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e1))
+		Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e2))
+		Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e3))
+		Expect([]process.AddEvent{e1, e2, e3}).To(ConsistOf(
+			process.AddEvent{PID: 1, Rule: "foo"},
+			process.AddEvent{PID: 2, Rule: "foo"},
+			process.AddEvent{PID: 3, Rule: "foo"},
 		))
 	})
 
@@ -80,9 +83,9 @@ var _ = Describe("Scanner", func() {
 				}, nil
 			}
 			ticker <- time.Now()
-			var pid uint32
-			Eventually(scanner.ProcessAddEventListener()).Should(Receive(&pid))
-			Expect(pid).To(Equal(uint32(4)))
+			var e process.AddEvent
+			Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e))
+			Expect(e.PID).To(Equal(uint32(4)))
 		})
 
 		It("sends no notifications if nothing has changed", func() {
@@ -113,11 +116,42 @@ var _ = Describe("Scanner", func() {
 				}, nil
 			}
 			ticker <- time.Now()
+			var e process.AddEvent
+			Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e))
+			Expect(e.PID).To(Equal(uint32(4)))
 			var pid uint32
-			Eventually(scanner.ProcessAddEventListener()).Should(Receive(&pid))
-			Expect(pid).To(Equal(uint32(4)))
 			Eventually(scanner.ProcessRemoveEventListener()).Should(Receive(&pid))
 			Expect(pid).To(Equal(uint32(3)))
 		})
 	})
+
+	Context("when the matcher has several rules", func() {
+		BeforeEach(func() {
+			supervisor.Stop()
+			ticker = make(chan time.Time)
+
+			matcher, err := process.NewMatcher([]config.ProcessMatchRule{
+				{Name: "dx11", Pattern: "fooa.exe"},
+				{Name: "others", Match: config.ProcessMatchGlob, Pattern: "foo*.exe"},
+				{Pattern: "foob.exe", Exclude: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			scanner = process.NewScanner(matcher, ticker, pp, 10, zap.NewNop())
+			supervisor = suture.New("test-scanner", suture.Spec{FailureThreshold: 1})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(scanner)
+		})
+
+		It("reports the rule that matched each process", func() {
+			var e1, e2 process.AddEvent
+			Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e1))
+			Eventually(scanner.ProcessAddEventListener()).Should(Receive(&e2))
+			Expect([]process.AddEvent{e1, e2}).To(ConsistOf(
+				process.AddEvent{PID: 1, Rule: "dx11"},
+				process.AddEvent{PID: 3, Rule: "others"},
+			))
+			Consistently(scanner.ProcessAddEventListener()).ShouldNot(Receive())
+		})
+	})
 })
@@ -0,0 +1,166 @@
+// +build ignore
+
+// This program generates the table of the datatypes compiled into xivnet for
+// the opcodes package. The registries of the xivnet datatypes package are not
+// exported, so they are read from its source instead.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	datatypesPkg = "github.com/ff14wed/xivnet/v3/datatypes"
+	outputFile   = "compiled_datatypes.go"
+)
+
+var registerFunc = regexp.MustCompile(`^register(In|Out)(Chat)?BlockFactory$`)
+
+type entry struct {
+	name      string
+	direction string
+	channel   string
+	opcode    string
+}
+
+func main() {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", datatypesPkg).Output()
+	if err != nil {
+		log.Fatalln("cannot find the xivnet datatypes package:", err)
+	}
+	dir := strings.TrimSpace(string(out))
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	sort.Strings(paths)
+
+	var entries []entry
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if e, ok := registration(n); ok {
+				entries = append(entries, e)
+			}
+			return true
+		})
+	}
+	if len(entries) == 0 {
+		log.Fatalln("no datatypes found in", dir)
+	}
+
+	// Zone datatypes are listed before chat datatypes, and ingress datatypes
+	// before egress datatypes
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].channel != entries[j].channel {
+			return entries[i].channel == "Zone"
+		}
+		return entries[i].direction == "Ingress" && entries[j].direction == "Egress"
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by scripts/datatypesgen.go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package opcodes")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	fmt.Fprintln(&buf, `	"github.com/ff14wed/xivnet/v3"`)
+	fmt.Fprintln(&buf, `	"github.com/ff14wed/xivnet/v3/datatypes"`)
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// compiledDatatypes lists the datatypes registered by xivnet along with the")
+	fmt.Fprintln(&buf, "// opcodes compiled into it.")
+	fmt.Fprintln(&buf, "var compiledDatatypes = []datatype{")
+	for _, e := range entries {
+		fmt.Fprintf(&buf, "\t{%q, %s, %s, datatypes.%s, func() xivnet.BlockData { return new(datatypes.%s) }},\n",
+			e.name, e.direction, e.channel, e.opcode, e.name)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := os.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// registration returns the datatype registered by a call such as
+// registerInBlockFactory(FooOpcode, func() xivnet.BlockData { return new(Foo) })
+func registration(n ast.Node) (entry, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return entry{}, false
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return entry{}, false
+	}
+	m := registerFunc.FindStringSubmatch(fun.Name)
+	if m == nil {
+		return entry{}, false
+	}
+	opcode, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		log.Fatalf("%s: unexpected opcode argument", fun.Name)
+	}
+	name := returnedType(call.Args[1])
+	if name == "" {
+		log.Fatalf("%s(%s): unexpected factory argument", fun.Name, opcode.Name)
+	}
+
+	e := entry{name: name, direction: "Ingress", channel: "Zone", opcode: opcode.Name}
+	if m[1] == "Out" {
+		e.direction = "Egress"
+	}
+	if m[2] != "" {
+		e.channel = "Chat"
+	}
+	return e, true
+}
+
+// returnedType returns the name of the type T of a factory of the form
+// func() xivnet.BlockData { return new(T) }
+func returnedType(expr ast.Expr) string {
+	lit, ok := expr.(*ast.FuncLit)
+	if !ok || len(lit.Body.List) != 1 {
+		return ""
+	}
+	ret, ok := lit.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return ""
+	}
+	call, ok := ret.Results[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return ""
+	}
+	if fun, ok := call.Fun.(*ast.Ident); !ok || fun.Name != "new" {
+		return ""
+	}
+	t, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return t.Name
+}
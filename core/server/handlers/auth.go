@@ -262,6 +262,24 @@ func (a *Auth) AuthorizePluginToken(ctx context.Context) error {
 	return nil
 }
 
+// AuthorizeAdmin checks to make sure that the request is authorized to make
+// admin-level requests, such as controlling which processes are attached.
+// Only the local token is authorized, since plugin tokens are handed out to
+// third-party plugins.
+func (a *Auth) AuthorizeAdmin(ctx context.Context) error {
+	a.authConfigLock.RLock()
+	defer a.authConfigLock.RUnlock()
+
+	if a.authConfig.disableAuth {
+		return nil
+	}
+
+	if a.isValidLocalTokenAuth(ctx) {
+		return nil
+	}
+	return ErrAuth
+}
+
 func (a *Auth) isValidLocalTokenAuth(ctx context.Context) bool {
 	localToken := a.authConfig.localToken
 	if localToken == "" {
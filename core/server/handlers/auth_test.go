@@ -263,6 +263,63 @@ var _ = Describe("Auth", func() {
 		})
 	})
 
+	Describe("AuthorizeAdmin", func() {
+		localRequestCtx := func(origin, token string) context.Context {
+			req, err := http.NewRequest("POST", "/foo", nil)
+			Expect(err).ToNot(HaveOccurred())
+			if origin != "" {
+				req.Header.Set("Origin", origin)
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			var receivedCtx context.Context
+			authHandler := auth.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				receivedCtx = r.Context()
+			}))
+			authHandler.ServeHTTP(httptest.NewRecorder(), req)
+			Expect(receivedCtx).ToNot(BeNil())
+			return receivedCtx
+		}
+
+		BeforeEach(func() {
+			Expect(cp.MutateConfig(func(cfg config.Config) (config.Config, error) {
+				cfg.LocalToken = "some-local-token"
+				return cfg, nil
+			})).To(Succeed())
+			Expect(cp.AddPlugin("Foo Plugin", "https://example.com/foo/plugin")).To(Succeed())
+			Expect(auth.RefreshConfig()).To(Succeed())
+		})
+
+		It("authorizes local requests with the local token", func() {
+			Expect(auth.AuthorizeAdmin(localRequestCtx("app://bar", "some-local-token"))).To(Succeed())
+		})
+
+		It("rejects non-local requests with the local token", func() {
+			Expect(auth.AuthorizeAdmin(localRequestCtx("", "some-local-token"))).To(MatchError(handlers.ErrAuth))
+		})
+
+		It("rejects plugin tokens", func() {
+			pluginInfo := auth.GetRegisteredPlugins()["Foo Plugin"]
+			ctx := handlers.ContextWithToken(pluginInfo.APIToken)
+			Expect(auth.AuthorizePluginToken(ctx)).To(Succeed())
+			Expect(auth.AuthorizeAdmin(ctx)).To(MatchError(handlers.ErrAuth))
+		})
+
+		Context("when auth is disabled", func() {
+			BeforeEach(func() {
+				Expect(cp.MutateConfig(func(cfg config.Config) (config.Config, error) {
+					cfg.DisableAuth = true
+					return cfg, nil
+				})).To(Succeed())
+				Expect(auth.RefreshConfig()).To(Succeed())
+			})
+
+			It("authorizes requests with no token", func() {
+				Expect(auth.AuthorizeAdmin(context.Background())).To(Succeed())
+			})
+		})
+	})
+
 	Describe("Handler", func() {
 		It("adds the auth token to the request context", func() {
 			req, err := http.NewRequest("POST", "/foo", nil)
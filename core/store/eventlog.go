@@ -0,0 +1,49 @@
+package store
+
+import (
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"go.uber.org/zap"
+)
+
+// EventBatch holds copies of the events emitted by an update, along with the
+// time the update was applied to the store. The copies do not share any data
+// with the store, so they can be read safely on any goroutine.
+type EventBatch struct {
+	Time         time.Time
+	StreamEvents []models.StreamEvent
+	EntityEvents []models.EntityEvent
+}
+
+// logEvents sends copies of the events emitted by an update to the event log.
+// The batch is dropped if the event log is not ready to accept it, so that a
+// slow event log never holds up the updates to the store. A warning is logged
+// when the event log starts falling behind.
+func (p *Provider) logEvents(t time.Time, streamEvents []models.StreamEvent, entityEvents []models.EntityEvent) {
+	if len(streamEvents) == 0 && len(entityEvents) == 0 {
+		return
+	}
+	batch := EventBatch{Time: t}
+	for _, e := range streamEvents {
+		batch.StreamEvents = append(batch.StreamEvents, e.Clone())
+	}
+	for _, e := range entityEvents {
+		batch.EntityEvents = append(batch.EntityEvents, e.Clone())
+	}
+
+	select {
+	case p.eventLog <- batch:
+		p.droppingEvents = false
+	default:
+		dropped := len(streamEvents) + len(entityEvents)
+		p.droppedEvents += dropped
+		if !p.droppingEvents {
+			p.droppingEvents = true
+			p.logger.Warn("Event log is not keeping up, dropped events",
+				zap.Int("dropped", dropped),
+				zap.Int("totalDropped", p.droppedEvents),
+			)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// history keeps the recent states of a single stream as periodic keyframes,
+// each followed by the events emitted for the stream until the next
+// keyframe. The state of the stream at any time within the history is
+// reconstructed by applying the events that happened before that time to the
+// keyframe preceding it.
+//
+// Events are recorded rather than updates, since updates are not guaranteed
+// to leave the stream untouched when they are applied a second time. Events
+// that add a stream are recorded as keyframes instead, since they refer to
+// the stream in the store rather than a copy of it.
+type history struct {
+	keyframes []keyframe
+}
+
+type keyframe struct {
+	time   time.Time
+	stream *models.Stream
+	deltas []delta
+}
+
+type delta struct {
+	time        time.Time
+	streamEvent *models.StreamEvent
+	entityEvent *models.EntityEvent
+}
+
+// addKeyframe records a copy of the stream as the state of the stream at t. A
+// nil stream records that the stream does not exist at t.
+func (h *history) addKeyframe(t time.Time, stream *models.Stream) {
+	kf := keyframe{time: t}
+	if stream != nil {
+		streamClone := stream.Clone()
+		kf.stream = &streamClone
+	}
+	h.keyframes = append(h.keyframes, kf)
+}
+
+// addDelta records an event emitted for the stream. Events are dropped if
+// there is no keyframe to apply them to.
+func (h *history) addDelta(d delta) {
+	if len(h.keyframes) == 0 {
+		return
+	}
+	last := &h.keyframes[len(h.keyframes)-1]
+	last.deltas = append(last.deltas, d)
+}
+
+// prune drops the keyframes that are no longer needed to reconstruct the
+// state of the stream at or after horizon. It returns false if the stream
+// was removed and has not changed since horizon, in which case the history
+// can be discarded.
+func (h *history) prune(horizon time.Time, removed bool) bool {
+	if len(h.keyframes) == 0 {
+		return false
+	}
+	i := 0
+	for i+1 < len(h.keyframes) && !h.keyframes[i+1].time.After(horizon) {
+		i++
+	}
+	h.keyframes = h.keyframes[i:]
+	if !removed {
+		return true
+	}
+	last := h.keyframes[len(h.keyframes)-1]
+	lastChange := last.time
+	if len(last.deltas) > 0 {
+		lastChange = last.deltas[len(last.deltas)-1].time
+	}
+	return lastChange.After(horizon)
+}
+
+// at reconstructs the state of the stream at t. It returns nil if the stream
+// did not exist at t, and an error if t is before the start of the history.
+func (h *history) at(t time.Time) (*models.Stream, error) {
+	idx := -1
+	for i, kf := range h.keyframes {
+		if kf.time.After(t) {
+			break
+		}
+		idx = i
+	}
+	if idx < 0 {
+		return nil, fmt.Errorf("no history before %s", t.Format(time.RFC3339Nano))
+	}
+	kf := h.keyframes[idx]
+
+	var stream *models.Stream
+	if kf.stream != nil {
+		streamClone := kf.stream.Clone()
+		stream = &streamClone
+	}
+	for _, d := range kf.deltas {
+		if d.time.After(t) {
+			break
+		}
+		if d.streamEvent != nil {
+			stream = applyStreamEvent(stream, d.streamEvent.Type)
+		}
+		if d.entityEvent != nil && stream != nil {
+			applyEntityEvent(stream, d.entityEvent.EntityID, d.entityEvent.Type)
+		}
+	}
+	return stream, nil
+}
+
+// applyStreamEvent applies the change described by the event to the stream.
+// It returns the resulting stream, which is nil if the stream was removed.
+func applyStreamEvent(s *models.Stream, event models.StreamEventType) *models.Stream {
+	if s == nil {
+		return nil
+	}
+	switch e := event.(type) {
+	case models.RemoveStream:
+		return nil
+	case models.UpdateIDs:
+		s.ServerID = e.ServerID
+		s.InstanceNum = e.InstanceNum
+		s.CharacterID = e.CharacterID
+		if e.HomeWorld != nil {
+			s.HomeWorld = *e.HomeWorld
+		}
+		if e.CurrentWorld != nil {
+			s.CurrentWorld = *e.CurrentWorld
+		}
+	case models.UpdateMap:
+		s.Place = e.Place.Clone()
+	case models.UpdateCraftingInfo:
+		s.CraftingInfo = nil
+		if e.CraftingInfo != nil {
+			craftingInfoClone := *e.CraftingInfo
+			s.CraftingInfo = &craftingInfoClone
+		}
+	case models.UpdateEnmity:
+		s.Enmity = e.Enmity.Clone()
+	case models.UpdateStats:
+		statsClone := *e.Stats
+		s.Stats = &statsClone
+	case models.UpdateStreamStatus:
+		statusClone := *e.Status
+		s.Status = &statusClone
+	}
+	return s
+}
+
+// applyEntityEvent applies the change described by the event to the entity
+// identified by entityID in the stream.
+func applyEntityEvent(s *models.Stream, entityID uint64, event models.EntityEventType) {
+	switch e := event.(type) {
+	case models.AddEntity:
+		entityClone := e.Entity.Clone()
+		s.EntitiesMap[entityID] = &entityClone
+		return
+	case models.RemoveEntity:
+		s.DepartEntity(e.ID, e.Reason, e.Time)
+		return
+	case models.SetEntities:
+		s.EntitiesMap = make(map[uint64]*models.Entity)
+		for _, entity := range e.Entities {
+			entityClone := entity.Clone()
+			s.EntitiesMap[entity.ID] = &entityClone
+		}
+		return
+	}
+
+	entity := s.EntitiesMap[entityID]
+	if entity == nil {
+		return
+	}
+	switch e := event.(type) {
+	case models.UpdateTarget:
+		entity.TargetID = e.TargetID
+	case models.UpdateClass:
+		classJobClone := *e.ClassJob
+		entity.ClassJob = &classJobClone
+		entity.Level = e.Level
+	case models.UpdateLastAction:
+		actionClone := e.Action.Clone()
+		entity.LastAction = &actionClone
+	case models.UpdateCastingInfo:
+		entity.CastingInfo = nil
+		if e.CastingInfo != nil {
+			castingInfoClone := *e.CastingInfo
+			entity.CastingInfo = &castingInfoClone
+		}
+	case models.UpsertStatus:
+		if len(entity.Statuses) <= e.Index {
+			diff := e.Index + 1 - len(entity.Statuses)
+			entity.Statuses = append(entity.Statuses, make([]*models.Status, diff)...)
+		}
+		statusClone := *e.Status
+		entity.Statuses[e.Index] = &statusClone
+	case models.RemoveStatus:
+		if e.Index < len(entity.Statuses) {
+			entity.Statuses[e.Index] = nil
+		}
+	case models.UpdateLocation:
+		locationClone := *e.Location
+		entity.Location = &locationClone
+	case models.UpdateResources:
+		resourcesClone := *e.Resources
+		entity.Resources = &resourcesClone
+	case models.UpdateLockonMarker:
+		entity.LockonMarker = e.LockonMarker
+	}
+}
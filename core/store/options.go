@@ -7,16 +7,21 @@ type providerConfig struct {
 	updateBufferSize  int
 	eventBufferSize   int
 	requestBufferSize int
+	snapshotFile      string
+	snapshotInterval  time.Duration
+	historyDuration   time.Duration
+	keyframeInterval  time.Duration
+	eventLog          chan<- EventBatch
 }
 
 // Option defines an optional configuration parameter to the constructor of the
 // Provider
 type Option func(p *providerConfig)
 
-// WithQueryTimeout sets the timeout for read accesses to the store provider.
-// Since all store acceses happen on the same goroutine, it is possible for
-// misbehaving updates to block reads. The query methods on the provider will
-// timeout after this duration if this scenario happens.
+// WithQueryTimeout sets the timeout for history queries to the store provider.
+// Since history queries are served on the same goroutine as updates, it is
+// possible for misbehaving updates to block them. The history query methods
+// on the provider will timeout after this duration if this scenario happens.
 //
 // The default value is 5 seconds.
 func WithQueryTimeout(t time.Duration) Option {
@@ -57,3 +62,44 @@ func WithRequestBufferSize(size int) Option {
 		p.requestBufferSize = size
 	}
 }
+
+// WithSnapshot makes the store provider restore the streams saved in the
+// snapshot file at path when it is created, and save the streams to the file
+// on the given interval and when it stops. Restored streams are kept aside
+// until a live stream of the same source and character shows up.
+//
+// If interval is not positive, the streams are saved every minute. By
+// default, the streams are not saved.
+func WithSnapshot(path string, interval time.Duration) Option {
+	return func(p *providerConfig) {
+		p.snapshotFile = path
+		p.snapshotInterval = interval
+	}
+}
+
+// WithHistory makes the store provider keep the states of each stream over
+// the given duration, so that the state of a stream at a past time can be
+// queried. A copy of each stream is kept on the given keyframe interval, so
+// shorter intervals make queries faster at the cost of memory.
+//
+// If keyframeInterval is not positive, a copy of each stream is kept every 5
+// seconds. By default, no history is kept.
+func WithHistory(duration time.Duration, keyframeInterval time.Duration) Option {
+	return func(p *providerConfig) {
+		p.historyDuration = duration
+		p.keyframeInterval = keyframeInterval
+	}
+}
+
+// WithEventLog makes the store provider send copies of the events emitted by
+// every update to the events channel, in the order the updates are applied.
+// The provider never waits for the channel, so it should be buffered. Batches
+// that the channel is not ready to accept are dropped and counted, and a
+// warning is logged whenever the channel starts falling behind.
+//
+// By default, the events are not sent anywhere else than the event sources.
+func WithEventLog(events chan<- EventBatch) Option {
+	return func(p *providerConfig) {
+		p.eventLog = events
+	}
+}
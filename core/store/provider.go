@@ -1,7 +1,9 @@
 package store
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/ff14wed/aetherometer/core/hub"
@@ -10,15 +12,26 @@ import (
 )
 
 // Provider provides access to the store. It runs as a long running service
-// that updates the store in response to update events. All updates and
-// accesses are handled in an evented loop to serialize changes to the store
-// for thread safety.
+// that updates the store in response to update events. All updates are
+// handled in an evented loop to serialize changes to the store for thread
+// safety. After each batch of updates, the loop publishes an immutable view
+// of the store, so that reads never wait on the loop.
 // Provider also emits events for updates made to the store.
 type Provider struct {
-	queryTimeout time.Duration
-	logger       *zap.Logger
+	queryTimeout     time.Duration
+	snapshotFile     string
+	snapshotInterval time.Duration
+	historyDuration  time.Duration
+	keyframeInterval time.Duration
+	eventLog         chan<- EventBatch
+	droppedEvents    int
+	droppingEvents   bool
+	logger           *zap.Logger
 
 	streams   Streams
+	changes   changes
+	view      atomic.Value
+	histories map[int]*history
 	streamHub *hub.NotifyHub[*models.StreamEvent]
 	entityHub *hub.NotifyHub[*models.EntityEvent]
 
@@ -38,6 +51,9 @@ type Provider struct {
 // 		store.WithUpdateBufferSize(10),
 // 		store.WithEventBufferSize(10),
 // 		store.WithRequestBufferSize(10),
+// 		store.WithSnapshot("store.json", time.Minute),
+// 		store.WithHistory(time.Minute, 5*time.Second),
+// 		store.WithEventLog(eventBatches),
 // 	)
 func NewProvider(
 	logger *zap.Logger,
@@ -52,12 +68,24 @@ func NewProvider(
 	for _, opt := range opts {
 		opt(&cfg)
 	}
+	if cfg.snapshotInterval <= 0 {
+		cfg.snapshotInterval = time.Minute
+	}
+	if cfg.keyframeInterval <= 0 {
+		cfg.keyframeInterval = 5 * time.Second
+	}
 
-	return &Provider{
-		queryTimeout: cfg.queryTimeout,
-		logger:       logger.Named("store-provider"),
+	p := &Provider{
+		queryTimeout:     cfg.queryTimeout,
+		snapshotFile:     cfg.snapshotFile,
+		snapshotInterval: cfg.snapshotInterval,
+		historyDuration:  cfg.historyDuration,
+		keyframeInterval: cfg.keyframeInterval,
+		eventLog:         cfg.eventLog,
+		logger:           logger.Named("store-provider"),
 
 		streams:   Streams{Map: make(map[int]*models.Stream)},
+		histories: make(map[int]*history),
 		streamHub: hub.NewNotifyHub[*models.StreamEvent](cfg.eventBufferSize),
 		entityHub: hub.NewNotifyHub[*models.EntityEvent](cfg.eventBufferSize),
 
@@ -67,6 +95,45 @@ func NewProvider(
 		stop:     make(chan struct{}),
 		stopDone: make(chan struct{}),
 	}
+	p.view.Store(&view{streams: make(map[int]*models.Stream)})
+	p.restoreSnapshot()
+	return p
+}
+
+// restoreSnapshot loads the streams from the snapshot file, if one is
+// configured, so they can be re-associated with live streams later
+func (p *Provider) restoreSnapshot() {
+	if p.snapshotFile == "" {
+		return
+	}
+	restored, err := LoadSnapshot(p.snapshotFile)
+	if err != nil {
+		p.logger.Error("Error restoring snapshot",
+			zap.String("file", p.snapshotFile),
+			zap.Error(err),
+		)
+		return
+	}
+	if len(restored) > 0 {
+		p.logger.Info("Restored snapshot",
+			zap.String("file", p.snapshotFile),
+			zap.Int("streams", len(restored)),
+		)
+	}
+	p.streams.Restored = restored
+}
+
+// saveSnapshot writes the streams to the snapshot file, if one is configured
+func (p *Provider) saveSnapshot() {
+	if p.snapshotFile == "" {
+		return
+	}
+	if err := SaveSnapshot(p.snapshotFile, &p.streams); err != nil {
+		p.logger.Error("Error saving snapshot",
+			zap.String("file", p.snapshotFile),
+			zap.Error(err),
+		)
+	}
 }
 
 // Serve runs the main loop for the provider. It runs inside a goroutine
@@ -75,21 +142,41 @@ func NewProvider(
 func (p *Provider) Serve() {
 	defer close(p.stopDone)
 	p.logger.Info("Running")
+
+	var snapshotChan <-chan time.Time
+	if p.snapshotFile != "" {
+		snapshotTicker := time.NewTicker(p.snapshotInterval)
+		defer snapshotTicker.Stop()
+		snapshotChan = snapshotTicker.C
+	}
+
+	var keyframeChan <-chan time.Time
+	if p.historyDuration > 0 {
+		keyframeTicker := time.NewTicker(p.keyframeInterval)
+		defer keyframeTicker.Stop()
+		keyframeChan = keyframeTicker.C
+	}
+
 	for {
 		select {
 		case u := <-p.updatesChan:
 			p.handleUpdate(u)
+			for n := len(p.updatesChan); n > 0; n-- {
+				p.handleUpdate(<-p.updatesChan)
+			}
+			p.publishView()
 		case r := <-p.internalRequestChan:
 			switch v := r.(type) {
-			case streamsRequest:
-				p.handleStreamsRequest(v)
-			case streamRequest:
-				p.handleStreamRequest(v)
-			case entityRequest:
-				p.handleEntityRequest(v)
+			case streamAtRequest:
+				p.handleStreamAtRequest(v)
 			}
+		case now := <-keyframeChan:
+			p.addKeyframes(now)
+		case <-snapshotChan:
+			p.saveSnapshot()
 		case <-p.stop:
 			p.logger.Info("Stopping...")
+			p.saveSnapshot()
 			return
 		}
 	}
@@ -110,6 +197,7 @@ func (p *Provider) handleUpdate(u Update) {
 	if u == nil {
 		return
 	}
+	now := time.Now()
 	streamEvents, entityEvents, err := u.ModifyStore(&p.streams)
 	if err != nil {
 		p.logger.Error("Error applying update",
@@ -117,6 +205,13 @@ func (p *Provider) handleUpdate(u Update) {
 			zap.Error(err),
 		)
 	}
+	p.changes.record(u, streamEvents, entityEvents, err)
+	if p.historyDuration > 0 {
+		p.recordHistory(now, streamEvents, entityEvents)
+	}
+	if p.eventLog != nil {
+		p.logEvents(now, streamEvents, entityEvents)
+	}
 	for _, streamEvent := range streamEvents {
 		eventCopy := streamEvent
 		p.streamHub.Broadcast(&eventCopy)
@@ -127,73 +222,182 @@ func (p *Provider) handleUpdate(u Update) {
 	}
 }
 
-// Streams returns all the streams from the internal store. This query will
-// return an error if the request exceeds the timeout duration.
-func (p *Provider) Streams() ([]models.Stream, error) {
-	streamsChan := make(chan []models.Stream, 1)
-	p.internalRequestChan <- streamsRequest{respChan: streamsChan}
-	select {
-	case resp := <-streamsChan:
-		return resp, nil
-	case <-time.After(p.queryTimeout):
-		p.logger.Error("Streams()",
-			zap.Error(ErrRequestTimedOut),
-			zap.Duration("timeout-duration", p.queryTimeout),
-		)
-		return nil, ErrRequestTimedOut
+// publishView replaces the view of the store read by queries with one that
+// includes the changes made since the last view was published
+func (p *Provider) publishView() {
+	if p.changes.empty() {
+		return
 	}
+	p.view.Store(p.currentView().next(&p.streams, &p.changes))
+	p.changes = changes{}
 }
 
-// Stream returns a specific stream from the store, queried by streamID. This
-// query will return an error if the request exceeds the timeout duration.
-func (p *Provider) Stream(streamID int) (*models.Stream, error) {
-	streamChan := make(chan *models.Stream, 1)
-	p.internalRequestChan <- streamRequest{
-		respChan: streamChan,
-		streamID: streamID,
+func (p *Provider) currentView() *view {
+	return p.view.Load().(*view)
+}
+
+// recordHistory records copies of the events emitted by an update in the
+// histories of their streams, since the payloads of the events may point at
+// the live state of the store
+func (p *Provider) recordHistory(t time.Time, streamEvents []models.StreamEvent, entityEvents []models.EntityEvent) {
+	for _, streamEvent := range streamEvents {
+		h, found := p.histories[streamEvent.StreamID]
+		if _, ok := streamEvent.Type.(models.AddStream); ok {
+			if !found {
+				h = new(history)
+				p.histories[streamEvent.StreamID] = h
+			}
+			h.addKeyframe(t, p.streams.Map[streamEvent.StreamID])
+			continue
+		}
+		if found {
+			eventClone := streamEvent.Clone()
+			h.addDelta(delta{time: t, streamEvent: &eventClone})
+		}
 	}
-	select {
-	case resp := <-streamChan:
-		if resp == nil {
-			return nil, fmt.Errorf("stream ID %d not found", streamID)
+	for _, entityEvent := range entityEvents {
+		if h, found := p.histories[entityEvent.StreamID]; found {
+			eventClone := entityEvent.Clone()
+			h.addDelta(delta{time: t, entityEvent: &eventClone})
 		}
-		return resp, nil
-	case <-time.After(p.queryTimeout):
-		p.logger.Error("Stream()",
-			zap.Error(ErrRequestTimedOut),
-			zap.Int("streamID", streamID),
-			zap.Duration("timeout-duration", p.queryTimeout),
-		)
-		return nil, ErrRequestTimedOut
 	}
 }
 
+// addKeyframes records the current state of every stream in its history and
+// discards the history that is older than the history duration
+func (p *Provider) addKeyframes(now time.Time) {
+	for streamID, stream := range p.streams.Map {
+		h, found := p.histories[streamID]
+		if !found {
+			h = new(history)
+			p.histories[streamID] = h
+		}
+		h.addKeyframe(now, stream)
+	}
+	horizon := now.Add(-p.historyDuration)
+	for streamID, h := range p.histories {
+		_, exists := p.streams.Map[streamID]
+		if !h.prune(horizon, !exists) {
+			delete(p.histories, streamID)
+		}
+	}
+}
+
+// Streams returns all the streams from the store, as of the last batch of
+// updates applied to it. The streams share data with the store and must not
+// be modified. It returns the error of the context if it is already done.
+func (p *Provider) Streams(ctx context.Context) ([]models.Stream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	v := p.currentView()
+	streams := make([]models.Stream, 0, len(v.keyOrder))
+	for _, k := range v.keyOrder {
+		if s, found := v.streams[k]; found {
+			streams = append(streams, *s)
+		}
+	}
+	return streams, nil
+}
+
+// Stream returns a specific stream from the store, queried by streamID, as of
+// the last batch of updates applied to it. The stream shares data with the
+// store and must not be modified. It returns the error of the context if it
+// is already done.
+func (p *Provider) Stream(ctx context.Context, streamID int) (*models.Stream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s, found := p.currentView().streams[streamID]
+	if !found {
+		return nil, fmt.Errorf("stream ID %d not found", streamID)
+	}
+	sCopy := *s
+	return &sCopy, nil
+}
+
 // Entity returns a specific entity in a specific from the store, queried by
-// streamID and entityID. It returns an error if the stream ID is not found or
-// if the entityID is not found in the stream. This query will return an error
-// if the request exceeds the timeout duration.
-func (p *Provider) Entity(streamID int, entityID uint64) (*models.Entity, error) {
-	entityChan := make(chan *models.Entity, 1)
-	p.internalRequestChan <- entityRequest{
-		respChan: entityChan,
+// streamID and entityID, as of the last batch of updates applied to the
+// store. It returns an error if the stream ID is not found or if the entityID
+// is not found in the stream. The entity shares data with the store and must
+// not be modified. It returns the error of the context if it is already done.
+func (p *Provider) Entity(ctx context.Context, streamID int, entityID uint64) (*models.Entity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s, found := p.currentView().streams[streamID]; found {
+		if e := s.EntitiesMap[entityID]; e != nil {
+			eCopy := *e
+			return &eCopy, nil
+		}
+	}
+	return nil, fmt.Errorf("entity ID %d not found in stream %d", entityID, streamID)
+}
+
+// StreamAt returns the state of a specific stream at a past time, queried by
+// streamID. It returns an error if history is disabled, if the time is older
+// than the history that is kept, or if the stream did not exist at that time.
+// This query will return an error if the request exceeds the timeout
+// duration, and the error of the context if the context is done before the
+// request is served.
+func (p *Provider) StreamAt(ctx context.Context, streamID int, t time.Time) (*models.Stream, error) {
+	if p.historyDuration <= 0 {
+		return nil, ErrHistoryDisabled
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	timeout := time.NewTimer(p.queryTimeout)
+	defer timeout.Stop()
+
+	respChan := make(chan streamAtResponse, 1)
+	req := streamAtRequest{
+		ctx:      ctx,
+		respChan: respChan,
 		streamID: streamID,
-		entityID: entityID,
+		time:     t,
 	}
 	select {
-	case resp := <-entityChan:
-		if resp == nil {
-			return nil, fmt.Errorf("entity ID %d not found in stream %d", entityID, streamID)
-		}
-		return resp, nil
-	case <-time.After(p.queryTimeout):
-		p.logger.Error("Entity()",
-			zap.Error(ErrRequestTimedOut),
-			zap.Int("streamID", streamID),
-			zap.Uint64("entityID", entityID),
-			zap.Duration("timeout-duration", p.queryTimeout),
-		)
-		return nil, ErrRequestTimedOut
+	case p.internalRequestChan <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout.C:
+		return nil, p.streamAtTimedOut(streamID, t)
+	}
+	select {
+	case resp := <-respChan:
+		return resp.stream, resp.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout.C:
+		return nil, p.streamAtTimedOut(streamID, t)
+	}
+}
+
+func (p *Provider) streamAtTimedOut(streamID int, t time.Time) error {
+	p.logger.Error("StreamAt()",
+		zap.Error(ErrRequestTimedOut),
+		zap.Int("streamID", streamID),
+		zap.Time("time", t),
+		zap.Duration("timeout-duration", p.queryTimeout),
+	)
+	return ErrRequestTimedOut
+}
+
+// EntityAt returns the state of a specific entity in a specific stream at a
+// past time, queried by streamID and entityID. It returns an error in the
+// same cases as StreamAt, or if the entityID is not found in the stream at
+// that time.
+func (p *Provider) EntityAt(ctx context.Context, streamID int, entityID uint64, t time.Time) (*models.Entity, error) {
+	stream, err := p.StreamAt(ctx, streamID, t)
+	if err != nil {
+		return nil, err
+	}
+	e, found := stream.EntitiesMap[entityID]
+	if !found || e == nil {
+		return nil, fmt.Errorf("entity ID %d not found in stream %d", entityID, streamID)
 	}
+	return e, nil
 }
 
 // StreamEventSource returns an event source that allows consumers
@@ -0,0 +1,167 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/store"
+	"go.uber.org/zap"
+)
+
+const benchStreamID = 1
+
+// newBenchStream returns a stream with the given number of entities, each
+// holding the kind of nested data that the store keeps for a busy zone
+func newBenchStream(numEntities int) *models.Stream {
+	s := &models.Stream{
+		ID:          benchStreamID,
+		CharacterID: 1,
+		Place:       models.Place{MapID: 1, TerritoryID: 1, Maps: []models.MapInfo{{ID: "s1t1/00"}}},
+		EntitiesMap: make(map[uint64]*models.Entity, numEntities),
+	}
+	for i := 0; i < numEntities; i++ {
+		id := uint64(i + 1)
+		statuses := make([]*models.Status, 10)
+		for j := range statuses {
+			statuses[j] = &models.Status{ID: j, Param: j, Name: "Status", ActorID: id}
+		}
+		s.EntitiesMap[id] = &models.Entity{
+			ID:         id,
+			Index:      i,
+			Name:       "Entity",
+			ClassJob:   &models.ClassJob{ID: 1, Name: "Gladiator", Abbreviation: "GLA"},
+			BNPCInfo:   &models.NPCInfo{Name: new(string), Size: new(float64)},
+			Resources:  &models.Resources{Hp: 100, MaxHp: 100},
+			Location:   &models.Location{X: float64(i), Y: float64(i), Z: float64(i)},
+			LastAction: &models.Action{Name: "Attack", Location: &models.Location{}, Effects: []models.ActionEffect{{TargetID: 1, Type: 3}}},
+			Statuses:   statuses,
+		}
+	}
+	return s
+}
+
+// newBenchProvider returns a running provider whose store holds the stream
+func newBenchProvider(b *testing.B, stream *models.Stream) *store.Provider {
+	provider := store.NewProvider(zap.NewNop())
+	go provider.Serve()
+	b.Cleanup(provider.Stop)
+
+	provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+		s.Map[stream.ID] = stream
+		s.KeyOrder = []int{stream.ID}
+		return nil, nil, nil
+	})
+	for {
+		if _, err := provider.Stream(context.Background(), stream.ID); err == nil {
+			return provider
+		}
+	}
+}
+
+// moveEntity returns an update that moves an entity of the benchmark stream
+func moveEntity(entityID uint64, x float64) store.Update {
+	return testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+		location := &models.Location{X: x}
+		s.Map[benchStreamID].EntitiesMap[entityID].Location = location
+		return nil, []models.EntityEvent{
+			{StreamID: benchStreamID, EntityID: entityID, Type: models.UpdateLocation{Location: location}},
+		}, nil
+	})
+}
+
+// BenchmarkStreamClone measures the deep copy of a stream that the store
+// used to make on its update goroutine for every stream query.
+func BenchmarkStreamClone(b *testing.B) {
+	stream := newBenchStream(500)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = stream.Clone()
+	}
+}
+
+// BenchmarkProviderStream measures stream queries read from the view
+// published by the provider.
+func BenchmarkProviderStream(b *testing.B) {
+	provider := newBenchProvider(b, newBenchStream(500))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := provider.Stream(context.Background(), benchStreamID); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkProviderStreamWhileUpdating measures concurrent stream queries
+// while the provider keeps applying updates to the stream.
+func BenchmarkProviderStreamWhileUpdating(b *testing.B) {
+	provider := newBenchProvider(b, newBenchStream(500))
+	done := make(chan struct{})
+	b.Cleanup(func() { close(done) })
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			case provider.UpdatesChan() <- moveEntity(uint64(i%500+1), float64(i)):
+			}
+		}
+	}()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := provider.Stream(context.Background(), benchStreamID); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkProviderUpdate measures how fast the provider applies updates and
+// publishes the views that include them.
+func BenchmarkProviderUpdate(b *testing.B) {
+	provider := newBenchProvider(b, newBenchStream(500))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		provider.UpdatesChan() <- moveEntity(uint64(i%500+1), float64(i))
+	}
+	lastID := uint64((b.N-1)%500 + 1)
+	for {
+		e, err := provider.Entity(context.Background(), benchStreamID, lastID)
+		if err == nil && e.Location.X == float64(b.N-1) {
+			return
+		}
+	}
+}
+
+// BenchmarkProviderReportedUpdate measures how fast the provider applies
+// updates that report the stream they changed instead of emitting events,
+// such as the periodic updates to the status of a stream.
+func BenchmarkProviderReportedUpdate(b *testing.B) {
+	provider := newBenchProvider(b, newBenchStream(500))
+	status := reportingUpdate{
+		testUpdate: func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+			s.Map[benchStreamID].Status = &models.Health{State: models.HealthStateConnected}
+			return nil, nil, nil
+		},
+		changed: []int{benchStreamID},
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		provider.UpdatesChan() <- status
+	}
+	provider.UpdatesChan() <- moveEntity(1, -1)
+	for {
+		e, err := provider.Entity(context.Background(), benchStreamID, 1)
+		if err == nil && e.Location.X == -1 {
+			return
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package store_test
 
 import (
+	"context"
 	"errors"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -23,6 +26,16 @@ func (t testUpdate) ModifyStore(s *store.Streams) ([]models.StreamEvent, []model
 	return t(s)
 }
 
+// reportingUpdate is a testUpdate that reports the streams it changed
+type reportingUpdate struct {
+	testUpdate
+	changed []int
+}
+
+func (r reportingUpdate) ChangedStreams() []int {
+	return r.changed
+}
+
 var _ = Describe("Provider", func() {
 	var (
 		provider *store.Provider
@@ -35,6 +48,10 @@ var _ = Describe("Provider", func() {
 		supervisor *suture.Supervisor
 	)
 
+	streams := func() ([]models.Stream, error) {
+		return provider.Streams(context.Background())
+	}
+
 	BeforeEach(func() {
 		once.Do(func() {
 			logBuf = new(testhelpers.LogBuffer)
@@ -83,7 +100,7 @@ var _ = Describe("Provider", func() {
 			return nil, nil, nil
 		})
 
-		Eventually(provider.Streams).Should(HaveLen(2))
+		Eventually(streams).Should(HaveLen(2))
 	})
 
 	AfterEach(func() {
@@ -101,83 +118,94 @@ var _ = Describe("Provider", func() {
 
 	Describe("Streams", func() {
 		It("returns all the streams found in the store", func() {
-			Expect(provider.Streams()).To(Equal([]models.Stream{stream2, stream1}))
+			Expect(provider.Streams(context.Background())).To(Equal([]models.Stream{stream2, stream1}))
 		})
 
-		It("times out requests that take too long", func() {
+		It("does not wait for the updates being applied", func() {
 			blockCh := make(chan struct{})
 			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
 				By("blocking the provider service loop")
 				<-blockCh
+				s.Map[5678].CharacterID = 2345
 				<-blockCh
 				return nil, nil, nil
 			})
 
 			Eventually(blockCh).Should(BeSent(struct{}{}))
-			_, err := provider.Streams()
-			Expect(err).To(MatchError(store.ErrRequestTimedOut))
+			Expect(provider.Streams(context.Background())).To(Equal([]models.Stream{{ID: 5678}, stream1}))
 			close(blockCh)
 		})
 	})
 
 	Describe("Stream", func() {
 		It("returns the requested stream from the store", func() {
-			Expect(provider.Stream(5678)).To(Equal(&stream2))
+			Expect(provider.Stream(context.Background(), 5678)).To(Equal(&stream2))
 		})
 
 		It("returns an error if the requested stream does not exist", func() {
-			_, err := provider.Stream(2345)
+			_, err := provider.Stream(context.Background(), 2345)
 			Expect(err).To(MatchError("stream ID 2345 not found"))
 		})
 
-		It("times out requests that take too long", func() {
+		It("returns the error of the context if it is already done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := provider.Stream(ctx, 5678)
+			Expect(err).To(MatchError(context.Canceled))
+			_, err = provider.Streams(ctx)
+			Expect(err).To(MatchError(context.Canceled))
+			_, err = provider.Entity(ctx, 1234, 1)
+			Expect(err).To(MatchError(context.Canceled))
+		})
+
+		It("does not wait for the updates being applied", func() {
 			blockCh := make(chan struct{})
 			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
 				By("blocking the provider service loop")
 				<-blockCh
+				s.Map[5678].CharacterID = 2345
 				<-blockCh
 				return nil, nil, nil
 			})
 
 			Eventually(blockCh).Should(BeSent(struct{}{}))
-			_, err := provider.Stream(2345)
-			Expect(err).To(MatchError(store.ErrRequestTimedOut))
+			Expect(provider.Stream(context.Background(), 5678)).To(Equal(&models.Stream{ID: 5678}))
 			close(blockCh)
 		})
 	})
 
 	Describe("Entity", func() {
 		It("returns the requested entity from the store", func() {
-			Expect(provider.Entity(1234, 1)).To(Equal(&models.Entity{ID: 1, Name: "FooBar", Index: 2}))
+			Expect(provider.Entity(context.Background(), 1234, 1)).To(Equal(&models.Entity{ID: 1, Name: "FooBar", Index: 2}))
 		})
 
 		It("returns an error if the requested stream does not exist", func() {
-			_, err := provider.Entity(2345, 1)
+			_, err := provider.Entity(context.Background(), 2345, 1)
 			Expect(err).To(MatchError("entity ID 1 not found in stream 2345"))
 		})
 
 		It("returns an error if the requested entity does not exist", func() {
-			_, err := provider.Entity(1234, 4)
+			_, err := provider.Entity(context.Background(), 1234, 4)
 			Expect(err).To(MatchError("entity ID 4 not found in stream 1234"))
 		})
 
 		It("returns an error if the requested entity is nil", func() {
-			_, err := provider.Entity(1234, 3)
+			_, err := provider.Entity(context.Background(), 1234, 3)
 			Expect(err).To(MatchError("entity ID 3 not found in stream 1234"))
 		})
 
-		It("times out requests that take too long", func() {
+		It("does not wait for the updates being applied", func() {
 			blockCh := make(chan struct{})
 			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
 				By("blocking the provider service loop")
 				<-blockCh
+				s.Map[5678].CharacterID = 2345
 				<-blockCh
 				return nil, nil, nil
 			})
 
 			Eventually(blockCh).Should(BeSent(struct{}{}))
-			_, err := provider.Entity(1234, 3)
-			Expect(err).To(MatchError(store.ErrRequestTimedOut))
+			Expect(provider.Entity(context.Background(), 1234, 1)).To(Equal(&models.Entity{ID: 1, Name: "FooBar", Index: 2}))
 			close(blockCh)
 		})
 	})
@@ -189,7 +217,7 @@ var _ = Describe("Provider", func() {
 				return nil, nil, nil
 			})
 			Eventually(func() *models.Stream {
-				s, _ := provider.Stream(5678)
+				s, _ := provider.Stream(context.Background(), 5678)
 				return s
 			}).Should(Equal(&models.Stream{ID: 5678, CharacterID: 2345}))
 		})
@@ -197,7 +225,7 @@ var _ = Describe("Provider", func() {
 		It("ignores nil updates", func() {
 			provider.UpdatesChan() <- nil
 			Consistently(func() *models.Stream {
-				s, _ := provider.Stream(5678)
+				s, _ := provider.Stream(context.Background(), 5678)
 				return s
 			}).Should(Equal(&models.Stream{ID: 5678}))
 			Expect(logBuf).To(gbytes.Say("Running"))
@@ -205,7 +233,7 @@ var _ = Describe("Provider", func() {
 		})
 
 		It("updates applied should not affect the result of already returned queries", func() {
-			queriedStream, err := provider.Stream(5678)
+			queriedStream, err := provider.Stream(context.Background(), 5678)
 			Expect(err).ToNot(HaveOccurred())
 
 			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
@@ -213,7 +241,7 @@ var _ = Describe("Provider", func() {
 				return nil, nil, nil
 			})
 			Eventually(func() *models.Stream {
-				s, _ := provider.Stream(5678)
+				s, _ := provider.Stream(context.Background(), 5678)
 				return s
 			}).Should(Equal(&models.Stream{ID: 5678, CharacterID: 2345}))
 
@@ -221,6 +249,137 @@ var _ = Describe("Provider", func() {
 
 		})
 
+		It("only copies the entities changed by the update", func() {
+			before, err := provider.Stream(context.Background(), 1234)
+			Expect(err).ToNot(HaveOccurred())
+
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234].EntitiesMap[1].Name = "Changed"
+				s.Map[1234].EntitiesMap[2].Name = "Unreported"
+				return nil, []models.EntityEvent{
+					{StreamID: 1234, EntityID: 1, Type: models.UpdateTarget{}},
+				}, nil
+			})
+			var after *models.Stream
+			Eventually(func() string {
+				after, _ = provider.Stream(context.Background(), 1234)
+				return after.EntitiesMap[1].Name
+			}).Should(Equal("Changed"))
+
+			Expect(after.EntitiesMap[2]).To(BeIdenticalTo(before.EntitiesMap[2]))
+			Expect(after.EntitiesMap[2].Name).To(Equal("Baah"))
+			Expect(before.EntitiesMap[1].Name).To(Equal("FooBar"))
+		})
+
+		It("shares the streams that were not changed by the update", func() {
+			before, err := provider.Streams(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[5678].CharacterID = 2345
+				return []models.StreamEvent{
+					{StreamID: 5678, Type: models.UpdateIDs{CharacterID: 2345}},
+				}, nil, nil
+			})
+			var after []models.Stream
+			Eventually(func() uint64 {
+				after, _ = provider.Streams(context.Background())
+				return after[0].CharacterID
+			}).Should(BeEquivalentTo(2345))
+
+			Expect(after[1].EntitiesMap[1]).To(BeIdenticalTo(before[1].EntitiesMap[1]))
+			Expect(after[1]).To(Equal(stream1))
+		})
+
+		It("copies the entire store again after an update that emits no events", func() {
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234].EntitiesMap[2].Name = "Unreported"
+				return nil, nil, nil
+			})
+			Eventually(func() string {
+				e, _ := provider.Entity(context.Background(), 1234, 2)
+				return e.Name
+			}).Should(Equal("Unreported"))
+		})
+
+		It("only copies the streams reported by an update that emits no events", func() {
+			before, err := provider.Streams(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+
+			provider.UpdatesChan() <- reportingUpdate{
+				testUpdate: func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+					s.Map[5678].Status = &models.Health{State: models.HealthStateConnected}
+					return nil, nil, nil
+				},
+				changed: []int{5678},
+			}
+			var after []models.Stream
+			Eventually(func() *models.Health {
+				after, _ = provider.Streams(context.Background())
+				return after[0].Status
+			}).ShouldNot(BeNil())
+
+			Expect(after[0].Status.State).To(Equal(models.HealthStateConnected))
+			Expect(after[1].EntitiesMap[1]).To(BeIdenticalTo(before[1].EntitiesMap[1]))
+			Expect(after[1].EntitiesMap[2]).To(BeIdenticalTo(before[1].EntitiesMap[2]))
+		})
+
+		It("copies the entire store again after an update that fails", func() {
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234].EntitiesMap[2].Name = "Unreported"
+				return nil, []models.EntityEvent{
+					{StreamID: 1234, EntityID: 1, Type: models.UpdateTarget{}},
+				}, errors.New("kaboom")
+			})
+			Eventually(func() string {
+				e, _ := provider.Entity(context.Background(), 1234, 2)
+				return e.Name
+			}).Should(Equal("Unreported"))
+		})
+
+		It("removes the streams and entities removed by the update", func() {
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				delete(s.Map[1234].EntitiesMap, 1)
+				delete(s.Map, 5678)
+				s.KeyOrder = []int{1234}
+				return []models.StreamEvent{
+						{StreamID: 5678, Type: models.RemoveStream{ID: 5678}},
+					}, []models.EntityEvent{
+						{StreamID: 1234, EntityID: 1, Type: models.RemoveEntity{ID: 1}},
+					}, nil
+			})
+			Eventually(streams).Should(HaveLen(1))
+			_, err := provider.Entity(context.Background(), 1234, 1)
+			Expect(err).To(MatchError("entity ID 1 not found in stream 1234"))
+			_, err = provider.Stream(context.Background(), 5678)
+			Expect(err).To(MatchError("stream ID 5678 not found"))
+		})
+
+		It("keeps the departed entities of a stream in the views published before later departures", func() {
+			depart := func(entityID uint64) {
+				provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+					s.Map[1234].DepartEntity(entityID, models.DespawnReasonRemoved, time.Unix(100, 0))
+					return nil, []models.EntityEvent{
+						{StreamID: 1234, EntityID: entityID, Type: models.RemoveEntity{ID: entityID}},
+					}, nil
+				})
+			}
+			depart(1)
+			var before *models.Stream
+			Eventually(func() []models.DepartedEntity {
+				before, _ = provider.Stream(context.Background(), 1234)
+				return before.Departed
+			}).Should(HaveLen(1))
+
+			depart(2)
+			Eventually(func() []models.DepartedEntity {
+				after, _ := provider.Stream(context.Background(), 1234)
+				return after.Departed
+			}).Should(HaveLen(2))
+			Expect(before.Departed).To(HaveLen(1))
+			Expect(before.Departed[0].Entity.ID).To(Equal(uint64(1)))
+		})
+
 		It("logs errors returned by the update", func() {
 			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
 				return nil, nil, errors.New("kaboom")
@@ -275,4 +434,541 @@ var _ = Describe("Provider", func() {
 			Eventually(entityEvents).Should(Receive(Equal(&models.EntityEvent{StreamID: 1234, EntityID: 2})))
 		})
 	})
+
+	Context("when a snapshot file is configured", func() {
+		var snapshotFile string
+
+		BeforeEach(func() {
+			supervisor.Stop()
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"providertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			snapshotFile = filepath.Join(GinkgoT().TempDir(), "snapshot.json")
+			stream1.CharacterID = 0x12345678
+			stream1.Source = &models.StreamSource{Adapter: "Hook", Key: "1234"}
+			Expect(store.SaveSnapshot(snapshotFile, &store.Streams{
+				Map:      map[int]*models.Stream{1234: &stream1},
+				KeyOrder: []int{1234},
+			})).To(Succeed())
+
+			provider = store.NewProvider(
+				logger,
+				store.WithQueryTimeout(10*time.Millisecond),
+				store.WithSnapshot(snapshotFile, 10*time.Millisecond),
+			)
+			supervisor = suture.New("test-provider", suture.Spec{FailureThreshold: 1})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(provider)
+		})
+
+		It("restores the streams from the snapshot without adding them to the store", func() {
+			restoredChan := make(chan []*models.Stream, 1)
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				restoredChan <- s.Restored
+				return nil, nil, nil
+			})
+
+			var restored []*models.Stream
+			Eventually(restoredChan).Should(Receive(&restored))
+			Expect(restored).To(HaveLen(1))
+			Expect(restored[0].ID).To(Equal(1234))
+			Expect(restored[0].Restored).To(BeTrue())
+			Expect(provider.Streams(context.Background())).To(BeEmpty())
+		})
+
+		It("saves the streams to the snapshot periodically", func() {
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				stream := &models.Stream{ID: 5678, CharacterID: 0x23456789}
+				s.Map[5678] = stream
+				s.KeyOrder = []int{5678}
+				return nil, nil, nil
+			})
+
+			Eventually(func() []*models.Stream {
+				restored, _ := store.LoadSnapshot(snapshotFile)
+				return restored
+			}).Should(ContainElement(HaveField("CharacterID", BeEquivalentTo(0x23456789))))
+		})
+
+		It("logs an error if the snapshot cannot be restored", func() {
+			Expect(os.WriteFile(snapshotFile, []byte(`{"version":0}`), 0644)).To(Succeed())
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"providertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			store.NewProvider(logger, store.WithSnapshot(snapshotFile, time.Minute))
+			Eventually(logBuf).Should(gbytes.Say(`ERROR.*store-provider.*Error restoring snapshot.*unsupported snapshot version 0`))
+		})
+	})
+
+	Describe("StreamAt", func() {
+		It("returns an error if history is disabled", func() {
+			_, err := provider.StreamAt(context.Background(), 1234, time.Now())
+			Expect(err).To(MatchError(store.ErrHistoryDisabled))
+		})
+	})
+
+	Context("when an event log is configured", func() {
+		var eventLog chan store.EventBatch
+
+		BeforeEach(func() {
+			supervisor.Stop()
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"providertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			eventLog = make(chan store.EventBatch, 1)
+			provider = store.NewProvider(logger, store.WithEventLog(eventLog))
+			supervisor = suture.New("test-provider", suture.Spec{FailureThreshold: 1})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(provider)
+		})
+
+		setEnmity := func(hate int) store.Update {
+			return testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				if s.Map[1234] == nil {
+					s.Map[1234] = &models.Stream{ID: 1234}
+				}
+				enmity := &s.Map[1234].Enmity
+				enmity.TargetHateRanking = append(enmity.TargetHateRanking[:0], models.HateRanking{ActorID: 1, Hate: hate})
+				return []models.StreamEvent{
+					{StreamID: 1234, Type: models.UpdateEnmity{Enmity: enmity}},
+				}, nil, nil
+			})
+		}
+
+		It("sends copies of the events that do not change with the store", func() {
+			provider.UpdatesChan() <- setEnmity(100)
+			var batch store.EventBatch
+			Eventually(eventLog).Should(Receive(&batch))
+			Expect(batch.Time).ToNot(BeZero())
+			Expect(batch.EntityEvents).To(BeEmpty())
+
+			provider.UpdatesChan() <- setEnmity(50)
+			Eventually(eventLog).Should(Receive())
+			Expect(batch.StreamEvents).To(Equal([]models.StreamEvent{{
+				StreamID: 1234,
+				Type: models.UpdateEnmity{Enmity: &models.Enmity{
+					TargetHateRanking: []models.HateRanking{{ActorID: 1, Hate: 100}},
+				}},
+			}}))
+		})
+
+		It("drops and reports the events that the event log is not ready to accept", func() {
+			provider.UpdatesChan() <- setEnmity(100)
+			provider.UpdatesChan() <- setEnmity(50)
+			Eventually(logBuf).Should(gbytes.Say(`WARN.*store-provider.*Event log is not keeping up, dropped events.*"dropped": 1, "totalDropped": 1`))
+
+			By("only warning once while the event log is falling behind")
+			provider.UpdatesChan() <- setEnmity(25)
+			Consistently(logBuf).ShouldNot(gbytes.Say("Event log is not keeping up"))
+
+			var batch store.EventBatch
+			Expect(eventLog).To(Receive(&batch))
+			Expect(batch.StreamEvents[0].Type.(models.UpdateEnmity).Enmity.TargetHateRanking[0].Hate).To(Equal(100))
+			Consistently(eventLog).ShouldNot(Receive())
+
+			By("warning again if the event log falls behind after catching up")
+			provider.UpdatesChan() <- setEnmity(10)
+			Eventually(eventLog).Should(Receive())
+			provider.UpdatesChan() <- setEnmity(5)
+			provider.UpdatesChan() <- setEnmity(1)
+			Eventually(logBuf).Should(gbytes.Say(`Event log is not keeping up, dropped events.*"totalDropped": 3`))
+		})
+
+		It("does not hold up updates while the event log is full", func() {
+			provider.UpdatesChan() <- setEnmity(100)
+			provider.UpdatesChan() <- setEnmity(50)
+			provider.UpdatesChan() <- setEnmity(25)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = provider.Stream(context.Background(), 1234)
+			}()
+			Eventually(done, 500*time.Millisecond).Should(BeClosed())
+		})
+	})
+
+	Context("when history is enabled", func() {
+		var (
+			addedTime time.Time
+			movedTime time.Time
+		)
+
+		location := func(x float64) *models.Location {
+			return &models.Location{X: x}
+		}
+
+		BeforeEach(func() {
+			supervisor.Stop()
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"providertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			provider = store.NewProvider(
+				logger,
+				store.WithQueryTimeout(10*time.Millisecond),
+				store.WithHistory(time.Hour, 20*time.Millisecond),
+			)
+			supervisor = suture.New("test-provider", suture.Spec{FailureThreshold: 1})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(provider)
+
+			applied := make(chan time.Time, 1)
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234] = &models.Stream{
+					ID: 1234,
+					EntitiesMap: map[uint64]*models.Entity{
+						1: {ID: 1, Name: "FooBar", Location: location(1)},
+					},
+				}
+				s.KeyOrder = []int{1234}
+				applied <- time.Now()
+				return []models.StreamEvent{
+					{StreamID: 1234, Type: models.AddStream{Stream: s.Map[1234]}},
+				}, nil, nil
+			})
+			Eventually(applied).Should(Receive(&addedTime))
+			time.Sleep(time.Millisecond)
+
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234].EntitiesMap[1].Location = location(2)
+				applied <- time.Now()
+				return nil, []models.EntityEvent{
+					{StreamID: 1234, EntityID: 1, Type: models.UpdateLocation{Location: location(2)}},
+				}, nil
+			})
+			Eventually(applied).Should(Receive(&movedTime))
+		})
+
+		It("returns the state of the stream at the requested time", func() {
+			s, err := provider.StreamAt(context.Background(), 1234, addedTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.EntitiesMap[1].Location).To(Equal(location(1)))
+
+			s, err = provider.StreamAt(context.Background(), 1234, movedTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.EntitiesMap[1].Location).To(Equal(location(2)))
+		})
+
+		It("returns the same states after more keyframes are kept", func() {
+			time.Sleep(50 * time.Millisecond)
+
+			s, err := provider.StreamAt(context.Background(), 1234, addedTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.EntitiesMap[1].Location).To(Equal(location(1)))
+
+			s, err = provider.StreamAt(context.Background(), 1234, time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.EntitiesMap[1].Location).To(Equal(location(2)))
+		})
+
+		It("returns the state of the entity at the requested time", func() {
+			Expect(provider.EntityAt(context.Background(), 1234, 1, addedTime.Add(time.Microsecond))).To(Equal(
+				&models.Entity{ID: 1, Name: "FooBar", Location: location(1)},
+			))
+			_, err := provider.EntityAt(context.Background(), 1234, 2, addedTime.Add(time.Microsecond))
+			Expect(err).To(MatchError("entity ID 2 not found in stream 1234"))
+		})
+
+		It("does not change the history when the stream is changed in place", func() {
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234].EntitiesMap[1].Name = "Baah"
+				return nil, nil, nil
+			})
+			Eventually(func() string {
+				e, _ := provider.Entity(context.Background(), 1234, 1)
+				return e.Name
+			}).Should(Equal("Baah"))
+
+			e, err := provider.EntityAt(context.Background(), 1234, 1, addedTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(e.Name).To(Equal("FooBar"))
+		})
+
+		It("keeps the enmity of each update when the stream enmity is changed in place", func() {
+			applied := make(chan time.Time, 1)
+			setEnmity := func(hate int) store.Update {
+				return testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+					enmity := &s.Map[1234].Enmity
+					enmity.TargetHateRanking = append(enmity.TargetHateRanking[:0], models.HateRanking{ActorID: 1, Hate: hate})
+					applied <- time.Now()
+					return []models.StreamEvent{
+						{StreamID: 1234, Type: models.UpdateEnmity{Enmity: enmity}},
+					}, nil, nil
+				})
+			}
+
+			var firstTime, secondTime time.Time
+			provider.UpdatesChan() <- setEnmity(100)
+			Eventually(applied).Should(Receive(&firstTime))
+			time.Sleep(time.Millisecond)
+			provider.UpdatesChan() <- setEnmity(50)
+			Eventually(applied).Should(Receive(&secondTime))
+
+			s, err := provider.StreamAt(context.Background(), 1234, firstTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Enmity.TargetHateRanking).To(Equal([]models.HateRanking{{ActorID: 1, Hate: 100}}))
+
+			s, err = provider.StreamAt(context.Background(), 1234, secondTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Enmity.TargetHateRanking).To(Equal([]models.HateRanking{{ActorID: 1, Hate: 50}}))
+		})
+
+		It("returns an error if the time is before the history of the stream", func() {
+			_, err := provider.StreamAt(context.Background(), 1234, addedTime.Add(-time.Second))
+			Expect(err).To(MatchError(HavePrefix("stream ID 1234: no history before")))
+		})
+
+		It("returns an error if the stream was removed at the requested time", func() {
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				delete(s.Map, 1234)
+				s.KeyOrder = nil
+				return []models.StreamEvent{
+					{StreamID: 1234, Type: models.RemoveStream{ID: 1234}},
+				}, nil, nil
+			})
+			Eventually(streams).Should(BeEmpty())
+
+			_, err := provider.StreamAt(context.Background(), 1234, time.Now())
+			Expect(err).To(MatchError(HavePrefix("stream ID 1234 not found at")))
+
+			s, err := provider.StreamAt(context.Background(), 1234, movedTime.Add(time.Microsecond))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.ID).To(Equal(1234))
+		})
+
+		It("returns an error if the stream has no history", func() {
+			_, err := provider.StreamAt(context.Background(), 5678, time.Now())
+			Expect(err).To(MatchError("stream ID 5678 not found"))
+		})
+
+		It("returns the error of the context if it is already done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := provider.StreamAt(ctx, 1234, time.Now())
+			Expect(err).To(MatchError(context.Canceled))
+			_, err = provider.EntityAt(ctx, 1234, 1, time.Now())
+			Expect(err).To(MatchError(context.Canceled))
+		})
+
+		Context("when the store is busy applying an update", func() {
+			var unblock chan struct{}
+
+			BeforeEach(func() {
+				unblock = make(chan struct{})
+				provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+					<-unblock
+					return nil, nil, nil
+				})
+			})
+
+			AfterEach(func() {
+				close(unblock)
+			})
+
+			It("returns when the deadline of the context is exceeded before the timeout", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+				defer cancel()
+				_, err := provider.StreamAt(ctx, 1234, time.Now())
+				Expect(err).To(MatchError(context.DeadlineExceeded))
+			})
+
+			It("does not wait on a full request queue past the context or the timeout", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				errs := make(chan error, 20)
+				for i := 0; i < 20; i++ {
+					go func() {
+						defer GinkgoRecover()
+						_, err := provider.StreamAt(ctx, 1234, time.Now())
+						errs <- err
+					}()
+				}
+				cancel()
+				for i := 0; i < 20; i++ {
+					Eventually(errs).Should(Receive(SatisfyAny(
+						MatchError(context.Canceled),
+						MatchError(store.ErrRequestTimedOut),
+					)))
+				}
+			})
+		})
+
+		DescribeTable("replays the events emitted for the stream to the same state as the store",
+			func(modify func(*models.Stream), streamEvents []models.StreamEvent, entityEvents []models.EntityEvent) {
+				provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+					modify(s.Map[1234])
+					return streamEvents, entityEvents, nil
+				})
+
+				var current *models.Stream
+				Eventually(func() *models.Stream {
+					current, _ = provider.Stream(context.Background(), 1234)
+					return current
+				}).ShouldNot(Equal(&models.Stream{
+					ID: 1234,
+					EntitiesMap: map[uint64]*models.Entity{
+						1: {ID: 1, Name: "FooBar", Location: location(2)},
+					},
+				}))
+				Expect(provider.StreamAt(context.Background(), 1234, time.Now())).To(Equal(current))
+			},
+			Entry("UpdateIDs",
+				func(s *models.Stream) {
+					s.ServerID = 12
+					s.CharacterID = 34
+					s.HomeWorld = models.World{ID: 56, Name: "Foo"}
+					s.CurrentWorld = models.World{ID: 78, Name: "Bar"}
+				},
+				[]models.StreamEvent{{StreamID: 1234, Type: models.UpdateIDs{
+					ServerID:     12,
+					CharacterID:  34,
+					HomeWorld:    &models.World{ID: 56, Name: "Foo"},
+					CurrentWorld: &models.World{ID: 78, Name: "Bar"},
+				}}},
+				nil,
+			),
+			Entry("UpdateMap",
+				func(s *models.Stream) {
+					s.Place = models.Place{MapID: 12, Maps: []models.MapInfo{{Key: 12}}}
+				},
+				[]models.StreamEvent{{StreamID: 1234, Type: models.UpdateMap{
+					Place: &models.Place{MapID: 12, Maps: []models.MapInfo{{Key: 12}}},
+				}}},
+				nil,
+			),
+			Entry("UpdateStreamStatus",
+				func(s *models.Stream) {
+					s.Status = &models.Health{State: models.HealthStateDegraded}
+				},
+				[]models.StreamEvent{{StreamID: 1234, Type: models.UpdateStreamStatus{
+					Status: &models.Health{State: models.HealthStateDegraded},
+				}}},
+				nil,
+			),
+			Entry("AddEntity",
+				func(s *models.Stream) {
+					s.EntitiesMap[2] = &models.Entity{ID: 2, Name: "Baah"}
+				},
+				nil,
+				[]models.EntityEvent{{StreamID: 1234, EntityID: 2, Type: models.AddEntity{
+					Entity: &models.Entity{ID: 2, Name: "Baah"},
+				}}},
+			),
+			Entry("RemoveEntity",
+				func(s *models.Stream) {
+					s.DepartEntity(1, models.DespawnReasonRemoved, time.Unix(100, 0))
+				},
+				nil,
+				[]models.EntityEvent{{StreamID: 1234, EntityID: 1, Type: models.RemoveEntity{
+					ID:     1,
+					Reason: models.DespawnReasonRemoved,
+					Time:   time.Unix(100, 0),
+				}}},
+			),
+			Entry("SetEntities",
+				func(s *models.Stream) {
+					s.EntitiesMap = map[uint64]*models.Entity{}
+				},
+				nil,
+				[]models.EntityEvent{{StreamID: 1234, Type: models.SetEntities{}}},
+			),
+			Entry("UpsertStatus and RemoveStatus",
+				func(s *models.Stream) {
+					s.EntitiesMap[1].Statuses = []*models.Status{nil, nil, {ID: 3}}
+				},
+				nil,
+				[]models.EntityEvent{
+					{StreamID: 1234, EntityID: 1, Type: models.UpsertStatus{Index: 1, Status: &models.Status{ID: 2}}},
+					{StreamID: 1234, EntityID: 1, Type: models.UpsertStatus{Index: 2, Status: &models.Status{ID: 3}}},
+					{StreamID: 1234, EntityID: 1, Type: models.RemoveStatus{Index: 1}},
+				},
+			),
+			Entry("UpdateResources",
+				func(s *models.Stream) {
+					s.EntitiesMap[1].Resources = &models.Resources{Hp: 100}
+				},
+				nil,
+				[]models.EntityEvent{{StreamID: 1234, EntityID: 1, Type: models.UpdateResources{
+					Resources: &models.Resources{Hp: 100},
+				}}},
+			),
+			Entry("UpdateClass",
+				func(s *models.Stream) {
+					s.EntitiesMap[1].ClassJob = &models.ClassJob{ID: 19}
+					s.EntitiesMap[1].Level = 90
+				},
+				nil,
+				[]models.EntityEvent{{StreamID: 1234, EntityID: 1, Type: models.UpdateClass{
+					ClassJob: &models.ClassJob{ID: 19}, Level: 90,
+				}}},
+			),
+		)
+	})
+
+	Context("when the history duration is short", func() {
+		BeforeEach(func() {
+			supervisor.Stop()
+
+			zapCfg := zap.NewDevelopmentConfig()
+			zapCfg.OutputPaths = []string{"providertest://"}
+			logger, err := zapCfg.Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			provider = store.NewProvider(
+				logger,
+				store.WithQueryTimeout(10*time.Millisecond),
+				store.WithHistory(30*time.Millisecond, 10*time.Millisecond),
+			)
+			supervisor = suture.New("test-provider", suture.Spec{FailureThreshold: 1})
+			supervisor.ServeBackground()
+			_ = supervisor.Add(provider)
+
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				s.Map[1234] = &stream1
+				s.KeyOrder = []int{1234}
+				return nil, nil, nil
+			})
+		})
+
+		It("discards the history that is older than the duration", func() {
+			start := time.Now()
+			Eventually(func() error {
+				_, err := provider.StreamAt(context.Background(), 1234, time.Now())
+				return err
+			}).Should(Succeed())
+			Eventually(func() error {
+				_, err := provider.StreamAt(context.Background(), 1234, start)
+				return err
+			}).Should(MatchError(HavePrefix("stream ID 1234: no history before")))
+		})
+
+		It("discards the history of a removed stream after the duration", func() {
+			Eventually(func() error {
+				_, err := provider.StreamAt(context.Background(), 1234, time.Now())
+				return err
+			}).Should(Succeed())
+			provider.UpdatesChan() <- testUpdate(func(s *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+				delete(s.Map, 1234)
+				s.KeyOrder = nil
+				return []models.StreamEvent{
+					{StreamID: 1234, Type: models.RemoveStream{ID: 1234}},
+				}, nil, nil
+			})
+			Eventually(func() error {
+				_, err := provider.StreamAt(context.Background(), 1234, time.Now())
+				return err
+			}).Should(MatchError("stream ID 1234 not found"))
+		})
+	})
 })
@@ -1,7 +1,10 @@
 package store
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/ff14wed/aetherometer/core/models"
 )
@@ -10,59 +13,45 @@ import (
 // query
 var ErrRequestTimedOut = errors.New("request timed out")
 
+// ErrHistoryDisabled is returned if the state of a stream at a past time is
+// queried but the store does not keep any history
+var ErrHistoryDisabled = errors.New("stream history is disabled")
+
 type internalRequest interface {
 	isInternalRequest()
 }
 
-type streamsRequest struct {
-	respChan chan []models.Stream
-}
-
-func (streamsRequest) isInternalRequest() {}
-
-func (p *Provider) handleStreamsRequest(req streamsRequest) {
-	streams := make([]models.Stream, len(p.streams.KeyOrder))
-	for i, k := range p.streams.KeyOrder {
-		streams[i] = p.streams.Map[k].Clone()
-	}
-	req.respChan <- streams
+type streamAtRequest struct {
+	ctx      context.Context
+	respChan chan streamAtResponse
+	streamID int
+	time     time.Time
 }
 
-type streamRequest struct {
-	respChan chan *models.Stream
-	streamID int
+type streamAtResponse struct {
+	stream *models.Stream
+	err    error
 }
 
-func (streamRequest) isInternalRequest() {}
+func (streamAtRequest) isInternalRequest() {}
 
-func (p *Provider) handleStreamRequest(req streamRequest) {
-	if s, found := p.streams.Map[req.streamID]; found {
-		sClone := s.Clone()
-		req.respChan <- &sClone
+func (p *Provider) handleStreamAtRequest(req streamAtRequest) {
+	if err := req.ctx.Err(); err != nil {
+		// Nobody is waiting on the response anymore, so skip the work of
+		// replaying the history
+		req.respChan <- streamAtResponse{err: err}
 		return
 	}
-	req.respChan <- nil
-}
-
-type entityRequest struct {
-	respChan chan *models.Entity
-	streamID int
-	entityID uint64
-}
-
-func (entityRequest) isInternalRequest() {}
-
-func (p *Provider) handleEntityRequest(req entityRequest) {
-	if s, found := p.streams.Map[req.streamID]; found {
-		if e, found := s.EntitiesMap[req.entityID]; found {
-			if e == nil {
-				req.respChan <- nil
-				return
-			}
-			eClone := e.Clone()
-			req.respChan <- &eClone
-			return
-		}
+	h, found := p.histories[req.streamID]
+	if !found {
+		req.respChan <- streamAtResponse{err: fmt.Errorf("stream ID %d not found", req.streamID)}
+		return
+	}
+	s, err := h.at(req.time)
+	if err != nil {
+		err = fmt.Errorf("stream ID %d: %w", req.streamID, err)
+	} else if s == nil {
+		err = fmt.Errorf("stream ID %d not found at %s", req.streamID, req.time.Format(time.RFC3339Nano))
 	}
-	req.respChan <- nil
+	req.respChan <- streamAtResponse{stream: s, err: err}
 }
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// SnapshotVersion is the version of the snapshot file format. Snapshots
+// written with a different version are not restored, since the structure of
+// the stored streams may have changed.
+const SnapshotVersion = 1
+
+type snapshot struct {
+	Version int              `json:"version"`
+	SavedAt time.Time        `json:"savedAt"`
+	Streams []*models.Stream `json:"streams"`
+}
+
+// SaveSnapshot writes the streams in the store to the snapshot file at path.
+// Only streams whose character is known are saved, since the state of the
+// other streams cannot be used until a zone change. The file is replaced
+// atomically so that a crash during the write does not corrupt the previous
+// snapshot.
+func SaveSnapshot(path string, streams *Streams) error {
+	s := snapshot{
+		Version: SnapshotVersion,
+		SavedAt: time.Now(),
+		Streams: []*models.Stream{},
+	}
+	for _, k := range streams.KeyOrder {
+		stream := streams.Map[k]
+		if stream == nil || stream.CharacterID == 0 {
+			continue
+		}
+		s.Streams = append(s.Streams, stream)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadSnapshot reads the streams from the snapshot file at path. It returns
+// no streams and no error if the file does not exist.
+func LoadSnapshot(path string) ([]*models.Stream, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d (expected %d)", s.Version, SnapshotVersion)
+	}
+	for _, stream := range s.Streams {
+		stream.Restored = true
+		if stream.EntitiesMap == nil {
+			stream.EntitiesMap = make(map[uint64]*models.Entity)
+		}
+		// Older versions kept removed entities in the map as nil entries
+		for id, e := range stream.EntitiesMap {
+			if e == nil {
+				delete(stream.EntitiesMap, id)
+			}
+		}
+	}
+	return s.Streams, nil
+}
@@ -0,0 +1,107 @@
+package store_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/store"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		snapshotFile string
+		streams      store.Streams
+	)
+
+	BeforeEach(func() {
+		snapshotFile = filepath.Join(GinkgoT().TempDir(), "snapshot.json")
+
+		streams = store.Streams{
+			Map: map[int]*models.Stream{
+				1234: {
+					ID:          1234,
+					CharacterID: 0x12345678,
+					Source:      &models.StreamSource{Adapter: "Hook", Key: "1234"},
+					Place:       models.Place{MapID: 12, TerritoryID: 34},
+					EntitiesMap: map[uint64]*models.Entity{
+						0x12345678: {ID: 0x12345678, Name: "FooBar", Location: &models.Location{X: 1, Y: 2, Z: 3}},
+					},
+				},
+				5678: {
+					ID:          5678,
+					Source:      &models.StreamSource{Adapter: "Hook", Key: "5678"},
+					EntitiesMap: map[uint64]*models.Entity{},
+				},
+			},
+			KeyOrder: []int{1234, 5678},
+		}
+	})
+
+	It("restores the saved streams and marks them as restored", func() {
+		Expect(store.SaveSnapshot(snapshotFile, &streams)).To(Succeed())
+
+		restored, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedStream := *streams.Map[1234]
+		expectedStream.Restored = true
+		Expect(restored).To(Equal([]*models.Stream{&expectedStream}))
+	})
+
+	It("drops the nil entries that older versions kept for removed entities", func() {
+		streams.Map[1234].EntitiesMap[0x23456789] = nil
+		Expect(store.SaveSnapshot(snapshotFile, &streams)).To(Succeed())
+
+		restored, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored).To(HaveLen(1))
+		Expect(restored[0].EntitiesMap).To(HaveLen(1))
+		Expect(restored[0].EntitiesMap).ToNot(HaveKey(uint64(0x23456789)))
+	})
+
+	It("does not save streams whose character is unknown", func() {
+		streams.Map[1234].CharacterID = 0
+		Expect(store.SaveSnapshot(snapshotFile, &streams)).To(Succeed())
+
+		restored, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored).To(BeEmpty())
+	})
+
+	It("replaces the previous snapshot", func() {
+		Expect(store.SaveSnapshot(snapshotFile, &streams)).To(Succeed())
+		streams.Map[1234].CharacterID = 0x23456789
+		Expect(store.SaveSnapshot(snapshotFile, &streams)).To(Succeed())
+
+		restored, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored).To(HaveLen(1))
+		Expect(restored[0].CharacterID).To(BeEquivalentTo(0x23456789))
+
+		files, err := os.ReadDir(filepath.Dir(snapshotFile))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+	})
+
+	It("returns no streams if the snapshot file does not exist", func() {
+		restored, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored).To(BeEmpty())
+	})
+
+	It("returns an error if the snapshot was saved with a different version", func() {
+		Expect(os.WriteFile(snapshotFile, []byte(`{"version":0,"streams":[]}`), 0644)).To(Succeed())
+		_, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).To(MatchError("unsupported snapshot version 0 (expected 1)"))
+	})
+
+	It("returns an error if the snapshot is corrupted", func() {
+		Expect(os.WriteFile(snapshotFile, []byte(`{"version":`), 0644)).To(Succeed())
+		_, err := store.LoadSnapshot(snapshotFile)
+		Expect(err).To(HaveOccurred())
+	})
+})
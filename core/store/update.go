@@ -7,6 +7,10 @@ import "github.com/ff14wed/aetherometer/core/models"
 type Streams struct {
 	Map      map[int]*models.Stream
 	KeyOrder []int
+
+	// Restored holds the streams restored from a snapshot that have not been
+	// re-associated with a live stream yet
+	Restored []*models.Stream
 }
 
 // Update defines the interface for making modifications to the streams store
@@ -15,6 +19,22 @@ type Streams struct {
 // compete with other updates running at the same time. It is assumed that
 // the resulting stream events are applied first and in order. Then the
 // resulting entity events are applied in order.
+// The events are also used to find the parts of the store that changed, so
+// they are expected to describe every change made by the update. An update
+// that returns no events, or that fails, is assumed to have changed the
+// entire store unless it implements ChangeReporter.
 type Update interface {
 	ModifyStore(streams *Streams) ([]models.StreamEvent, []models.EntityEvent, error)
 }
+
+// ChangeReporter is an optional interface that an Update may implement if it
+// can change a stream without emitting an event about it, such as when the
+// stream's status is updated but its state stays the same.
+//
+// ChangedStreams is called after ModifyStore and returns the streams that the
+// update modified in addition to the changes described by its events. The
+// entities of these streams are assumed to be unchanged unless the events
+// say otherwise. An update that changed nothing returns no streams.
+type ChangeReporter interface {
+	ChangedStreams() []int
+}
@@ -19,6 +19,7 @@ func newControlSelfUpdate(streamID int, b *xivnet.Block, d *datasheet.Collection
 		return removeEntityUpdate{
 			streamID:  streamID,
 			subjectID: uint64(data.P3),
+			time:      b.Time,
 		}
 	}
 	return nil
@@ -83,6 +83,10 @@ func validateEntityUpdate(streams *store.Streams, streamID int, entityID uint64,
 	}
 	entity, found := stream.EntitiesMap[entityID]
 	if !found {
+		// Packets about an entity can still arrive after it despawned
+		if stream.HasDeparted(entityID) {
+			return nil, nil, nil
+		}
 		return nil, nil, ErrorEntityNotFound
 	}
 	if entity == nil {
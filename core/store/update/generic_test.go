@@ -123,6 +123,29 @@ func entityValidationTests(testEnv *testVars, isEgress bool) {
 		Expect(validate.Validate(streams)).To(Succeed())
 	})
 
+	It("does nothing if the entity has departed from the stream", func() {
+		generator := testEnv.generator
+		b := testEnv.b
+		streams := testEnv.streams
+		streamID := testEnv.streamID
+
+		Expect(streams.Map[streamID].DepartEntity(0x99999999, models.DespawnReasonRemoved, time.Unix(101, 0))).To(BeTrue())
+		departed := streams.Map[streamID].Departed
+		b.SubjectID = 0x99999999
+
+		u := generator.Generate(streamID, isEgress, b)
+		Expect(u).ToNot(BeNil())
+
+		streamEvents, entityEvents, err := u.ModifyStore(streams)
+		Expect(err).To(BeNil())
+		Expect(streamEvents).To(BeEmpty())
+		Expect(entityEvents).To(BeEmpty())
+		Expect(streams.Map[streamID].EntitiesMap).ToNot(HaveKey(uint64(0x99999999)))
+		Expect(streams.Map[streamID].Departed).To(Equal(departed))
+
+		Expect(validate.Validate(streams)).To(Succeed())
+	})
+
 	It("does nothing if the entity is nil", func() {
 		generator := testEnv.generator
 		b := testEnv.b
@@ -65,6 +65,7 @@ func (u placeUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent,
 	stream.ServerID = u.serverID
 	stream.CharacterID = u.currentID
 	stream.InstanceNum = u.instanceNum
+	stream.Restored = false
 	streamEvents = append(streamEvents, models.StreamEvent{
 		StreamID: u.streamID,
 		Type: models.UpdateIDs{
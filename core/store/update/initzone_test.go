@@ -107,6 +107,16 @@ var _ = Describe("InitZone Update", func() {
 		Expect(validate.Validate(streams)).To(Succeed())
 	})
 
+	It("generates an update that marks a restored stream as fresh", func() {
+		streams.Map[streamID].Restored = true
+		u := generator.Generate(streamID, false, b)
+		Expect(u).ToNot(BeNil())
+		_, _, err := u.ModifyStore(streams)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(streams.Map[streamID].Restored).To(BeFalse())
+	})
+
 	It("generates an update that changes the place", func() {
 		u := generator.Generate(streamID, false, b)
 		Expect(u).ToNot(BeNil())
@@ -125,7 +135,7 @@ var _ = Describe("InitZone Update", func() {
 		Expect(validate.Validate(streams)).To(Succeed())
 	})
 
-	It("generates an update that clears the entity map", func() {
+	It("generates an update that clears the entity map without departing the entities", func() {
 		u := generator.Generate(streamID, false, b)
 		Expect(u).ToNot(BeNil())
 		_, entityEvents, err := u.ModifyStore(streams)
@@ -139,6 +149,7 @@ var _ = Describe("InitZone Update", func() {
 		Expect(eventType.Entities).To(BeNil())
 
 		Expect(streams.Map[streamID].EntitiesMap).To(BeEmpty())
+		Expect(streams.Map[streamID].Departed).To(BeEmpty())
 
 		Expect(validate.Validate(entityEvents)).To(Succeed())
 		Expect(validate.Validate(streams)).To(Succeed())
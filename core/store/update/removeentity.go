@@ -1,6 +1,8 @@
 package update
 
 import (
+	"time"
+
 	"github.com/ff14wed/aetherometer/core/datasheet"
 	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/store"
@@ -18,12 +20,14 @@ func newRemoveEntityUpdate(streamID int, b *xivnet.Block, d *datasheet.Collectio
 	return removeEntityUpdate{
 		streamID:  streamID,
 		subjectID: uint64(data.ID),
+		time:      b.Time,
 	}
 }
 
 type removeEntityUpdate struct {
 	streamID  int
 	subjectID uint64
+	time      time.Time
 }
 
 func (u removeEntityUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
@@ -31,13 +35,15 @@ func (u removeEntityUpdate) ModifyStore(streams *store.Streams) ([]models.Stream
 	if !found {
 		return nil, nil, ErrorStreamNotFound
 	}
-	stream.EntitiesMap[u.subjectID] = nil
+	stream.DepartEntity(u.subjectID, models.DespawnReasonRemoved, u.time)
 
 	return nil, []models.EntityEvent{{
 		StreamID: u.streamID,
 		EntityID: u.subjectID,
 		Type: models.RemoveEntity{
-			ID: u.subjectID,
+			ID:     u.subjectID,
+			Reason: models.DespawnReasonRemoved,
+			Time:   u.time,
 		},
 	}}, nil
 }
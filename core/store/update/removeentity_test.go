@@ -48,10 +48,18 @@ var _ = Describe("RemoveEntity Update", func() {
 		Expect(entityEvents[0].EntityID).To(Equal(removableID))
 		eventType, assignable := entityEvents[0].Type.(models.RemoveEntity)
 		Expect(assignable).To(BeTrue())
-		Expect(eventType.ID).To(Equal(removableID))
-
-		Expect(streams.Map[streamID].EntitiesMap).To(HaveKey(removableID))
-		Expect(streams.Map[streamID].EntitiesMap[removableID]).To(BeNil())
+		Expect(eventType).To(Equal(models.RemoveEntity{
+			ID:     removableID,
+			Reason: models.DespawnReasonRemoved,
+			Time:   b.Time,
+		}))
+
+		Expect(streams.Map[streamID].EntitiesMap).ToNot(HaveKey(removableID))
+		Expect(streams.Map[streamID].Departed).To(HaveLen(1))
+		departed := streams.Map[streamID].Departed[0]
+		Expect(departed.Entity.ID).To(Equal(removableID))
+		Expect(departed.DespawnTime).To(Equal(b.Time))
+		Expect(departed.Reason).To(Equal(models.DespawnReasonRemoved))
 
 		Expect(validate.Validate(entityEvents)).To(Succeed())
 		Expect(validate.Validate(streams)).To(Succeed())
@@ -83,8 +91,8 @@ var _ = Describe("RemoveEntity Update", func() {
 			Expect(assignable).To(BeTrue())
 			Expect(eventType.ID).To(Equal(nonexistentID))
 
-			Expect(streams.Map[streamID].EntitiesMap).To(HaveKey(nonexistentID))
-			Expect(streams.Map[streamID].EntitiesMap[nonexistentID]).To(BeNil())
+			Expect(streams.Map[streamID].EntitiesMap).ToNot(HaveKey(nonexistentID))
+			Expect(streams.Map[streamID].Departed).To(BeEmpty())
 
 			Expect(validate.Validate(entityEvents)).To(Succeed())
 			Expect(validate.Validate(streams)).To(Succeed())
@@ -157,6 +157,7 @@ func generateSpawnUpdate(
 	return entitySpawnUpdate{
 		streamID:  streamID,
 		subjectID: subjectID,
+		time:      now,
 
 		isWorldSet:   isWorldSet,
 		homeWorld:    homeWorld,
@@ -169,6 +170,7 @@ func generateSpawnUpdate(
 type entitySpawnUpdate struct {
 	streamID  int
 	subjectID uint64
+	time      time.Time
 
 	isWorldSet   bool
 	homeWorld    models.World
@@ -192,12 +194,14 @@ func (u entitySpawnUpdate) ModifyStore(streams *store.Streams) ([]models.StreamE
 		if ent == nil || ent.Index != u.entity.Index {
 			continue
 		}
-		stream.EntitiesMap[key] = nil
+		stream.DepartEntity(key, models.DespawnReasonIndexReused, u.time)
 		entityEvents = append(entityEvents, models.EntityEvent{
 			StreamID: u.streamID,
 			EntityID: key,
 			Type: models.RemoveEntity{
-				ID: key,
+				ID:     key,
+				Reason: models.DespawnReasonIndexReused,
+				Time:   u.time,
 			},
 		})
 		break
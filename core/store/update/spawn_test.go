@@ -299,7 +299,11 @@ var _ = Describe("Spawn Update", func() {
 			Expect(entityEvents[0].EntityID).To(Equal(removableID))
 			removeEvent, assignable := entityEvents[0].Type.(models.RemoveEntity)
 			Expect(assignable).To(BeTrue())
-			Expect(removeEvent.ID).To(Equal(removableID))
+			Expect(removeEvent).To(Equal(models.RemoveEntity{
+				ID:     removableID,
+				Reason: models.DespawnReasonIndexReused,
+				Time:   b.Time,
+			}))
 
 			Expect(entityEvents[1].StreamID).To(Equal(streamID))
 			Expect(entityEvents[1].EntityID).To(Equal(subjectID))
@@ -311,6 +315,13 @@ var _ = Describe("Spawn Update", func() {
 			Expect(streams.Map[streamID].EntitiesMap[subjectID]).To(
 				gstruct.PointTo(gstruct.MatchAllFields(expectedEntityFields)),
 			)
+			Expect(streams.Map[streamID].EntitiesMap).ToNot(HaveKey(removableID))
+
+			departed := streams.Map[streamID].Departed
+			Expect(departed).To(HaveLen(1))
+			Expect(departed[0].Entity.ID).To(Equal(removableID))
+			Expect(departed[0].DespawnTime).To(Equal(b.Time))
+			Expect(departed[0].Reason).To(Equal(models.DespawnReasonIndexReused))
 
 			Expect(validate.Validate(entityEvents)).To(Succeed())
 			Expect(validate.Validate(streams)).To(Succeed())
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// view is an immutable copy of the store that the provider publishes for
+// readers after applying a batch of updates. Nothing reachable from a view is
+// ever modified, so views can be read from any goroutine without locking.
+//
+// To keep publishing cheap, a view shares the copies of the streams and
+// entities that did not change since the previous view. Only the streams and
+// entities touched by the batch of updates are copied again.
+type view struct {
+	streams  map[int]*models.Stream
+	keyOrder []int
+}
+
+// changes tracks the parts of the store touched by the updates applied since
+// the last view was published. The changes are derived from the events
+// emitted by the updates.
+type changes struct {
+	all      bool
+	keyOrder bool
+	streams  map[int]*streamChanges
+}
+
+type streamChanges struct {
+	allEntities bool
+	entities    map[uint64]struct{}
+}
+
+func (c *changes) empty() bool {
+	return !c.all && !c.keyOrder && len(c.streams) == 0
+}
+
+func (c *changes) stream(streamID int) *streamChanges {
+	if c.streams == nil {
+		c.streams = make(map[int]*streamChanges)
+	}
+	sc, found := c.streams[streamID]
+	if !found {
+		sc = &streamChanges{entities: make(map[uint64]struct{})}
+		c.streams[streamID] = sc
+	}
+	return sc
+}
+
+// record marks the parts of the store touched by an update. Since the events
+// of an update are expected to describe all of its changes, an update that
+// emits no events or fails is assumed to have changed anything, unless it
+// reports the streams it changed itself.
+func (c *changes) record(u Update, streamEvents []models.StreamEvent, entityEvents []models.EntityEvent, err error) {
+	if r, ok := u.(ChangeReporter); ok {
+		for _, streamID := range r.ChangedStreams() {
+			c.stream(streamID)
+		}
+	} else if err != nil || (len(streamEvents) == 0 && len(entityEvents) == 0) {
+		c.all = true
+		return
+	}
+	for _, e := range streamEvents {
+		sc := c.stream(e.StreamID)
+		switch e.Type.(type) {
+		case models.AddStream, models.RemoveStream:
+			c.keyOrder = true
+			sc.allEntities = true
+		}
+	}
+	for _, e := range entityEvents {
+		sc := c.stream(e.StreamID)
+		sc.entities[e.EntityID] = struct{}{}
+		switch t := e.Type.(type) {
+		case models.SetEntities:
+			sc.allEntities = true
+		case models.RemoveEntity:
+			sc.entities[t.ID] = struct{}{}
+		}
+	}
+}
+
+// next returns a new view of the streams that shares everything left
+// untouched by the changes with the view v
+func (v *view) next(streams *Streams, c *changes) *view {
+	nv := &view{
+		streams:  make(map[int]*models.Stream, len(streams.Map)),
+		keyOrder: v.keyOrder,
+	}
+	if c.all || c.keyOrder {
+		nv.keyOrder = make([]int, len(streams.KeyOrder))
+		copy(nv.keyOrder, streams.KeyOrder)
+	}
+	for id, s := range streams.Map {
+		if s == nil {
+			continue
+		}
+		prev := v.streams[id]
+		sc, changed := c.streams[id]
+		if prev != nil && !c.all && !changed {
+			nv.streams[id] = prev
+			continue
+		}
+		if c.all {
+			prev = nil
+		}
+		nv.streams[id] = freezeStream(s, prev, sc)
+	}
+	return nv
+}
+
+// freezeStream returns a copy of the stream for a view. The copies of the
+// entities in prev are reused unless they were changed according to sc.
+func freezeStream(s *models.Stream, prev *models.Stream, sc *streamChanges) *models.Stream {
+	frozen := *s
+	frozen.EntitiesMap = nil
+	frozen.Departed = nil
+	frozen = frozen.Clone()
+	// Departed entities are never modified and the store only ever discards
+	// them from the front or appends to the end, so the view can share them
+	// as long as the length is capped.
+	frozen.Departed = s.Departed[:len(s.Departed):len(s.Departed)]
+	if s.Stats != nil {
+		statsClone := *s.Stats
+		frozen.Stats = &statsClone
+	}
+
+	if s.EntitiesMap == nil {
+		return &frozen
+	}
+	canShare := prev != nil && sc != nil && !sc.allEntities
+	if canShare && len(sc.entities) == 0 && len(prev.EntitiesMap) == len(s.EntitiesMap) && prev.EntitiesMap != nil {
+		frozen.EntitiesMap = prev.EntitiesMap
+		return &frozen
+	}
+	frozen.EntitiesMap = make(map[uint64]*models.Entity, len(s.EntitiesMap))
+	for id, e := range s.EntitiesMap {
+		if e == nil {
+			frozen.EntitiesMap[id] = nil
+			continue
+		}
+		if canShare {
+			if _, dirty := sc.entities[id]; !dirty {
+				if prevEntity := prev.EntitiesMap[id]; prevEntity != nil {
+					frozen.EntitiesMap[id] = prevEntity
+					continue
+				}
+			}
+		}
+		entityClone := e.Clone()
+		frozen.EntitiesMap[id] = &entityClone
+	}
+	return &frozen
+}
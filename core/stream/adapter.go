@@ -2,6 +2,7 @@ package stream
 
 import (
 	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/xivnet/v3"
 	"github.com/thejerf/suture"
 	"go.uber.org/zap"
@@ -17,8 +18,14 @@ import (
 // into the correct xivnet datatype. This is to ensure backwards compatibility
 // with older data when the datatype opcodes are updated.
 type Provider interface {
-	// StreamID returns a unique identifier for the stream. This identifier
-	// must be unique across all adapters.
+	// StreamID returns an identifier for the stream. This identifier must be
+	// unique among the streams opened by the adapter, and it is used to
+	// identify the stream when the adapter notifies the core that the stream
+	// has closed.
+	//
+	// The core assigns each stream an ID that is unique across all adapters,
+	// and it prefers to use this identifier as long as it does not collide
+	// with the stream of another adapter.
 	StreamID() int
 	// SubscribeIngress notifies the core of network packets in the ingress
 	// direction from this stream.
@@ -31,6 +38,60 @@ type Provider interface {
 	SendRequest(req []byte) (resp []byte, err error)
 }
 
+// ResetBlock is a marker that a Provider sends on both its ingress and egress
+// channels when the state of its stream must be rebuilt from scratch, such as
+// when seeking backwards through a recorded session.
+//
+// Once the marker has been received on both channels, the stream's state in
+// the store is replaced with an empty stream. Blocks sent before the marker on
+// either channel are discarded, and blocks sent after it are applied to the
+// new stream.
+var ResetBlock = &xivnet.Block{}
+
+// SourceKeyer is an optional interface that a Provider may implement to
+// describe the source of its stream within its adapter, such as a process ID,
+// an endpoint, or a file. If it is not implemented, the stream ID reported by
+// the Provider is used as the key.
+type SourceKeyer interface {
+	SourceKey() string
+}
+
+// CommandLister is an optional interface that a Provider may implement to
+// describe the commands that can be sent to it through SendRequest, so that
+// clients can discover them with the streamCommands query.
+type CommandLister interface {
+	Commands() []models.StreamCommand
+}
+
+// HealthReporter is an optional interface that an Adapter or a Provider may
+// implement to report on the health of its connection to the data source.
+//
+// The health of a Provider is polled periodically and stored as the status of
+// its stream, and a StreamEvent is emitted whenever the state or the last error
+// of the stream changes. The health of an Adapter is reported by the adapters
+// query.
+type HealthReporter interface {
+	Health() models.Health
+}
+
+// HealthNotifier is an optional interface that a Provider may implement to
+// push the health of its connection to the data source whenever its state
+// changes, such as when the connection is lost and is being re-established.
+// Unlike the periodic polling of a HealthReporter, every transition is
+// recorded as the status of the stream and emitted as a StreamEvent.
+type HealthNotifier interface {
+	SubscribeHealth() <-chan models.Health
+}
+
+// HookMessageReporter is an optional interface that a Provider may implement
+// to report the messages sent by the hook of its stream, such as warnings
+// about an incompatible game build. The most recent messages can be queried
+// per stream, and every new message is emitted as a StreamEvent.
+type HookMessageReporter interface {
+	Messages() []models.HookMessage
+	SubscribeMessages() <-chan models.HookMessage
+}
+
 // Adapter defines an interface that translates data from data sources into
 // streams that the core server can consume data from. Each stream provided
 // by the adapter is wrapped in a Provider in order for the core server to
@@ -49,6 +110,18 @@ type Adapter interface {
 	suture.Service
 }
 
+// ProcessAttacher is an optional interface that an Adapter may implement to
+// let users choose which of the candidate processes it attaches to, rather
+// than attaching to every matching process automatically.
+//
+// The StreamID of each candidate is the adapter's own ID for the stream of the
+// process, if it is attached. The Adapter field is filled in by the Manager.
+type ProcessAttacher interface {
+	CandidateProcesses() []models.ProcessCandidate
+	AttachProcess(pid int) error
+	DetachProcess(streamID int) error
+}
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AdapterBuilder
 
 // AdapterBuilder represents a set of methods that instantiates the adapter.
@@ -59,8 +132,10 @@ type AdapterBuilder interface {
 	// the configuration is first loaded.
 	//
 	// If LoadConfig returns an error, Build will not be called for this
-	// AdapterBuilder. This error is considered fatal and will cause the server to
-	// exit.
+	// AdapterBuilder. When the configuration is first loaded, this error is
+	// considered fatal and will cause the server to exit. When the
+	// configuration changes while the server is running, the adapter is left
+	// stopped and the error is reported as the adapter's health.
 	//
 	// Adapter authors should add an Enabled field in the adapter configuration
 	// struct to conditionally disable the adapter. If the adapter is disabled in
@@ -80,6 +155,15 @@ type AdapterBuilder interface {
 	Build(streamUp chan<- Provider, streamDown chan<- int, logger *zap.Logger) Adapter
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AdapterRegistry
+
+// AdapterRegistry keeps track of the adapters that are currently running so
+// that they can be listed along with their health.
+type AdapterRegistry interface {
+	RegisterAdapter(name string, adapter Adapter)
+	UnregisterAdapter(name string)
+}
+
 // AdapterInfo lists information about this Adapter and provides a builder
 // for the Adapter.
 type AdapterInfo struct {
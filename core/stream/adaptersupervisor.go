@@ -0,0 +1,263 @@
+package stream
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+)
+
+// adapterStopTimeout is how long to wait for an adapter to shut down when it
+// is removed
+const adapterStopTimeout = 30 * time.Second
+
+// AdapterSupervisor is responsible for running the enabled adapters in the
+// inventory under the parent supervisor. It watches for configuration changes
+// and stops, rebuilds, and restarts any adapter that has been enabled,
+// disabled, or reconfigured. Whenever an adapter is stopped, any of its
+// streams that are still open are closed via the StreamDown channel.
+//
+// Running adapters are registered with the AdapterRegistry so that they can be
+// listed along with their health. An adapter that fails to load its
+// configuration is registered as CLOSED, with the error as its last error,
+// until the next configuration change.
+//
+// Only the adapter's own configuration section is compared when detecting
+// changes, so changes to configuration outside of this section do not cause
+// an adapter to be rebuilt.
+type AdapterSupervisor struct {
+	inventory   []AdapterInfo
+	cfgProvider *config.Provider
+	parent      *suture.Supervisor
+	registry    AdapterRegistry
+	streamUp    chan<- StreamUpEvent
+	streamDown  chan<- StreamDownEvent
+	// adapterLogger is the logger provided to the adapters
+	adapterLogger *zap.Logger
+	logger        *zap.Logger
+
+	running map[string]*runningAdapter
+	failed  map[string]struct{}
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// runningAdapter tracks an adapter added to the parent supervisor, as well as
+// the streams that it has opened
+type runningAdapter struct {
+	name  string
+	cfg   config.Adapters
+	token suture.ServiceToken
+
+	streamUp   chan Provider
+	streamDown chan int
+	streams    map[int]struct{}
+
+	stop     chan struct{}
+	stopDone chan struct{}
+}
+
+// NewAdapterSupervisor returns a new AdapterSupervisor. The adapters are not
+// started until the AdapterSupervisor is running.
+func NewAdapterSupervisor(
+	inventory []AdapterInfo,
+	cfgProvider *config.Provider,
+	parent *suture.Supervisor,
+	registry AdapterRegistry,
+	streamUp chan<- StreamUpEvent,
+	streamDown chan<- StreamDownEvent,
+	logger *zap.Logger,
+) *AdapterSupervisor {
+	return &AdapterSupervisor{
+		inventory:   inventory,
+		cfgProvider: cfgProvider,
+		parent:      parent,
+		registry:    registry,
+		streamUp:    streamUp,
+		streamDown:  streamDown,
+
+		adapterLogger: logger,
+		logger:        logger.Named("adapter-supervisor"),
+
+		running: make(map[string]*runningAdapter),
+		failed:  make(map[string]struct{}),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+}
+
+// LoadConfig loads the configuration for each of the enabled adapters in order
+// to check that the configuration is valid. It returns an error if any of the
+// adapters fails to load its configuration.
+func (s *AdapterSupervisor) LoadConfig(cfg config.Config) error {
+	for _, info := range s.inventory {
+		if !cfg.Adapters.IsEnabled(info.Name) {
+			continue
+		}
+		if err := info.Builder.LoadConfig(cfg); err != nil {
+			return fmt.Errorf("error creating adapter %s: %s", info.Name, err)
+		}
+	}
+	return nil
+}
+
+// Serve runs the service responsible for starting the enabled adapters and
+// updating them whenever the configuration changes. Adapters are left running
+// when the AdapterSupervisor stops, since they are stopped along with the
+// parent supervisor.
+func (s *AdapterSupervisor) Serve() {
+	defer close(s.stopDone)
+	updatesCh, updatesChID := s.cfgProvider.UpdateEvents.Subscribe()
+	defer s.cfgProvider.UpdateEvents.Unsubscribe(updatesChID)
+
+	s.logger.Info("Running")
+	s.apply(s.cfgProvider.Config())
+	for {
+		select {
+		case <-updatesCh:
+			s.apply(s.cfgProvider.Config())
+		case <-s.stop:
+			s.logger.Info("Stopping...")
+			return
+		}
+	}
+}
+
+// Stop will shutdown this service and wait on it to stop before returning
+func (s *AdapterSupervisor) Stop() {
+	close(s.stop)
+	<-s.stopDone
+}
+
+// apply starts, stops, and rebuilds adapters to match the configuration
+func (s *AdapterSupervisor) apply(cfg config.Config) {
+	for _, info := range s.inventory {
+		enabled := cfg.Adapters.IsEnabled(info.Name)
+		ra, isRunning := s.running[info.Name]
+		if isRunning && enabled && ra.cfg.IsAdapterConfigEqual(cfg.Adapters, info.Name) {
+			continue
+		}
+		if isRunning {
+			s.logger.Info("Stopping adapter", zap.String("adapter", info.Name))
+			s.stopAdapter(info.Name, ra)
+		}
+		if _, failed := s.failed[info.Name]; failed {
+			s.registry.UnregisterAdapter(info.Name)
+			delete(s.failed, info.Name)
+		}
+		if enabled {
+			s.logger.Info("Starting adapter", zap.String("adapter", info.Name))
+			if err := s.startAdapter(info, cfg); err != nil {
+				s.logger.Error("Failed to start adapter", zap.String("adapter", info.Name), zap.Error(err))
+				s.registry.RegisterAdapter(info.Name, failedAdapter{err: err})
+				s.failed[info.Name] = struct{}{}
+			}
+		}
+	}
+}
+
+func (s *AdapterSupervisor) startAdapter(info AdapterInfo, cfg config.Config) error {
+	if err := info.Builder.LoadConfig(cfg); err != nil {
+		return err
+	}
+	ra := &runningAdapter{
+		name: info.Name,
+		cfg:  cfg.Adapters,
+
+		streamUp:   make(chan Provider, 64),
+		streamDown: make(chan int, 64),
+		streams:    make(map[int]struct{}),
+
+		stop:     make(chan struct{}),
+		stopDone: make(chan struct{}),
+	}
+	adapter := info.Builder.Build(ra.streamUp, ra.streamDown, s.adapterLogger)
+	s.registry.RegisterAdapter(info.Name, adapter)
+	go ra.forward(s.streamUp, s.streamDown)
+	ra.token = s.parent.Add(adapter)
+	s.running[info.Name] = ra
+	return nil
+}
+
+func (s *AdapterSupervisor) stopAdapter(name string, ra *runningAdapter) {
+	s.registry.UnregisterAdapter(name)
+	if err := s.parent.RemoveAndWait(ra.token, adapterStopTimeout); err != nil {
+		s.logger.Error("Error removing adapter",
+			zap.String("adapter", name),
+			zap.Error(err),
+		)
+	}
+	close(ra.stop)
+	<-ra.stopDone
+	ra.drain()
+	delete(s.running, name)
+	for streamID := range ra.streams {
+		select {
+		case s.streamDown <- StreamDownEvent{Adapter: name, StreamID: streamID}:
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// failedAdapter stands in for an adapter that failed to load its
+// configuration, so that the error is reported along with the other adapters.
+// It is never run.
+type failedAdapter struct {
+	err error
+}
+
+func (a failedAdapter) Serve() {}
+
+func (a failedAdapter) Stop() {}
+
+func (a failedAdapter) Health() models.Health {
+	errStr := a.err.Error()
+	return models.Health{State: models.HealthStateClosed, LastError: &errStr}
+}
+
+// forward passes stream notifications from the adapter along to the core,
+// keeping track of which of the adapter's streams are open
+func (ra *runningAdapter) forward(streamUp chan<- StreamUpEvent, streamDown chan<- StreamDownEvent) {
+	defer close(ra.stopDone)
+	for {
+		select {
+		case sp := <-ra.streamUp:
+			select {
+			case streamUp <- StreamUpEvent{Adapter: ra.name, Provider: sp}:
+				ra.streams[sp.StreamID()] = struct{}{}
+			case <-ra.stop:
+				return
+			}
+		case streamID := <-ra.streamDown:
+			select {
+			case streamDown <- StreamDownEvent{Adapter: ra.name, StreamID: streamID}:
+				delete(ra.streams, streamID)
+			case <-ra.stop:
+				return
+			}
+		case <-ra.stop:
+			return
+		}
+	}
+}
+
+// drain discards any notifications that the adapter sent before it stopped
+// but were not forwarded. Streams that never made it to the core don't need
+// to be closed.
+func (ra *runningAdapter) drain() {
+	for {
+		select {
+		case <-ra.streamUp:
+		case streamID := <-ra.streamDown:
+			delete(ra.streams, streamID)
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,297 @@
+package stream_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/stream/streamfakes"
+	"github.com/ff14wed/aetherometer/core/testhelpers"
+	"github.com/thejerf/suture"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+// FakeAdapter opens a single stream when it starts, and optionally closes
+// the stream when asked to
+type FakeAdapter struct {
+	streamID   int
+	streamUp   chan<- stream.Provider
+	streamDown chan<- int
+
+	stopCalled uint32
+	stop       chan struct{}
+}
+
+func (f *FakeAdapter) Serve() {
+	p := new(streamfakes.FakeProvider)
+	p.StreamIDReturns(f.streamID)
+	f.streamUp <- p
+	<-f.stop
+}
+
+func (f *FakeAdapter) Stop() {
+	atomic.StoreUint32(&f.stopCalled, 1)
+	close(f.stop)
+}
+
+func (f *FakeAdapter) StopCalled() bool {
+	return atomic.LoadUint32(&f.stopCalled) == 1
+}
+
+func fakeAdapterBuilder(streamID int, adapters *[]*FakeAdapter, lock *sync.Mutex) *streamfakes.FakeAdapterBuilder {
+	b := new(streamfakes.FakeAdapterBuilder)
+	b.BuildStub = func(streamUp chan<- stream.Provider, streamDown chan<- int, _ *zap.Logger) stream.Adapter {
+		lock.Lock()
+		defer lock.Unlock()
+		a := &FakeAdapter{
+			streamID:   streamID,
+			streamUp:   streamUp,
+			streamDown: streamDown,
+			stop:       make(chan struct{}),
+		}
+		*adapters = append(*adapters, a)
+		return a
+	}
+	return b
+}
+
+var _ = Describe("AdapterSupervisor", func() {
+	var (
+		adapterSupervisor *stream.AdapterSupervisor
+		parent            *suture.Supervisor
+		cfgProvider       *config.Provider
+		registry          *streamfakes.FakeAdapterRegistry
+
+		hookBuilder, replayBuilder *streamfakes.FakeAdapterBuilder
+		hookAdapters               []*FakeAdapter
+		replayAdapters             []*FakeAdapter
+		adaptersLock               sync.Mutex
+
+		streamUp   chan stream.StreamUpEvent
+		streamDown chan stream.StreamDownEvent
+
+		logBuf *testhelpers.LogBuffer
+		once   sync.Once
+	)
+
+	hookAdapter := func(i int) *FakeAdapter {
+		adaptersLock.Lock()
+		defer adaptersLock.Unlock()
+		return hookAdapters[i]
+	}
+
+	mutateAdapters := func(mutate func(a *config.Adapters)) {
+		Expect(cfgProvider.MutateConfig(func(cfg config.Config) (config.Config, error) {
+			mutate(&cfg.Adapters)
+			return cfg, nil
+		})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		once.Do(func() {
+			logBuf = new(testhelpers.LogBuffer)
+			err := zap.RegisterSink("adaptersupervisortest", func(*url.URL) (zap.Sink, error) {
+				return logBuf, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		logBuf.Reset()
+		zapCfg := zap.NewDevelopmentConfig()
+		zapCfg.OutputPaths = []string{"adaptersupervisortest://"}
+		logger, err := zapCfg.Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		cfgProvider = config.NewProvider(
+			filepath.Join(GinkgoT().TempDir(), "config.toml"),
+			config.Config{
+				APIPort: 9000,
+				Sources: config.Sources{
+					DataPath: os.TempDir(),
+					Maps:     config.MapConfig{Cache: os.TempDir()},
+				},
+				Adapters: config.Adapters{
+					Hook: config.HookConfig{Enabled: true, FFXIVProcess: "ffxiv_dx11.exe"},
+				},
+			},
+			logger,
+		)
+		Expect(cfgProvider.EnsureConfigFile()).To(Succeed())
+
+		hookAdapters = nil
+		replayAdapters = nil
+		hookBuilder = fakeAdapterBuilder(1, &hookAdapters, &adaptersLock)
+		replayBuilder = fakeAdapterBuilder(2, &replayAdapters, &adaptersLock)
+
+		streamUp = make(chan stream.StreamUpEvent, 10)
+		streamDown = make(chan stream.StreamDownEvent, 10)
+
+		parent = suture.New("test-parent", suture.Spec{
+			Log: func(line string) {
+				_, _ = GinkgoWriter.Write([]byte(line))
+			},
+			FailureThreshold: 1,
+		})
+		parent.ServeBackground()
+
+		registry = new(streamfakes.FakeAdapterRegistry)
+		adapterSupervisor = stream.NewAdapterSupervisor(
+			[]stream.AdapterInfo{
+				{Name: "Hook", Builder: hookBuilder},
+				{Name: "Replay", Builder: replayBuilder},
+			},
+			cfgProvider,
+			parent,
+			registry,
+			streamUp,
+			streamDown,
+			logger,
+		)
+	})
+
+	JustBeforeEach(func() {
+		parent.Add(adapterSupervisor)
+		Eventually(logBuf).Should(gbytes.Say(`adapter-supervisor.*Running`))
+	})
+
+	AfterEach(func() {
+		parent.Stop()
+	})
+
+	It("starts the enabled adapters", func() {
+		var e stream.StreamUpEvent
+		Eventually(streamUp).Should(Receive(&e))
+		Expect(e.Adapter).To(Equal("Hook"))
+		Expect(e.Provider.StreamID()).To(Equal(1))
+		Expect(hookBuilder.BuildCallCount()).To(Equal(1))
+		Expect(replayBuilder.LoadConfigCallCount()).To(Equal(0))
+		Expect(replayBuilder.BuildCallCount()).To(Equal(0))
+	})
+
+	It("registers the running adapters", func() {
+		Eventually(streamUp).Should(Receive())
+		Expect(registry.RegisterAdapterCallCount()).To(Equal(1))
+		name, adapter := registry.RegisterAdapterArgsForCall(0)
+		Expect(name).To(Equal("Hook"))
+		Expect(adapter).To(Equal(hookAdapter(0)))
+
+		mutateAdapters(func(a *config.Adapters) {
+			a.Hook.Enabled = false
+		})
+		Eventually(registry.UnregisterAdapterCallCount).Should(Equal(1))
+		Expect(registry.UnregisterAdapterArgsForCall(0)).To(Equal("Hook"))
+	})
+
+	It("starts an adapter once it is enabled", func() {
+		Eventually(streamUp).Should(Receive())
+		mutateAdapters(func(a *config.Adapters) {
+			a.Replay.Enabled = true
+		})
+
+		var e stream.StreamUpEvent
+		Eventually(streamUp).Should(Receive(&e))
+		Expect(e.Adapter).To(Equal("Replay"))
+		Expect(e.Provider.StreamID()).To(Equal(2))
+		Expect(replayBuilder.BuildCallCount()).To(Equal(1))
+		cfg := replayBuilder.LoadConfigArgsForCall(0)
+		Expect(cfg.Adapters.Replay.Enabled).To(BeTrue())
+
+		By("leaving the unchanged adapters running")
+		Expect(hookBuilder.BuildCallCount()).To(Equal(1))
+		Expect(hookAdapter(0).StopCalled()).To(BeFalse())
+	})
+
+	It("stops an adapter and closes its streams once it is disabled", func() {
+		Eventually(streamUp).Should(Receive())
+		mutateAdapters(func(a *config.Adapters) {
+			a.Hook.Enabled = false
+		})
+
+		Eventually(streamDown).Should(Receive(Equal(stream.StreamDownEvent{Adapter: "Hook", StreamID: 1})))
+		Expect(hookAdapter(0).StopCalled()).To(BeTrue())
+		Consistently(streamUp).ShouldNot(Receive())
+		Expect(hookBuilder.BuildCallCount()).To(Equal(1))
+	})
+
+	It("rebuilds an adapter when its configuration changes", func() {
+		Eventually(streamUp).Should(Receive())
+		mutateAdapters(func(a *config.Adapters) {
+			a.Hook.FFXIVProcess = "ffxiv.exe"
+		})
+
+		Eventually(streamDown).Should(Receive(Equal(stream.StreamDownEvent{Adapter: "Hook", StreamID: 1})))
+		Eventually(streamUp).Should(Receive())
+		Expect(hookAdapter(0).StopCalled()).To(BeTrue())
+		Expect(hookBuilder.BuildCallCount()).To(Equal(2))
+		cfg := hookBuilder.LoadConfigArgsForCall(1)
+		Expect(cfg.Adapters.Hook.FFXIVProcess).To(Equal("ffxiv.exe"))
+	})
+
+	It("does not close streams that the adapter already closed", func() {
+		Eventually(streamUp).Should(Receive())
+		hookAdapter(0).streamDown <- 1
+		Eventually(streamDown).Should(Receive(Equal(stream.StreamDownEvent{Adapter: "Hook", StreamID: 1})))
+
+		mutateAdapters(func(a *config.Adapters) {
+			a.Hook.Enabled = false
+		})
+		Eventually(hookAdapter(0).StopCalled).Should(BeTrue())
+		Consistently(streamDown).ShouldNot(Receive())
+	})
+
+	Context("when an adapter fails to load its configuration", func() {
+		BeforeEach(func() {
+			replayBuilder.LoadConfigReturns(errors.New("bad config"))
+		})
+
+		It("logs an error and does not start the adapter", func() {
+			Eventually(streamUp).Should(Receive())
+			mutateAdapters(func(a *config.Adapters) {
+				a.Replay.Enabled = true
+			})
+			Eventually(logBuf).Should(gbytes.Say(`Failed to start adapter.*"adapter": "Replay".*bad config`))
+			Expect(replayBuilder.BuildCallCount()).To(Equal(0))
+		})
+
+		It("reports the error as the health of the adapter until the configuration changes", func() {
+			Eventually(streamUp).Should(Receive())
+			mutateAdapters(func(a *config.Adapters) {
+				a.Replay.Enabled = true
+			})
+			Eventually(registry.RegisterAdapterCallCount).Should(Equal(2))
+			name, adapter := registry.RegisterAdapterArgsForCall(1)
+			Expect(name).To(Equal("Replay"))
+			health := adapter.(stream.HealthReporter).Health()
+			Expect(health.State).To(Equal(models.HealthStateClosed))
+			Expect(health.LastError).To(HaveValue(Equal("bad config")))
+
+			mutateAdapters(func(a *config.Adapters) {
+				a.Replay.Enabled = false
+			})
+			Eventually(registry.UnregisterAdapterCallCount).Should(Equal(1))
+			Expect(registry.UnregisterAdapterArgsForCall(0)).To(Equal("Replay"))
+		})
+	})
+
+	Describe("LoadConfig", func() {
+		It("loads the configuration of the enabled adapters", func() {
+			cfg := cfgProvider.Config()
+			Expect(adapterSupervisor.LoadConfig(cfg)).To(Succeed())
+
+			replayBuilder.LoadConfigReturns(errors.New("bad config"))
+			Expect(adapterSupervisor.LoadConfig(cfg)).To(Succeed())
+
+			cfg.Adapters.Replay.Enabled = true
+			Expect(adapterSupervisor.LoadConfig(cfg)).To(MatchError("error creating adapter Replay: bad config"))
+		})
+	})
+})
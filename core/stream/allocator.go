@@ -0,0 +1,68 @@
+package stream
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/ff14wed/aetherometer/core/models"
+)
+
+// IDAllocator assigns stream IDs that are unique across all adapters. It keeps
+// track of which source each stream ID was assigned to, where a source is
+// identified by the name of the adapter and a key that is local to the
+// adapter, such as a process ID, an endpoint, or a file.
+type IDAllocator struct {
+	ids     map[models.StreamSource]int
+	sources map[int]models.StreamSource
+	lock    sync.Mutex
+}
+
+// NewIDAllocator returns a new IDAllocator
+func NewIDAllocator() *IDAllocator {
+	return &IDAllocator{
+		ids:     make(map[models.StreamSource]int),
+		sources: make(map[int]models.StreamSource),
+	}
+}
+
+// Allocate assigns a stream ID to the source. The preferred ID is assigned if
+// it is not already in use so that stream IDs remain stable whenever
+// possible. Otherwise, the next free ID after the preferred ID is assigned.
+// It returns an error if the source already has a stream ID assigned to it.
+func (a *IDAllocator) Allocate(source models.StreamSource, preferredID int) (int, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if id, found := a.ids[source]; found {
+		return 0, fmt.Errorf("source %s/%s already has stream ID %d", source.Adapter, source.Key, id)
+	}
+
+	id := preferredID
+	if id <= 0 || id > math.MaxInt32 {
+		id = 1
+	}
+	for {
+		if _, taken := a.sources[id]; !taken {
+			break
+		}
+		id++
+		if id > math.MaxInt32 {
+			id = 1
+		}
+	}
+
+	a.ids[source] = id
+	a.sources[id] = source
+	return id, nil
+}
+
+// Release frees the stream ID so that it can be assigned to another source.
+func (a *IDAllocator) Release(id int) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if source, found := a.sources[id]; found {
+		delete(a.ids, source)
+		delete(a.sources, id)
+	}
+}
@@ -0,0 +1,56 @@
+package stream_test
+
+import (
+	"math"
+
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/stream"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IDAllocator", func() {
+	var (
+		allocator *stream.IDAllocator
+
+		hookSource   models.StreamSource
+		replaySource models.StreamSource
+	)
+
+	BeforeEach(func() {
+		allocator = stream.NewIDAllocator()
+		hookSource = models.StreamSource{Adapter: "Hook", Key: "1234"}
+		replaySource = models.StreamSource{Adapter: "Replay", Key: "session.aethsess"}
+	})
+
+	It("assigns the preferred ID if it is free", func() {
+		Expect(allocator.Allocate(hookSource, 1234)).To(Equal(1234))
+	})
+
+	It("assigns the next free ID if the preferred ID is taken", func() {
+		Expect(allocator.Allocate(hookSource, 1234)).To(Equal(1234))
+		Expect(allocator.Allocate(models.StreamSource{Adapter: "Socket", Key: "tcp://foo"}, 1235)).To(Equal(1235))
+		Expect(allocator.Allocate(replaySource, 1234)).To(Equal(1236))
+	})
+
+	It("errors if the source already has an ID", func() {
+		Expect(allocator.Allocate(hookSource, 1234)).To(Equal(1234))
+		_, err := allocator.Allocate(hookSource, 5678)
+		Expect(err).To(MatchError("source Hook/1234 already has stream ID 1234"))
+	})
+
+	It("allows the ID to be reassigned once it is released", func() {
+		Expect(allocator.Allocate(hookSource, 1234)).To(Equal(1234))
+		allocator.Release(1234)
+
+		Expect(allocator.Allocate(replaySource, 1234)).To(Equal(1234))
+		Expect(allocator.Allocate(hookSource, 1234)).To(Equal(1235))
+	})
+
+	It("only assigns positive IDs that fit in 32 bits", func() {
+		Expect(allocator.Allocate(hookSource, 0)).To(Equal(1))
+		Expect(allocator.Allocate(replaySource, math.MaxInt32)).To(Equal(math.MaxInt32))
+		Expect(allocator.Allocate(models.StreamSource{Adapter: "Socket", Key: "tcp://foo"}, math.MaxInt32)).To(Equal(2))
+	})
+})
@@ -2,6 +2,7 @@ package stream
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/store"
@@ -14,24 +15,41 @@ import (
 // adapters and generating updates with them
 type handler struct {
 	streamID    int
+	source      models.StreamSource
 	ingressChan <-chan *xivnet.Block
 	egressChan  <-chan *xivnet.Block
+	healthChan  <-chan models.Health
+	messageChan <-chan models.HookMessage
 	updateChan  chan<- store.Update
 	generator   update.Generator
+	throttle    *Throttle
 	logger      *zap.Logger
 
+	// restoreRequested is set once the character of the stream is known and
+	// the store has been asked to restore the stream from a snapshot
+	restoreRequested bool
+
 	stop     chan struct{}
 	stopDone chan struct{}
 }
 
-// NewHandler returns a new stream Handler.
+// NewHandler returns a new stream Handler. If no Throttle is provided, every
+// block is processed.
 func NewHandler(args HandlerFactoryArgs) Handler {
+	throttle := args.Throttle
+	if throttle == nil {
+		throttle = NewThrottle(nil)
+	}
 	return &handler{
 		streamID:    args.StreamID,
+		source:      args.Source,
 		ingressChan: args.IngressChan,
 		egressChan:  args.EgressChan,
+		healthChan:  args.HealthChan,
+		messageChan: args.MessageChan,
 		updateChan:  args.UpdateChan,
 		generator:   args.Generator,
+		throttle:    throttle,
 		logger:      args.Logger.Named(fmt.Sprintf("stream-handler-%d", args.StreamID)),
 
 		stop:     make(chan struct{}),
@@ -45,13 +63,51 @@ func NewHandler(args HandlerFactoryArgs) Handler {
 func (h *handler) Serve() {
 	defer close(h.stopDone)
 	h.logger.Info("Running")
-	h.updateChan <- addStreamUpdate{streamID: h.streamID}
+	h.updateChan <- addStreamUpdate{streamID: h.streamID, source: h.source}
+
+	var flushTicker *time.Ticker
+	var flushChan <-chan time.Time
+	startFlushTicker := func() {
+		if flushTicker != nil {
+			flushTicker.Stop()
+			flushTicker, flushChan = nil, nil
+		}
+		if interval := h.throttle.FlushInterval(); interval > 0 {
+			flushTicker = time.NewTicker(interval)
+			flushChan = flushTicker.C
+		}
+	}
+	startFlushTicker()
+	defer func() {
+		if flushTicker != nil {
+			flushTicker.Stop()
+		}
+	}()
+
 	for {
 		select {
 		case parsedBlock := <-h.ingressChan:
-			h.updateChan <- h.generator.Generate(h.streamID, false, parsedBlock)
+			if parsedBlock == ResetBlock {
+				h.reset(h.egressChan)
+			} else {
+				h.handleBlock(false, parsedBlock)
+			}
 		case parsedBlock := <-h.egressChan:
-			h.updateChan <- h.generator.Generate(h.streamID, true, parsedBlock)
+			if parsedBlock == ResetBlock {
+				h.reset(h.ingressChan)
+			} else {
+				h.handleBlock(true, parsedBlock)
+			}
+		case now := <-flushChan:
+			for _, b := range h.throttle.Flush(now) {
+				h.updateChan <- h.generator.Generate(h.streamID, b.IsEgress, b.Block)
+			}
+		case <-h.throttle.RulesChanged():
+			startFlushTicker()
+		case status := <-h.healthChan:
+			h.updateChan <- streamStatusUpdate{streamID: h.streamID, status: status}
+		case msg := <-h.messageChan:
+			h.updateChan <- hookMessageUpdate{streamID: h.streamID, message: msg}
 		case <-h.stop:
 			h.logger.Info("Stopping...")
 			h.updateChan <- removeStreamUpdate{streamID: h.streamID}
@@ -60,6 +116,45 @@ func (h *handler) Serve() {
 	}
 }
 
+// handleBlock generates an update from the block unless the throttle drops it
+// or holds it back
+func (h *handler) handleBlock(isEgress bool, parsedBlock *xivnet.Block) {
+	if !h.throttle.Allow(isEgress, parsedBlock, time.Now()) {
+		return
+	}
+	u := h.generator.Generate(h.streamID, isEgress, parsedBlock)
+	if !isEgress && !h.restoreRequested && parsedBlock.CurrentID != 0 {
+		// The first ingress block identifies the character of the stream
+		h.restoreRequested = true
+		u = restoreStreamUpdate{
+			streamID:    h.streamID,
+			characterID: uint64(parsedBlock.CurrentID),
+			next:        u,
+		}
+	}
+	h.updateChan <- u
+}
+
+// reset replaces the stream with an empty stream once the ResetBlock marker
+// has been received on the other channel as well. The blocks received on the
+// other channel before its marker were produced before the reset, so they are
+// discarded.
+func (h *handler) reset(other <-chan *xivnet.Block) {
+	h.logger.Info("Resetting stream")
+	for {
+		select {
+		case parsedBlock := <-other:
+			if parsedBlock == ResetBlock {
+				h.throttle.Reset()
+				h.updateChan <- resetStreamUpdate{streamID: h.streamID}
+				return
+			}
+		case <-h.stop:
+			return
+		}
+	}
+}
+
 // Stop will shutdown this service and wait on it to stop before returning
 func (h *handler) Stop() {
 	close(h.stop)
@@ -68,11 +163,14 @@ func (h *handler) Stop() {
 
 type addStreamUpdate struct {
 	streamID int
+	source   models.StreamSource
 }
 
 func (u addStreamUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+	source := u.source
 	s := &models.Stream{
 		ID:          u.streamID,
+		Source:      &source,
 		EntitiesMap: make(map[uint64]*models.Entity),
 	}
 
@@ -106,3 +204,111 @@ func (u removeStreamUpdate) ModifyStore(streams *store.Streams) ([]models.Stream
 		Type:     models.RemoveStream{ID: u.streamID},
 	}}, nil, nil
 }
+
+// hookMessageUpdate emits a message sent by the hook of a stream as a
+// StreamEvent. The message is not stored with the stream.
+type hookMessageUpdate struct {
+	streamID int
+	message  models.HookMessage
+}
+
+func (u hookMessageUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+	if _, found := streams.Map[u.streamID]; !found {
+		// The stream may have been removed already
+		return nil, nil, nil
+	}
+	return []models.StreamEvent{{
+		StreamID: u.streamID,
+		Type:     u.message,
+	}}, nil, nil
+}
+
+// ChangedStreams reports that the update never modifies the store
+func (u hookMessageUpdate) ChangedStreams() []int {
+	return nil
+}
+
+// restoreStreamUpdate re-associates the stream with a stream restored from a
+// snapshot that has the same source and character, so that the state of the
+// stream is available before the next zone change. Nothing is restored if
+// there is no such stream or if the state of the stream is already known.
+// The next update, generated from the block that identified the character,
+// is applied afterwards.
+type restoreStreamUpdate struct {
+	streamID    int
+	characterID uint64
+	next        store.Update
+}
+
+func (u restoreStreamUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+	streamEvents := u.restore(streams)
+	if u.next == nil {
+		return streamEvents, nil, nil
+	}
+	nextStreamEvents, entityEvents, err := u.next.ModifyStore(streams)
+	return append(streamEvents, nextStreamEvents...), entityEvents, err
+}
+
+func (u restoreStreamUpdate) restore(streams *store.Streams) []models.StreamEvent {
+	live, found := streams.Map[u.streamID]
+	if !found || live.CharacterID != 0 || live.Source == nil {
+		return nil
+	}
+	for i, s := range streams.Restored {
+		if s.CharacterID != u.characterID || s.Source == nil || *s.Source != *live.Source {
+			continue
+		}
+		streams.Restored = append(streams.Restored[:i], streams.Restored[i+1:]...)
+
+		// The metadata about the stream's source and health belongs to the
+		// live stream
+		s.ID = u.streamID
+		s.Source = live.Source
+		s.Status = live.Status
+		s.Restored = true
+		streams.Map[u.streamID] = s
+
+		return []models.StreamEvent{
+			{
+				StreamID: u.streamID,
+				Type:     models.RemoveStream{ID: u.streamID},
+			},
+			{
+				StreamID: u.streamID,
+				Type:     models.AddStream{Stream: s},
+			},
+		}
+	}
+	return nil
+}
+
+type resetStreamUpdate struct {
+	streamID int
+}
+
+func (u resetStreamUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+	prev, found := streams.Map[u.streamID]
+	if !found {
+		return nil, nil, fmt.Errorf("stream ID %d not found", u.streamID)
+	}
+	// Only the state of the game is reset, so the metadata about the stream's
+	// source and health is kept
+	s := &models.Stream{
+		ID:          u.streamID,
+		Source:      prev.Source,
+		Status:      prev.Status,
+		EntitiesMap: make(map[uint64]*models.Entity),
+	}
+	streams.Map[u.streamID] = s
+
+	return []models.StreamEvent{
+		{
+			StreamID: u.streamID,
+			Type:     models.RemoveStream{ID: u.streamID},
+		},
+		{
+			StreamID: u.streamID,
+			Type:     models.AddStream{Stream: s},
+		},
+	}, nil, nil
+}
@@ -4,7 +4,9 @@ import (
 	"math"
 	"net/url"
 	"sync"
+	"time"
 
+	"github.com/ff14wed/aetherometer/core/config"
 	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/store"
 	"github.com/ff14wed/aetherometer/core/store/update"
@@ -30,8 +32,11 @@ var _ = Describe("Handler", func() {
 
 		ingressChan chan *xivnet.Block
 		egressChan  chan *xivnet.Block
+		healthChan  chan models.Health
+		messageChan chan models.HookMessage
 		updateChan  chan store.Update
 		generator   update.Generator
+		throttle    *stream.Throttle
 
 		logBuf *testhelpers.LogBuffer
 		once   sync.Once
@@ -54,15 +59,25 @@ var _ = Describe("Handler", func() {
 
 		ingressChan = make(chan *xivnet.Block)
 		egressChan = make(chan *xivnet.Block)
+		healthChan = make(chan models.Health)
+		messageChan = make(chan models.HookMessage)
 		updateChan = make(chan store.Update, 2)
 		generator = update.NewGenerator(nil)
+		throttle = stream.NewThrottle(stream.ThrottleRules{
+			"UpdateHPMPTP": {Policy: config.ThrottleDedup},
+			"SetPos":       {Policy: config.ThrottleLatest, Interval: 50 * time.Millisecond},
+		})
 
 		handler = stream.NewHandler(stream.HandlerFactoryArgs{
 			StreamID:    1234,
+			Source:      models.StreamSource{Adapter: "Hook", Key: "1234"},
 			IngressChan: ingressChan,
 			EgressChan:  egressChan,
+			HealthChan:  healthChan,
+			MessageChan: messageChan,
 			UpdateChan:  updateChan,
 			Generator:   generator,
+			Throttle:    throttle,
 			Logger:      logger,
 		})
 
@@ -101,6 +116,7 @@ var _ = Describe("Handler", func() {
 
 		expectedStream := &models.Stream{
 			ID:          1234,
+			Source:      &models.StreamSource{Adapter: "Hook", Key: "1234"},
 			EntitiesMap: make(map[uint64]*models.Entity),
 		}
 
@@ -118,6 +134,101 @@ var _ = Describe("Handler", func() {
 		Expect(validate.Validate(streams)).To(Succeed())
 	})
 
+	Context("when the stream is reset", func() {
+		BeforeEach(func() {
+			By("properly add a new stream first")
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			_, _, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			streams.Map[1234].CharacterID = 0x12345678
+			streams.Map[1234].EntitiesMap[0x12345678] = &models.Entity{}
+		})
+
+		It("replaces the stream with an empty stream once both channels have been reset", func() {
+			ingressChan <- stream.ResetBlock
+			egressChan <- &xivnet.Block{}
+			Consistently(updateChan).ShouldNot(Receive())
+			egressChan <- stream.ResetBlock
+
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+
+			streamEvents, entityEvents, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entityEvents).To(BeEmpty())
+
+			expectedStream := &models.Stream{
+				ID:          1234,
+				Source:      &models.StreamSource{Adapter: "Hook", Key: "1234"},
+				EntitiesMap: make(map[uint64]*models.Entity),
+			}
+			Expect(streamEvents).To(Equal([]models.StreamEvent{
+				{StreamID: 1234, Type: models.RemoveStream{ID: 1234}},
+				{StreamID: 1234, Type: models.AddStream{Stream: expectedStream}},
+			}))
+			Expect(streams.Map).To(HaveKeyWithValue(1234, expectedStream))
+			Expect(streams.KeyOrder).To(Equal([]int{0, 1, 1234}))
+			Consistently(updateChan).ShouldNot(Receive())
+		})
+	})
+
+	Context("when the health of the stream changes", func() {
+		BeforeEach(func() {
+			By("properly add a new stream first")
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			_, _, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("records the status of the stream and emits a stream event", func() {
+			lastError := "connection to the hook was closed"
+			status := models.Health{State: models.HealthStateReconnecting, LastError: &lastError}
+			healthChan <- status
+
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			streamEvents, entityEvents, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entityEvents).To(BeEmpty())
+			Expect(streamEvents).To(Equal([]models.StreamEvent{{
+				StreamID: 1234,
+				Type:     models.UpdateStreamStatus{Status: &status},
+			}}))
+			Expect(streams.Map[1234].Status).To(Equal(&status))
+		})
+	})
+
+	Context("when the hook sends a message", func() {
+		BeforeEach(func() {
+			By("properly add a new stream first")
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			_, _, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("emits the message as a stream event", func() {
+			msg := models.HookMessage{
+				Time:     time.Unix(10, 0),
+				Severity: models.HookMessageSeverityWarn,
+				Message:  "Unknown game build",
+			}
+			messageChan <- msg
+
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			streamEvents, entityEvents, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entityEvents).To(BeEmpty())
+			Expect(streamEvents).To(Equal([]models.StreamEvent{{
+				StreamID: 1234,
+				Type:     msg,
+			}}))
+		})
+	})
+
 	Context("when shutting down", func() {
 		BeforeEach(func() {
 			By("properly add a new stream first")
@@ -221,6 +332,163 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	Context("when a stream with the same source was restored from a snapshot", func() {
+		var restoredStream *models.Stream
+
+		BeforeEach(func() {
+			By("properly add a new stream first")
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			_, _, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+
+			restoredStream = &models.Stream{
+				ID:          5678,
+				CharacterID: 0x12345678,
+				Source:      &models.StreamSource{Adapter: "Hook", Key: "1234"},
+				Restored:    true,
+				EntitiesMap: map[uint64]*models.Entity{
+					0x12345678: {
+						Location:  &models.Location{},
+						ClassJob:  &models.ClassJob{},
+						Resources: &models.Resources{},
+					},
+				},
+			}
+			streams.Restored = []*models.Stream{restoredStream}
+		})
+
+		hpBlock := func(currentID uint32) *xivnet.Block {
+			return &xivnet.Block{
+				SubjectID: 0x12345678, CurrentID: currentID, Data: &datatypes.UpdateHPMPTP{HP: 100},
+			}
+		}
+
+		It("re-associates the restored stream before applying the first block", func() {
+			ingressChan <- hpBlock(0x12345678)
+
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			streamEvents, entityEvents, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+
+			expectedStream := &models.Stream{
+				ID:          1234,
+				CharacterID: 0x12345678,
+				Source:      &models.StreamSource{Adapter: "Hook", Key: "1234"},
+				Restored:    true,
+				EntitiesMap: map[uint64]*models.Entity{
+					0x12345678: {
+						Location:  &models.Location{},
+						ClassJob:  &models.ClassJob{},
+						Resources: &models.Resources{Hp: 100},
+					},
+				},
+			}
+			Expect(streamEvents).To(Equal([]models.StreamEvent{
+				{StreamID: 1234, Type: models.RemoveStream{ID: 1234}},
+				{StreamID: 1234, Type: models.AddStream{Stream: expectedStream}},
+			}))
+			Expect(entityEvents).To(HaveLen(1))
+			Expect(streams.Map).To(HaveKeyWithValue(1234, expectedStream))
+			Expect(streams.KeyOrder).To(Equal([]int{0, 1, 1234}))
+			Expect(streams.Restored).To(BeEmpty())
+		})
+
+		It("does not re-associate a restored stream of a different character", func() {
+			ingressChan <- hpBlock(0x23456789)
+
+			var u store.Update
+			Eventually(updateChan).Should(Receive(&u))
+			streamEvents, _, err := u.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streamEvents).To(BeEmpty())
+			Expect(streams.Map[1234].CharacterID).To(BeZero())
+			Expect(streams.Restored).To(ConsistOf(restoredStream))
+		})
+	})
+
+	Context("when throttled blocks are emitted by the stream", func() {
+		BeforeEach(func() {
+			Eventually(updateChan).Should(Receive())
+			streams.Map[1234] = &models.Stream{
+				ID:          1234,
+				CharacterID: 0x12345678,
+				EntitiesMap: map[uint64]*models.Entity{
+					0x12345678: {
+						Location:  &models.Location{},
+						ClassJob:  &models.ClassJob{},
+						Resources: &models.Resources{},
+					},
+				},
+			}
+		})
+
+		It("drops exact duplicates of blocks with the dedup policy", func() {
+			hpBlock := func(hp uint32) *xivnet.Block {
+				return &xivnet.Block{
+					SubjectID: 0x12345678, CurrentID: 0x12345678, Data: &datatypes.UpdateHPMPTP{HP: hp},
+				}
+			}
+			ingressChan <- hpBlock(100)
+			ingressChan <- hpBlock(100)
+			ingressChan <- hpBlock(200)
+
+			Eventually(updateChan).Should(Receive())
+			Eventually(updateChan).Should(Receive())
+			Consistently(updateChan).ShouldNot(Receive())
+			Expect(throttle.Stats()).To(ContainElement(models.ThrottleStat{
+				Datatype: "UpdateHPMPTP", Policy: models.ThrottlePolicyDedup, Dropped: 1,
+			}))
+		})
+
+		It("only processes the latest block of each interval with the latest policy", func() {
+			setPosBlock := func(x float32) *xivnet.Block {
+				return &xivnet.Block{
+					SubjectID: 0x12345678, CurrentID: 0x12345678, Data: &datatypes.SetPos{X: x},
+				}
+			}
+			ingressChan <- setPosBlock(100)
+			ingressChan <- setPosBlock(200)
+			ingressChan <- setPosBlock(300)
+
+			var u1, u2 store.Update
+			Eventually(updateChan).Should(Receive(&u1))
+			Eventually(updateChan).Should(Receive(&u2))
+			Consistently(updateChan).ShouldNot(Receive())
+
+			_, _, err := u1.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streams.Map[1234].EntitiesMap[0x12345678].Location.X).To(BeEquivalentTo(100))
+			_, _, err = u2.ModifyStore(&streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streams.Map[1234].EntitiesMap[0x12345678].Location.X).To(BeEquivalentTo(300))
+
+			Expect(throttle.Stats()).To(ContainElement(models.ThrottleStat{
+				Datatype: "SetPos", Policy: models.ThrottlePolicyLatest, Dropped: 1,
+			}))
+		})
+
+		It("flushes held back blocks using rules that were changed while running", func() {
+			throttle.SetRules(stream.ThrottleRules{
+				"Movement": {Policy: config.ThrottleLatest, Interval: 20 * time.Millisecond},
+			})
+			movementBlock := func(direction uint8) *xivnet.Block {
+				return &xivnet.Block{
+					SubjectID: 0x12345678, CurrentID: 0x12345678, Data: &datatypes.Movement{Direction: direction},
+				}
+			}
+			ingressChan <- movementBlock(1)
+			ingressChan <- movementBlock(2)
+
+			Eventually(updateChan).Should(Receive())
+			Eventually(updateChan).Should(Receive())
+			Expect(throttle.Stats()).To(ConsistOf(models.ThrottleStat{
+				Datatype: "Movement", Policy: models.ThrottlePolicyLatest,
+			}))
+		})
+	})
+
 	Context("when an egress block is emitted by the stream", func() {
 		BeforeEach(func() {
 			Eventually(updateChan).Should(Receive())
@@ -1,9 +1,14 @@
 package stream
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
+	"time"
 
+	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/store"
 	"github.com/ff14wed/aetherometer/core/store/update"
 	"github.com/ff14wed/xivnet/v3"
@@ -13,10 +18,14 @@ import (
 
 type HandlerFactoryArgs struct {
 	StreamID    int
+	Source      models.StreamSource
 	IngressChan <-chan *xivnet.Block
 	EgressChan  <-chan *xivnet.Block
+	HealthChan  <-chan models.Health
+	MessageChan <-chan models.HookMessage
 	UpdateChan  chan<- store.Update
 	Generator   update.Generator
+	Throttle    *Throttle
 	Logger      *zap.Logger
 }
 
@@ -29,8 +38,40 @@ type Handler interface {
 // updates from the stream provider
 type HandlerFactory func(h HandlerFactoryArgs) Handler
 
+// StreamUpEvent notifies the Manager that an adapter has opened a new stream
+type StreamUpEvent struct {
+	Adapter  string
+	Provider Provider
+}
+
+// StreamDownEvent notifies the Manager that an adapter has closed a stream.
+// The StreamID is the ID reported by the stream's Provider.
+type StreamDownEvent struct {
+	Adapter  string
+	StreamID int
+}
+
+// localStream identifies a stream by the ID reported by its Provider
+type localStream struct {
+	adapter  string
+	streamID int
+}
+
+// healthPollInterval is how often the health of each stream is polled
+const healthPollInterval = time.Second
+
 // Manager is a process responsible for watching stream created or stream
-// closed events from all adapters
+// closed events from all adapters.
+//
+// The Manager assigns each stream an ID that is unique across all adapters.
+// The ID reported by the stream's Provider is used if possible, but the stream
+// is assigned a different ID if it collides with the stream of another
+// adapter. A stream is rejected if its adapter already has an open stream with
+// the same ID or source key.
+//
+// It also keeps track of the running adapters
+// and periodically records the health of any stream whose Provider implements
+// HealthReporter.
 type Manager struct {
 	generator        update.Generator
 	updateChan       chan<- store.Update
@@ -41,12 +82,23 @@ type Manager struct {
 	stop     chan struct{}
 	stopDone chan struct{}
 
+	allocator    *IDAllocator
+	localStreams map[localStream]int
+	// rejected counts the streams that were rejected for each local stream
+	// ID, whose StreamDown events must not close the accepted stream
+	rejected      map[localStream]int
 	streamTokens  map[int]suture.ServiceToken
 	providers     map[int]Provider
+	locals        map[int]localStream
+	throttles     map[int]*Throttle
+	throttleRules ThrottleRules
 	providersLock sync.Mutex
 
-	streamUp   chan Provider
-	streamDown chan int
+	adapters     map[string]Adapter
+	adaptersLock sync.Mutex
+
+	streamUp   chan StreamUpEvent
+	streamDown chan StreamDownEvent
 }
 
 // NewManager returns a new stream Manager.
@@ -67,11 +119,17 @@ func NewManager(
 		stop:     make(chan struct{}),
 		stopDone: make(chan struct{}),
 
+		allocator:    NewIDAllocator(),
+		localStreams: make(map[localStream]int),
+		rejected:     make(map[localStream]int),
 		streamTokens: make(map[int]suture.ServiceToken),
 		providers:    make(map[int]Provider),
+		locals:       make(map[int]localStream),
+		throttles:    make(map[int]*Throttle),
+		adapters:     make(map[string]Adapter),
 
-		streamUp:   make(chan Provider, 64),
-		streamDown: make(chan int, 64),
+		streamUp:   make(chan StreamUpEvent, 64),
+		streamDown: make(chan StreamDownEvent, 64),
 	}
 }
 
@@ -80,37 +138,18 @@ func NewManager(
 // when streams are closed.
 func (m *Manager) Serve() {
 	defer close(m.stopDone)
+	healthTicker := time.NewTicker(healthPollInterval)
+	defer healthTicker.Stop()
+
 	m.logger.Info("Running")
 	for {
 		select {
-		case sp := <-m.streamUp:
-			streamID := sp.StreamID()
-			ingressChan := sp.SubscribeIngress()
-			egressChan := sp.SubscribeEgress()
-			sh := m.handlerFactory(HandlerFactoryArgs{
-				StreamID:    streamID,
-				IngressChan: ingressChan,
-				EgressChan:  egressChan,
-				UpdateChan:  m.updateChan,
-				Generator:   m.generator,
-				Logger:      m.logger,
-			})
-			token := m.streamSupervisor.Add(sh)
-			m.streamTokens[streamID] = token
-
-			m.providersLock.Lock()
-			m.providers[streamID] = sp
-			m.providersLock.Unlock()
-		case streamID := <-m.streamDown:
-			err := m.streamSupervisor.Remove(m.streamTokens[streamID])
-			if err != nil {
-				m.logger.Error("Error removing stream", zap.Int("streamID", streamID), zap.Error(err))
-			}
-			delete(m.streamTokens, streamID)
-
-			m.providersLock.Lock()
-			delete(m.providers, streamID)
-			m.providersLock.Unlock()
+		case e := <-m.streamUp:
+			m.addStream(e)
+		case e := <-m.streamDown:
+			m.removeStream(e)
+		case <-healthTicker.C:
+			m.pollHealth()
 		case <-m.stop:
 			m.logger.Info("Stopping...")
 			return
@@ -124,6 +163,176 @@ func (m *Manager) Stop() {
 	<-m.stopDone
 }
 
+// addStream assigns a stream ID to the new stream and starts up a handler
+// for it
+func (m *Manager) addStream(e StreamUpEvent) {
+	sp := e.Provider
+	local := localStream{adapter: e.Adapter, streamID: sp.StreamID()}
+	source := models.StreamSource{
+		Adapter: e.Adapter,
+		Key:     strconv.Itoa(local.streamID),
+	}
+	if k, ok := sp.(SourceKeyer); ok {
+		source.Key = k.SourceKey()
+	}
+
+	if existingID, found := m.localStreams[local]; found {
+		m.logger.Error("Rejected stream with duplicate ID",
+			zap.String("adapter", e.Adapter),
+			zap.Int("localStreamID", local.streamID),
+			zap.Int("existingStreamID", existingID),
+		)
+		m.rejected[local]++
+		return
+	}
+	streamID, err := m.allocator.Allocate(source, local.streamID)
+	if err != nil {
+		m.logger.Error("Rejected stream with duplicate source", zap.Error(err))
+		m.rejected[local]++
+		return
+	}
+	if streamID != local.streamID {
+		m.logger.Error("Stream ID collision, remapped stream",
+			zap.String("adapter", e.Adapter),
+			zap.String("source", source.Key),
+			zap.Int("localStreamID", local.streamID),
+			zap.Int("streamID", streamID),
+		)
+	}
+	m.localStreams[local] = streamID
+
+	ingressChan := sp.SubscribeIngress()
+	egressChan := sp.SubscribeEgress()
+	var healthChan <-chan models.Health
+	if n, ok := sp.(HealthNotifier); ok {
+		healthChan = n.SubscribeHealth()
+	}
+	var messageChan <-chan models.HookMessage
+	if r, ok := sp.(HookMessageReporter); ok {
+		messageChan = r.SubscribeMessages()
+	}
+	m.providersLock.Lock()
+	throttle := NewThrottle(m.throttleRules)
+	m.providersLock.Unlock()
+	sh := m.handlerFactory(HandlerFactoryArgs{
+		StreamID:    streamID,
+		Source:      source,
+		IngressChan: ingressChan,
+		EgressChan:  egressChan,
+		HealthChan:  healthChan,
+		MessageChan: messageChan,
+		UpdateChan:  m.updateChan,
+		Generator:   m.generator,
+		Throttle:    throttle,
+		Logger:      m.logger,
+	})
+	token := m.streamSupervisor.Add(sh)
+	m.streamTokens[streamID] = token
+
+	m.providersLock.Lock()
+	m.providers[streamID] = sp
+	m.locals[streamID] = local
+	m.throttles[streamID] = throttle
+	m.providersLock.Unlock()
+}
+
+// removeStream shuts down the handler for the closed stream and releases its
+// stream ID. Since a rejected stream shares its local stream ID with the
+// accepted stream, the first StreamDown events for that ID are attributed to
+// the rejected streams.
+func (m *Manager) removeStream(e StreamDownEvent) {
+	local := localStream{adapter: e.Adapter, streamID: e.StreamID}
+	if n := m.rejected[local]; n > 0 {
+		if n == 1 {
+			delete(m.rejected, local)
+		} else {
+			m.rejected[local] = n - 1
+		}
+		m.logger.Info("Rejected stream closed",
+			zap.String("adapter", e.Adapter),
+			zap.Int("localStreamID", e.StreamID),
+		)
+		return
+	}
+	streamID, found := m.localStreams[local]
+	if !found {
+		m.logger.Error("Error removing stream",
+			zap.String("adapter", e.Adapter),
+			zap.Int("streamID", e.StreamID),
+			zap.Error(errors.New("stream not found")),
+		)
+		return
+	}
+	err := m.streamSupervisor.Remove(m.streamTokens[streamID])
+	if err != nil {
+		m.logger.Error("Error removing stream", zap.Int("streamID", streamID), zap.Error(err))
+	}
+	delete(m.streamTokens, streamID)
+	delete(m.localStreams, local)
+	m.allocator.Release(streamID)
+
+	m.providersLock.Lock()
+	delete(m.providers, streamID)
+	delete(m.locals, streamID)
+	delete(m.throttles, streamID)
+	m.providersLock.Unlock()
+}
+
+// pollHealth records the current health of every stream that reports it
+func (m *Manager) pollHealth() {
+	m.providersLock.Lock()
+	reporters := make(map[int]HealthReporter)
+	for streamID, sp := range m.providers {
+		if r, ok := sp.(HealthReporter); ok {
+			reporters[streamID] = r
+		}
+	}
+	m.providersLock.Unlock()
+
+	for streamID, r := range reporters {
+		select {
+		case m.updateChan <- streamStatusUpdate{streamID: streamID, status: r.Health()}:
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// RegisterAdapter adds the adapter to the list of running adapters.
+func (m *Manager) RegisterAdapter(name string, adapter Adapter) {
+	m.adaptersLock.Lock()
+	defer m.adaptersLock.Unlock()
+	m.adapters[name] = adapter
+}
+
+// UnregisterAdapter removes the adapter from the list of running adapters.
+func (m *Manager) UnregisterAdapter(name string) {
+	m.adaptersLock.Lock()
+	defer m.adaptersLock.Unlock()
+	delete(m.adapters, name)
+}
+
+// Adapters returns the running adapters sorted by name. The health of the
+// adapter is included if the adapter implements HealthReporter.
+func (m *Manager) Adapters() []models.Adapter {
+	m.adaptersLock.Lock()
+	defer m.adaptersLock.Unlock()
+
+	adapters := make([]models.Adapter, 0, len(m.adapters))
+	for name, a := range m.adapters {
+		info := models.Adapter{Name: name}
+		if r, ok := a.(HealthReporter); ok {
+			health := r.Health()
+			info.Health = &health
+		}
+		adapters = append(adapters, info)
+	}
+	sort.Slice(adapters, func(i, j int) bool {
+		return adapters[i].Name < adapters[j].Name
+	})
+	return adapters
+}
+
 // SendRequest forwards a request for a given stream ID to the correct stream
 // Provider.
 func (m *Manager) SendRequest(streamID int, req []byte) ([]byte, error) {
@@ -137,14 +346,196 @@ func (m *Manager) SendRequest(streamID int, req []byte) ([]byte, error) {
 	return nil, fmt.Errorf("stream provider %d not found", streamID)
 }
 
+// Commands returns the commands supported by the stream Provider for a given
+// stream ID. It returns an empty list if the Provider does not implement
+// CommandLister.
+func (m *Manager) Commands(streamID int) ([]models.StreamCommand, error) {
+	m.providersLock.Lock()
+	provider, found := m.providers[streamID]
+	m.providersLock.Unlock()
+
+	if !found {
+		return nil, fmt.Errorf("stream provider %d not found", streamID)
+	}
+	if l, ok := provider.(CommandLister); ok {
+		return l.Commands(), nil
+	}
+	return []models.StreamCommand{}, nil
+}
+
+// SetThrottleRules sets the rules used to throttle the blocks of streams.
+// The rules apply to the streams that are running as well as the streams
+// that are added afterwards.
+func (m *Manager) SetThrottleRules(rules ThrottleRules) {
+	m.providersLock.Lock()
+	defer m.providersLock.Unlock()
+	m.throttleRules = rules
+	for _, throttle := range m.throttles {
+		throttle.SetRules(rules)
+	}
+}
+
+// ThrottleStats returns the number of blocks dropped by the throttle of the
+// stream with the given stream ID.
+func (m *Manager) ThrottleStats(streamID int) ([]models.ThrottleStat, error) {
+	m.providersLock.Lock()
+	throttle, found := m.throttles[streamID]
+	m.providersLock.Unlock()
+
+	if !found {
+		return nil, fmt.Errorf("stream provider %d not found", streamID)
+	}
+	return throttle.Stats(), nil
+}
+
+// HookMessages returns the most recent messages sent by the hook of the stream
+// with the given stream ID. It returns an empty list if the Provider does not
+// implement HookMessageReporter.
+func (m *Manager) HookMessages(streamID int) ([]models.HookMessage, error) {
+	m.providersLock.Lock()
+	provider, found := m.providers[streamID]
+	m.providersLock.Unlock()
+
+	if !found {
+		return nil, fmt.Errorf("stream provider %d not found", streamID)
+	}
+	if r, ok := provider.(HookMessageReporter); ok {
+		return r.Messages(), nil
+	}
+	return []models.HookMessage{}, nil
+}
+
+// CandidateProcesses returns the candidate processes of every running adapter
+// that implements ProcessAttacher, sorted by adapter name and process ID. The
+// stream ID of an attached process is only reported once its stream is open.
+func (m *Manager) CandidateProcesses() []models.ProcessCandidate {
+	m.adaptersLock.Lock()
+	attachers := make(map[string]ProcessAttacher)
+	for name, a := range m.adapters {
+		if pa, ok := a.(ProcessAttacher); ok {
+			attachers[name] = pa
+		}
+	}
+	m.adaptersLock.Unlock()
+
+	m.providersLock.Lock()
+	streamIDs := make(map[localStream]int, len(m.locals))
+	for streamID, local := range m.locals {
+		streamIDs[local] = streamID
+	}
+	m.providersLock.Unlock()
+
+	candidates := []models.ProcessCandidate{}
+	for name, pa := range attachers {
+		for _, c := range pa.CandidateProcesses() {
+			c.Adapter = name
+			if c.StreamID != nil {
+				local := localStream{adapter: name, streamID: *c.StreamID}
+				if streamID, found := streamIDs[local]; found {
+					c.StreamID = &streamID
+				} else {
+					c.StreamID = nil
+				}
+			}
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Adapter != candidates[j].Adapter {
+			return candidates[i].Adapter < candidates[j].Adapter
+		}
+		return candidates[i].Pid < candidates[j].Pid
+	})
+	return candidates
+}
+
+// AttachProcess attaches the adapter that lists the process identified by pid
+// as a candidate to the process.
+func (m *Manager) AttachProcess(pid int) error {
+	for _, c := range m.CandidateProcesses() {
+		if c.Pid != pid {
+			continue
+		}
+		m.adaptersLock.Lock()
+		pa, ok := m.adapters[c.Adapter].(ProcessAttacher)
+		m.adaptersLock.Unlock()
+		if !ok {
+			break
+		}
+		return pa.AttachProcess(pid)
+	}
+	return fmt.Errorf("process %d is not a candidate process", pid)
+}
+
+// DetachProcess detaches the adapter of the stream identified by streamID from
+// the stream's process.
+func (m *Manager) DetachProcess(streamID int) error {
+	m.providersLock.Lock()
+	local, found := m.locals[streamID]
+	m.providersLock.Unlock()
+
+	if !found {
+		return fmt.Errorf("stream provider %d not found", streamID)
+	}
+
+	m.adaptersLock.Lock()
+	pa, ok := m.adapters[local.adapter].(ProcessAttacher)
+	m.adaptersLock.Unlock()
+
+	if !ok {
+		return fmt.Errorf("adapter %s cannot detach stream %d", local.adapter, streamID)
+	}
+	return pa.DetachProcess(local.streamID)
+}
+
 // StreamUp returns a channel that allows an upstream service to notify the
 // manager that a new stream has been created.
-func (m *Manager) StreamUp() chan<- Provider {
+func (m *Manager) StreamUp() chan<- StreamUpEvent {
 	return m.streamUp
 }
 
 // StreamDown returns a channel that allows an upstream service to notify the
 // manager that a stream has closed.
-func (m *Manager) StreamDown() chan<- int {
+func (m *Manager) StreamDown() chan<- StreamDownEvent {
 	return m.streamDown
 }
+
+// streamStatusUpdate records the health of a stream. A StreamEvent is only
+// emitted if the state or the last error of the stream has changed, since
+// the rest of the fields change constantly.
+type streamStatusUpdate struct {
+	streamID int
+	status   models.Health
+}
+
+func (u streamStatusUpdate) ModifyStore(streams *store.Streams) ([]models.StreamEvent, []models.EntityEvent, error) {
+	stream, found := streams.Map[u.streamID]
+	if !found {
+		// The stream may not have been added to the store yet
+		return nil, nil, nil
+	}
+	prev := stream.Status
+	status := u.status
+	stream.Status = &status
+
+	if prev != nil && prev.State == status.State && equalError(prev.LastError, status.LastError) {
+		return nil, nil, nil
+	}
+	return []models.StreamEvent{{
+		StreamID: u.streamID,
+		Type:     models.UpdateStreamStatus{Status: &status},
+	}}, nil, nil
+}
+
+// ChangedStreams reports that the status of the stream changed, even if no
+// StreamEvent was emitted for it
+func (u streamStatusUpdate) ChangedStreams() []int {
+	return []int{u.streamID}
+}
+
+func equalError(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
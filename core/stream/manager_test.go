@@ -4,7 +4,10 @@ import (
 	"net/url"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/store"
 	"github.com/ff14wed/aetherometer/core/store/update"
 	"github.com/ff14wed/aetherometer/core/stream"
@@ -43,6 +46,65 @@ func (f *FakeHandler) StopCalled() bool {
 	return atomic.LoadUint32(&f.stopCalled) == 1
 }
 
+type keyedProvider struct {
+	*streamfakes.FakeProvider
+	key string
+}
+
+func (k keyedProvider) SourceKey() string {
+	return k.key
+}
+
+type healthyProvider struct {
+	*streamfakes.FakeProvider
+	health models.Health
+}
+
+func (h healthyProvider) Health() models.Health {
+	return h.health
+}
+
+type commandProvider struct {
+	*streamfakes.FakeProvider
+	commands []models.StreamCommand
+}
+
+func (c commandProvider) Commands() []models.StreamCommand {
+	return c.commands
+}
+
+type notifyingProvider struct {
+	*streamfakes.FakeProvider
+	healthChan chan models.Health
+}
+
+func (n notifyingProvider) SubscribeHealth() <-chan models.Health {
+	return n.healthChan
+}
+
+type messagingProvider struct {
+	*streamfakes.FakeProvider
+	messages    []models.HookMessage
+	messageChan chan models.HookMessage
+}
+
+func (m messagingProvider) Messages() []models.HookMessage {
+	return m.messages
+}
+
+func (m messagingProvider) SubscribeMessages() <-chan models.HookMessage {
+	return m.messageChan
+}
+
+type healthyAdapter struct {
+	*FakeHandler
+	health models.Health
+}
+
+func (h healthyAdapter) Health() models.Health {
+	return h.health
+}
+
 var _ = Describe("Manager", func() {
 	var (
 		manager    *stream.Manager
@@ -50,9 +112,12 @@ var _ = Describe("Manager", func() {
 
 		generator        update.Generator
 		updateChan       chan<- store.Update
+		updates          chan store.Update
 		streamSupervisor *suture.Supervisor
 
 		handlerFactoryArgs stream.HandlerFactoryArgs
+		allHandlerArgs     []stream.HandlerFactoryArgs
+		handlerArgsLock    sync.Mutex
 		fakeHandler        *FakeHandler
 
 		logBuf *testhelpers.LogBuffer
@@ -75,11 +140,19 @@ var _ = Describe("Manager", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		generator = update.NewGenerator(nil)
-		updateChan = make(chan store.Update)
+		updates = make(chan store.Update)
+		updateChan = updates
 		fakeHandler = &FakeHandler{stop: make(chan struct{})}
+		allHandlerArgs = nil
 		handlerFactory := func(args stream.HandlerFactoryArgs) stream.Handler {
+			handlerArgsLock.Lock()
+			defer handlerArgsLock.Unlock()
 			handlerFactoryArgs = args
-			return fakeHandler
+			allHandlerArgs = append(allHandlerArgs, args)
+			if len(allHandlerArgs) == 1 {
+				return fakeHandler
+			}
+			return &FakeHandler{stop: make(chan struct{})}
 		}
 		streamSupervisor = suture.New("stream-supervisor", suture.Spec{
 			Log: func(line string) {
@@ -120,10 +193,273 @@ var _ = Describe("Manager", func() {
 	})
 
 	It("logs an error when attempting to remove a non-existent stream", func() {
-		manager.StreamDown() <- 1234
+		manager.StreamDown() <- stream.StreamDownEvent{Adapter: "Hook", StreamID: 1234}
 		Eventually(logBuf).Should(gbytes.Say("Error removing stream.*1234"))
 	})
 
+	Context("when streams from different adapters have the same ID", func() {
+		var hookProvider, replayProvider *streamfakes.FakeProvider
+
+		handlerArgs := func() []stream.HandlerFactoryArgs {
+			handlerArgsLock.Lock()
+			defer handlerArgsLock.Unlock()
+			return append([]stream.HandlerFactoryArgs(nil), allHandlerArgs...)
+		}
+
+		BeforeEach(func() {
+			hookProvider = new(streamfakes.FakeProvider)
+			hookProvider.StreamIDReturns(1234)
+			hookProvider.SendRequestReturns([]byte("hook"), nil)
+			replayProvider = new(streamfakes.FakeProvider)
+			replayProvider.StreamIDReturns(1234)
+			replayProvider.SendRequestReturns([]byte("replay"), nil)
+
+			manager.StreamUp() <- stream.StreamUpEvent{Adapter: "Hook", Provider: hookProvider}
+			manager.StreamUp() <- stream.StreamUpEvent{
+				Adapter:  "Replay",
+				Provider: keyedProvider{FakeProvider: replayProvider, key: "session.aethsess"},
+			}
+			Eventually(handlerArgs).Should(HaveLen(2))
+		})
+
+		It("remaps the stream ID of the colliding stream", func() {
+			args := handlerArgs()
+			Expect(args[0].StreamID).To(Equal(1234))
+			Expect(args[0].Source).To(Equal(models.StreamSource{Adapter: "Hook", Key: "1234"}))
+			Expect(args[1].StreamID).To(Equal(1235))
+			Expect(args[1].Source).To(Equal(models.StreamSource{Adapter: "Replay", Key: "session.aethsess"}))
+			Eventually(logBuf).Should(gbytes.Say(`Stream ID collision, remapped stream.*"adapter": "Replay".*"streamID": 1235`))
+
+			Expect(manager.SendRequest(1234, nil)).To(Equal([]byte("hook")))
+			Expect(manager.SendRequest(1235, nil)).To(Equal([]byte("replay")))
+		})
+
+		It("closes the remapped stream using the ID reported by its provider", func() {
+			manager.StreamDown() <- stream.StreamDownEvent{Adapter: "Replay", StreamID: 1234}
+			Eventually(func() error {
+				_, err := manager.SendRequest(1235, nil)
+				return err
+			}).Should(MatchError("stream provider 1235 not found"))
+			Expect(manager.SendRequest(1234, nil)).To(Equal([]byte("hook")))
+		})
+
+		It("rejects a stream with a duplicate ID from the same adapter", func() {
+			duplicateProvider := new(streamfakes.FakeProvider)
+			duplicateProvider.StreamIDReturns(1234)
+			manager.StreamUp() <- stream.StreamUpEvent{Adapter: "Hook", Provider: duplicateProvider}
+
+			Eventually(logBuf).Should(gbytes.Say(`Rejected stream with duplicate ID.*"adapter": "Hook"`))
+			Expect(handlerArgs()).To(HaveLen(2))
+		})
+
+		It("does not close the accepted stream when the rejected stream closes", func() {
+			duplicateProvider := new(streamfakes.FakeProvider)
+			duplicateProvider.StreamIDReturns(1234)
+			manager.StreamUp() <- stream.StreamUpEvent{Adapter: "Hook", Provider: duplicateProvider}
+			Eventually(logBuf).Should(gbytes.Say(`Rejected stream with duplicate ID`))
+
+			manager.StreamDown() <- stream.StreamDownEvent{Adapter: "Hook", StreamID: 1234}
+			Eventually(logBuf).Should(gbytes.Say(`Rejected stream closed.*"adapter": "Hook"`))
+			Expect(manager.SendRequest(1234, nil)).To(Equal([]byte("hook")))
+
+			manager.StreamDown() <- stream.StreamDownEvent{Adapter: "Hook", StreamID: 1234}
+			Eventually(func() error {
+				_, err := manager.SendRequest(1234, nil)
+				return err
+			}).Should(MatchError("stream provider 1234 not found"))
+		})
+	})
+
+	Describe("Adapters", func() {
+		It("lists the registered adapters sorted by name along with their health", func() {
+			manager.RegisterAdapter("Replay", &FakeHandler{})
+			manager.RegisterAdapter("Hook", healthyAdapter{
+				FakeHandler: &FakeHandler{},
+				health:      models.Health{State: models.HealthStateConnected},
+			})
+			Expect(manager.Adapters()).To(Equal([]models.Adapter{
+				{Name: "Hook", Health: &models.Health{State: models.HealthStateConnected}},
+				{Name: "Replay"},
+			}))
+
+			manager.UnregisterAdapter("Hook")
+			Expect(manager.Adapters()).To(Equal([]models.Adapter{{Name: "Replay"}}))
+		})
+	})
+
+	Context("when a new stream that reports its health is created", func() {
+		var streams *store.Streams
+
+		BeforeEach(func() {
+			fakeProvider := new(streamfakes.FakeProvider)
+			fakeProvider.StreamIDReturns(1234)
+			lastError := "connection reset"
+			manager.StreamUp() <- stream.StreamUpEvent{
+				Adapter: "Hook",
+				Provider: healthyProvider{
+					FakeProvider: fakeProvider,
+					health: models.Health{
+						State:            models.HealthStateDegraded,
+						LastError:        &lastError,
+						PacketsPerSecond: 5,
+					},
+				},
+			}
+			streams = &store.Streams{
+				Map: map[int]*models.Stream{1234: {ID: 1234}},
+			}
+		})
+
+		receiveUpdate := func() store.Update {
+			var u store.Update
+			Eventually(updates, 3*time.Second).Should(Receive(&u))
+			return u
+		}
+
+		It("periodically records the health of the stream", func() {
+			streamEvents, entityEvents, err := receiveUpdate().ModifyStore(streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entityEvents).To(BeEmpty())
+
+			lastError := "connection reset"
+			expectedStatus := &models.Health{
+				State:            models.HealthStateDegraded,
+				LastError:        &lastError,
+				PacketsPerSecond: 5,
+			}
+			Expect(streams.Map[1234].Status).To(Equal(expectedStatus))
+			Expect(streamEvents).To(Equal([]models.StreamEvent{{
+				StreamID: 1234,
+				Type:     models.UpdateStreamStatus{Status: expectedStatus},
+			}}))
+
+			By("not emitting an event if the state has not changed")
+			streams.Map[1234].Status.PacketsPerSecond = 10
+			u := receiveUpdate()
+			streamEvents, _, err = u.ModifyStore(streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streamEvents).To(BeEmpty())
+			Expect(streams.Map[1234].Status.PacketsPerSecond).To(Equal(5.0))
+
+			By("reporting the stream as changed so that it is published again")
+			reporter, ok := u.(store.ChangeReporter)
+			Expect(ok).To(BeTrue())
+			Expect(reporter.ChangedStreams()).To(Equal([]int{1234}))
+
+			By("emitting an event if the state has changed")
+			streams.Map[1234].Status.State = models.HealthStateConnected
+			streamEvents, _, err = receiveUpdate().ModifyStore(streams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streamEvents).To(HaveLen(1))
+		})
+
+		It("ignores streams that are not in the store", func() {
+			streamEvents, entityEvents, err := receiveUpdate().ModifyStore(&store.Streams{
+				Map: map[int]*models.Stream{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streamEvents).To(BeEmpty())
+			Expect(entityEvents).To(BeEmpty())
+		})
+	})
+
+	Context("when a new stream that notifies of its health is created", func() {
+		var healthChan chan models.Health
+
+		BeforeEach(func() {
+			fakeProvider := new(streamfakes.FakeProvider)
+			fakeProvider.StreamIDReturns(1234)
+			healthChan = make(chan models.Health)
+			manager.StreamUp() <- stream.StreamUpEvent{
+				Adapter:  "Hook",
+				Provider: notifyingProvider{FakeProvider: fakeProvider, healthChan: healthChan},
+			}
+			Eventually(fakeHandler.ServeCalled).Should(BeTrue())
+		})
+
+		It("passes the health channel to the Handler", func() {
+			Expect(handlerFactoryArgs.HealthChan).To(Equal((<-chan models.Health)(healthChan)))
+		})
+	})
+
+	Context("when a new stream that reports hook messages is created", func() {
+		var (
+			messages    []models.HookMessage
+			messageChan chan models.HookMessage
+		)
+
+		BeforeEach(func() {
+			fakeProvider := new(streamfakes.FakeProvider)
+			fakeProvider.StreamIDReturns(1234)
+			messages = []models.HookMessage{{Severity: models.HookMessageSeverityWarn, Message: "Unknown game build"}}
+			messageChan = make(chan models.HookMessage)
+			manager.StreamUp() <- stream.StreamUpEvent{
+				Adapter: "Hook",
+				Provider: messagingProvider{
+					FakeProvider: fakeProvider,
+					messages:     messages,
+					messageChan:  messageChan,
+				},
+			}
+			Eventually(fakeHandler.ServeCalled).Should(BeTrue())
+		})
+
+		It("passes the message channel to the Handler", func() {
+			Expect(handlerFactoryArgs.MessageChan).To(Equal((<-chan models.HookMessage)(messageChan)))
+		})
+
+		It("returns the messages reported by the stream", func() {
+			Expect(manager.HookMessages(1234)).To(Equal(messages))
+		})
+	})
+
+	Context("when a new stream that lists its commands is created", func() {
+		var commands []models.StreamCommand
+
+		BeforeEach(func() {
+			fakeProvider := new(streamfakes.FakeProvider)
+			fakeProvider.StreamIDReturns(1234)
+			commands = []models.StreamCommand{{Name: "ping", Description: "Pings the hook", Schema: "{}"}}
+			manager.StreamUp() <- stream.StreamUpEvent{
+				Adapter:  "Hook",
+				Provider: commandProvider{FakeProvider: fakeProvider, commands: commands},
+			}
+			Eventually(fakeHandler.ServeCalled).Should(BeTrue())
+		})
+
+		It("returns the commands of the stream", func() {
+			Expect(manager.Commands(1234)).To(Equal(commands))
+		})
+	})
+
+	Context("when throttle rules are set", func() {
+		BeforeEach(func() {
+			manager.SetThrottleRules(stream.ThrottleRules{
+				"Movement": {Policy: config.ThrottleLatest, Interval: time.Second},
+			})
+			fakeProvider := new(streamfakes.FakeProvider)
+			fakeProvider.StreamIDReturns(1234)
+			manager.StreamUp() <- stream.StreamUpEvent{Adapter: "Hook", Provider: fakeProvider}
+			Eventually(fakeHandler.ServeCalled).Should(BeTrue())
+		})
+
+		It("throttles new streams using the rules", func() {
+			Expect(manager.ThrottleStats(1234)).To(Equal([]models.ThrottleStat{
+				{Datatype: "Movement", Policy: models.ThrottlePolicyLatest},
+			}))
+		})
+
+		It("applies changes of the rules to running streams", func() {
+			manager.SetThrottleRules(stream.ThrottleRules{
+				"UpdateHPMPTP": {Policy: config.ThrottleDedup},
+			})
+			Expect(manager.ThrottleStats(1234)).To(Equal([]models.ThrottleStat{
+				{Datatype: "UpdateHPMPTP", Policy: models.ThrottlePolicyDedup},
+			}))
+			Expect(handlerFactoryArgs.Throttle.RulesChanged()).To(Receive())
+		})
+	})
+
 	Context("when a new stream is created", func() {
 		var (
 			fakeProvider *streamfakes.FakeProvider
@@ -139,7 +475,7 @@ var _ = Describe("Manager", func() {
 			fakeProvider.SubscribeIngressReturns(ingressChan)
 			fakeProvider.SubscribeEgressReturns(egressChan)
 			fakeProvider.SendRequestReturns([]byte("ack"), nil)
-			manager.StreamUp() <- fakeProvider
+			manager.StreamUp() <- stream.StreamUpEvent{Adapter: "Hook", Provider: fakeProvider}
 
 			Eventually(fakeHandler.ServeCalled).Should(BeTrue())
 			Eventually(fakeProvider.SubscribeEgressCallCount()).Should(BeNumerically(">", 0))
@@ -147,8 +483,10 @@ var _ = Describe("Manager", func() {
 
 		It("creates a new Handler for the stream", func() {
 			Expect(handlerFactoryArgs.StreamID).To(Equal(1234))
+			Expect(handlerFactoryArgs.Source).To(Equal(models.StreamSource{Adapter: "Hook", Key: "1234"}))
 			Expect(handlerFactoryArgs.IngressChan).To(Equal(ingressChan))
 			Expect(handlerFactoryArgs.EgressChan).To(Equal(egressChan))
+			Expect(handlerFactoryArgs.HealthChan).To(BeNil())
 			Expect(handlerFactoryArgs.UpdateChan).To(Equal(updateChan))
 			Expect(handlerFactoryArgs.Generator).To(Equal(generator))
 		})
@@ -166,10 +504,44 @@ var _ = Describe("Manager", func() {
 			})
 		})
 
+		Describe("ThrottleStats", func() {
+			It("returns the stats of the stream's throttle", func() {
+				Expect(handlerFactoryArgs.Throttle).ToNot(BeNil())
+				Expect(manager.ThrottleStats(1234)).To(BeEmpty())
+			})
+
+			It("errors when the stream doesn't exist", func() {
+				_, err := manager.ThrottleStats(5678)
+				Expect(err).To(MatchError("stream provider 5678 not found"))
+			})
+		})
+
+		Describe("HookMessages", func() {
+			It("returns an empty list if the stream does not report hook messages", func() {
+				Expect(manager.HookMessages(1234)).To(BeEmpty())
+			})
+
+			It("errors when the stream doesn't exist", func() {
+				_, err := manager.HookMessages(5678)
+				Expect(err).To(MatchError("stream provider 5678 not found"))
+			})
+		})
+
+		Describe("Commands", func() {
+			It("returns an empty list if the stream does not list its commands", func() {
+				Expect(manager.Commands(1234)).To(BeEmpty())
+			})
+
+			It("errors when the stream doesn't exist", func() {
+				_, err := manager.Commands(5678)
+				Expect(err).To(MatchError("stream provider 5678 not found"))
+			})
+		})
+
 		Context("when the stream is closed", func() {
 			BeforeEach(func() {
 				Consistently(fakeHandler.StopCalled).Should(BeFalse())
-				manager.StreamDown() <- 1234
+				manager.StreamDown() <- stream.StreamDownEvent{Adapter: "Hook", StreamID: 1234}
 			})
 
 			It("stops the Handler for the stream", func() {
@@ -0,0 +1,246 @@
+package stream
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/xivnet/v3"
+)
+
+// ThrottleRule sets the policy used to drop blocks of a datatype. The
+// Interval is only used by the "latest" policy.
+type ThrottleRule struct {
+	Policy   string
+	Interval time.Duration
+}
+
+// ThrottleRules maps the name of an xivnet datatype to its ThrottleRule
+type ThrottleRules map[string]ThrottleRule
+
+// NewThrottleRules converts the throttle configuration into ThrottleRules.
+func NewThrottleRules(cfg map[string]config.ThrottleConfig) ThrottleRules {
+	rules := make(ThrottleRules)
+	for datatype, c := range cfg {
+		rules[datatype] = ThrottleRule{
+			Policy:   c.Policy,
+			Interval: time.Duration(c.IntervalMS) * time.Millisecond,
+		}
+	}
+	return rules
+}
+
+// ThrottledBlock is a block that was held back by a Throttle
+type ThrottledBlock struct {
+	IsEgress bool
+	Block    *xivnet.Block
+}
+
+type throttleKey struct {
+	datatype  string
+	isEgress  bool
+	subjectID uint32
+}
+
+type throttleWindow struct {
+	until   time.Time
+	pending *xivnet.Block
+}
+
+// zoneChangeDatatype is the datatype of the block that the game sends when
+// the player changes zones. The entities of the previous zone are gone once
+// it is received, so the Throttle forgets the blocks it kept about them.
+const zoneChangeDatatype = "InitZone"
+
+// Throttle drops high frequency blocks of a stream according to the policy
+// of each datatype, and it counts the number of blocks it dropped.
+type Throttle struct {
+	lock    sync.Mutex
+	rules   ThrottleRules
+	last    map[throttleKey]xivnet.BlockData
+	windows map[throttleKey]*throttleWindow
+	dropped map[string]int
+
+	rulesChanged chan struct{}
+}
+
+// NewThrottle returns a new Throttle that applies the given rules.
+func NewThrottle(rules ThrottleRules) *Throttle {
+	return &Throttle{
+		rules:   rules,
+		last:    make(map[throttleKey]xivnet.BlockData),
+		windows: make(map[throttleKey]*throttleWindow),
+		dropped: make(map[string]int),
+
+		rulesChanged: make(chan struct{}, 1),
+	}
+}
+
+// SetRules replaces the rules applied by the Throttle. Any blocks that are
+// held back are discarded, and the previous blocks of each subject are
+// forgotten. The counters are kept.
+func (t *Throttle) SetRules(rules ThrottleRules) {
+	t.lock.Lock()
+	t.rules = rules
+	t.forget()
+	t.lock.Unlock()
+
+	select {
+	case t.rulesChanged <- struct{}{}:
+	default:
+	}
+}
+
+// RulesChanged returns a channel that is notified whenever the rules are
+// replaced, so that the caller can check the FlushInterval again.
+func (t *Throttle) RulesChanged() <-chan struct{} {
+	return t.rulesChanged
+}
+
+// Allow returns whether the block should be processed now. Blocks held back
+// by the "latest" policy are returned by Flush once their interval elapses,
+// unless a newer block about the same subject replaces them.
+//
+// A zone change block makes the Throttle forget the blocks of every subject,
+// since the subjects of the previous zone are gone.
+func (t *Throttle) Allow(isEgress bool, block *xivnet.Block, now time.Time) bool {
+	datatype := datatypeName(block.Data)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if datatype == zoneChangeDatatype && !isEgress {
+		t.forget()
+	}
+	rule, found := t.rules[datatype]
+	if !found {
+		return true
+	}
+	key := throttleKey{datatype: datatype, isEgress: isEgress, subjectID: block.SubjectID}
+
+	switch rule.Policy {
+	case config.ThrottleDedup:
+		if prev, found := t.last[key]; found && reflect.DeepEqual(prev, block.Data) {
+			t.dropped[datatype]++
+			return false
+		}
+		t.last[key] = block.Data
+	case config.ThrottleLatest:
+		w, found := t.windows[key]
+		if found && now.Before(w.until) {
+			if w.pending != nil {
+				t.dropped[datatype]++
+			}
+			w.pending = block
+			return false
+		}
+		if found && w.pending != nil {
+			// The block supersedes the pending block that was not flushed
+			// in time
+			t.dropped[datatype]++
+		}
+		t.windows[key] = &throttleWindow{until: now.Add(rule.Interval)}
+	}
+	return true
+}
+
+// Flush returns the blocks held back by the "latest" policy whose interval
+// has elapsed.
+func (t *Throttle) Flush(now time.Time) []ThrottledBlock {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var blocks []ThrottledBlock
+	for key, w := range t.windows {
+		if now.Before(w.until) {
+			continue
+		}
+		if w.pending == nil {
+			delete(t.windows, key)
+			continue
+		}
+		blocks = append(blocks, ThrottledBlock{IsEgress: key.isEgress, Block: w.pending})
+		w.pending = nil
+		w.until = now.Add(t.rules[key.datatype].Interval)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Block.Time.Before(blocks[j].Block.Time)
+	})
+	return blocks
+}
+
+// FlushInterval returns how often Flush should be called, or 0 if no rule
+// holds back any blocks.
+func (t *Throttle) FlushInterval() time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var interval time.Duration
+	for _, rule := range t.rules {
+		if rule.Policy != config.ThrottleLatest {
+			continue
+		}
+		if interval == 0 || rule.Interval < interval {
+			interval = rule.Interval
+		}
+	}
+	return interval
+}
+
+// Reset discards any blocks that are held back and forgets the previous
+// blocks of each subject. The counters are kept.
+func (t *Throttle) Reset() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.forget()
+}
+
+// forget discards the held back blocks and the previous blocks of each
+// subject.
+// It is expected to be used inside a critical section
+func (t *Throttle) forget() {
+	t.last = make(map[throttleKey]xivnet.BlockData)
+	t.windows = make(map[throttleKey]*throttleWindow)
+}
+
+// Stats returns the number of blocks dropped for each throttled datatype,
+// sorted by datatype.
+func (t *Throttle) Stats() []models.ThrottleStat {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	stats := make([]models.ThrottleStat, 0, len(t.rules))
+	for datatype, rule := range t.rules {
+		stat := models.ThrottleStat{
+			Datatype: datatype,
+			Policy:   models.ThrottlePolicyPass,
+			Dropped:  t.dropped[datatype],
+		}
+		switch rule.Policy {
+		case config.ThrottleDedup:
+			stat.Policy = models.ThrottlePolicyDedup
+		case config.ThrottleLatest:
+			stat.Policy = models.ThrottlePolicyLatest
+		}
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Datatype < stats[j].Datatype
+	})
+	return stats
+}
+
+// datatypeName returns the name of the xivnet datatype of the block data
+func datatypeName(data xivnet.BlockData) string {
+	t := reflect.TypeOf(data)
+	if t == nil {
+		return ""
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
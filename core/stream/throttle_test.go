@@ -0,0 +1,174 @@
+package stream_test
+
+import (
+	"time"
+
+	"github.com/ff14wed/aetherometer/core/config"
+	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Throttle", func() {
+	var (
+		throttle *stream.Throttle
+		now      time.Time
+	)
+
+	hpBlock := func(subjectID uint32, hp uint32) *xivnet.Block {
+		return &xivnet.Block{SubjectID: subjectID, Data: &datatypes.UpdateHPMPTP{HP: hp}}
+	}
+
+	movementBlock := func(subjectID uint32, direction uint8) *xivnet.Block {
+		return &xivnet.Block{SubjectID: subjectID, Data: &datatypes.Movement{Direction: direction}}
+	}
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+		throttle = stream.NewThrottle(stream.NewThrottleRules(map[string]config.ThrottleConfig{
+			"UpdateHPMPTP": {Policy: config.ThrottleDedup},
+			"Movement":     {Policy: config.ThrottleLatest, IntervalMS: 100},
+			"Action":       {Policy: config.ThrottlePass},
+		}))
+	})
+
+	It("allows blocks of datatypes without a rule", func() {
+		b := &xivnet.Block{Data: &datatypes.SetPos{}}
+		Expect(throttle.Allow(false, b, now)).To(BeTrue())
+		Expect(throttle.Allow(false, b, now)).To(BeTrue())
+	})
+
+	It("allows every block of datatypes with the pass policy", func() {
+		b := &xivnet.Block{Data: &datatypes.Action{}}
+		Expect(throttle.Allow(false, b, now)).To(BeTrue())
+		Expect(throttle.Allow(false, b, now)).To(BeTrue())
+	})
+
+	Describe("the dedup policy", func() {
+		It("drops exact duplicates of the previous block about the same subject", func() {
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeFalse())
+			Expect(throttle.Allow(false, hpBlock(2, 100), now)).To(BeTrue())
+			Expect(throttle.Allow(false, hpBlock(1, 200), now)).To(BeTrue())
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+		})
+
+		It("forgets the previous blocks when it is reset", func() {
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+			throttle.Reset()
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+		})
+
+		It("forgets the previous blocks when the zone changes", func() {
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+			Expect(throttle.Allow(false, &xivnet.Block{Data: &datatypes.InitZone{}}, now)).To(BeTrue())
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+		})
+	})
+
+	Describe("the latest policy", func() {
+		It("holds back blocks within the interval and flushes the latest one", func() {
+			Expect(throttle.Allow(false, movementBlock(1, 1), now)).To(BeTrue())
+			Expect(throttle.Allow(false, movementBlock(1, 2), now.Add(10*time.Millisecond))).To(BeFalse())
+			Expect(throttle.Allow(false, movementBlock(1, 3), now.Add(20*time.Millisecond))).To(BeFalse())
+			Expect(throttle.Allow(false, movementBlock(2, 4), now.Add(20*time.Millisecond))).To(BeTrue())
+
+			Expect(throttle.Flush(now.Add(50 * time.Millisecond))).To(BeEmpty())
+			Expect(throttle.Flush(now.Add(100 * time.Millisecond))).To(Equal([]stream.ThrottledBlock{
+				{IsEgress: false, Block: movementBlock(1, 3)},
+			}))
+		})
+
+		It("starts a new interval after flushing a block", func() {
+			Expect(throttle.Allow(false, movementBlock(1, 1), now)).To(BeTrue())
+			Expect(throttle.Allow(false, movementBlock(1, 2), now)).To(BeFalse())
+			Expect(throttle.Flush(now.Add(100 * time.Millisecond))).To(HaveLen(1))
+
+			Expect(throttle.Allow(false, movementBlock(1, 3), now.Add(150*time.Millisecond))).To(BeFalse())
+			Expect(throttle.Flush(now.Add(200 * time.Millisecond))).To(HaveLen(1))
+			Expect(throttle.Flush(now.Add(300 * time.Millisecond))).To(BeEmpty())
+			Expect(throttle.Allow(false, movementBlock(1, 4), now.Add(310*time.Millisecond))).To(BeTrue())
+		})
+
+		It("discards the held back blocks when the zone changes", func() {
+			Expect(throttle.Allow(false, movementBlock(1, 1), now)).To(BeTrue())
+			Expect(throttle.Allow(false, movementBlock(1, 2), now)).To(BeFalse())
+			Expect(throttle.Allow(false, &xivnet.Block{Data: &datatypes.InitZone{}}, now)).To(BeTrue())
+			Expect(throttle.Flush(now.Add(100 * time.Millisecond))).To(BeEmpty())
+			Expect(throttle.Allow(false, movementBlock(1, 3), now)).To(BeTrue())
+		})
+
+		It("discards the held back blocks when it is reset", func() {
+			Expect(throttle.Allow(false, movementBlock(1, 1), now)).To(BeTrue())
+			Expect(throttle.Allow(false, movementBlock(1, 2), now)).To(BeFalse())
+			throttle.Reset()
+			Expect(throttle.Flush(now.Add(100 * time.Millisecond))).To(BeEmpty())
+			Expect(throttle.Allow(false, movementBlock(1, 3), now)).To(BeTrue())
+		})
+	})
+
+	Describe("SetRules", func() {
+		It("applies the new rules and discards the held back blocks", func() {
+			Expect(throttle.Allow(false, movementBlock(1, 1), now)).To(BeTrue())
+			Expect(throttle.Allow(false, movementBlock(1, 2), now)).To(BeFalse())
+
+			throttle.SetRules(stream.ThrottleRules{
+				"Movement": {Policy: config.ThrottleDedup},
+			})
+			Expect(throttle.RulesChanged()).To(Receive())
+			Expect(throttle.FlushInterval()).To(BeZero())
+			Expect(throttle.Flush(now.Add(100 * time.Millisecond))).To(BeEmpty())
+
+			Expect(throttle.Allow(false, movementBlock(1, 2), now)).To(BeTrue())
+			Expect(throttle.Allow(false, movementBlock(1, 2), now)).To(BeFalse())
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+			Expect(throttle.Allow(false, hpBlock(1, 100), now)).To(BeTrue())
+		})
+
+		It("keeps the counters", func() {
+			throttle.Allow(false, hpBlock(1, 100), now)
+			throttle.Allow(false, hpBlock(1, 100), now)
+			throttle.SetRules(stream.ThrottleRules{
+				"UpdateHPMPTP": {Policy: config.ThrottleDedup},
+			})
+			Expect(throttle.Stats()).To(Equal([]models.ThrottleStat{
+				{Datatype: "UpdateHPMPTP", Policy: models.ThrottlePolicyDedup, Dropped: 1},
+			}))
+		})
+	})
+
+	Describe("FlushInterval", func() {
+		It("returns the shortest interval of the latest policies", func() {
+			throttle = stream.NewThrottle(stream.ThrottleRules{
+				"Movement": {Policy: config.ThrottleLatest, Interval: 100 * time.Millisecond},
+				"SetPos":   {Policy: config.ThrottleLatest, Interval: 50 * time.Millisecond},
+			})
+			Expect(throttle.FlushInterval()).To(Equal(50 * time.Millisecond))
+		})
+
+		It("returns zero if no blocks are held back", func() {
+			Expect(stream.NewThrottle(nil).FlushInterval()).To(BeZero())
+		})
+	})
+
+	Describe("Stats", func() {
+		It("counts the dropped blocks of each datatype", func() {
+			throttle.Allow(false, hpBlock(1, 100), now)
+			throttle.Allow(false, hpBlock(1, 100), now)
+			throttle.Allow(false, hpBlock(1, 100), now)
+			throttle.Allow(false, movementBlock(1, 1), now)
+			throttle.Allow(false, movementBlock(1, 2), now)
+			throttle.Allow(false, movementBlock(1, 3), now)
+
+			Expect(throttle.Stats()).To(Equal([]models.ThrottleStat{
+				{Datatype: "Action", Policy: models.ThrottlePolicyPass},
+				{Datatype: "Movement", Policy: models.ThrottlePolicyLatest, Dropped: 1},
+				{Datatype: "UpdateHPMPTP", Policy: models.ThrottlePolicyDedup, Dropped: 2},
+			}))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package transform
+
+import (
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"go.uber.org/zap"
+)
+
+// lockonMarkerControlType is the type of the Control block that places a
+// lockon marker on an entity
+const lockonMarkerControlType = 0x22
+
+// PDKTransformer undoes the obfuscation of lockon markers in zones that use a
+// per-zone key (PDK). The key is learned from the first Casting block in the
+// zone, and it is subtracted from the marker ID of every lockon marker Control
+// block that follows.
+type PDKTransformer struct {
+	logger *zap.Logger
+	pdk    byte
+}
+
+// NewPDKTransformer creates a new PDKTransformer
+func NewPDKTransformer(logger *zap.Logger) Transformer {
+	return &PDKTransformer{logger: logger}
+}
+
+// AppliesToZone returns true for the zones known to use a PDK
+func (t *PDKTransformer) AppliesToZone(territoryTypeID uint16) bool {
+	switch territoryTypeID {
+	case 946, 947, 948, 949:
+		return true
+	}
+	return false
+}
+
+// Learn derives the PDK from the first Casting block in the zone
+func (t *PDKTransformer) Learn(block *xivnet.Block) {
+	if t.pdk != 0 {
+		return
+	}
+	if z, ok := block.Data.(*datatypes.Casting); ok {
+		t.pdk = byte(z.ActionID - uint32(z.ActionIDName))
+		t.logger.Info("Detected PDK for current zone", zap.Uint8("pdk", t.pdk))
+	}
+}
+
+// Transform removes the PDK from lockon marker Control blocks once the PDK
+// is known
+func (t *PDKTransformer) Transform(block *xivnet.Block) {
+	if t.pdk == 0 {
+		return
+	}
+	if z, ok := block.Data.(*datatypes.Control); ok && z.Type == lockonMarkerControlType {
+		z.P1 = z.P1 - uint32(t.pdk)
+	}
+}
+
+// Reset forgets the PDK of the previous zone
+func (t *PDKTransformer) Reset() {
+	t.pdk = 0
+}
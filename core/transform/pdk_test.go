@@ -0,0 +1,74 @@
+package transform_test
+
+import (
+	"github.com/ff14wed/aetherometer/core/transform"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PDKTransformer", func() {
+	var (
+		t transform.Transformer
+
+		casting *xivnet.Block
+	)
+
+	lockon := func() *xivnet.Block {
+		return &xivnet.Block{Data: &datatypes.Control{Type: 0x22, P1: 0x40}}
+	}
+
+	BeforeEach(func() {
+		t = transform.NewPDKTransformer(zap.NewNop())
+		casting = &xivnet.Block{Data: &datatypes.Casting{ActionIDName: 0x100, ActionID: 0x112}}
+	})
+
+	It("applies to the zones that use a PDK", func() {
+		for _, zone := range []uint16{946, 947, 948, 949} {
+			Expect(t.AppliesToZone(zone)).To(BeTrue())
+		}
+		Expect(t.AppliesToZone(945)).To(BeFalse())
+		Expect(t.AppliesToZone(950)).To(BeFalse())
+	})
+
+	It("does not transform lockon markers before the PDK is known", func() {
+		b := lockon()
+		t.Transform(b)
+		Expect(b.Data).To(Equal(&datatypes.Control{Type: 0x22, P1: 0x40}))
+	})
+
+	Context("when the PDK has been learned", func() {
+		BeforeEach(func() {
+			t.Learn(casting)
+		})
+
+		It("removes the PDK from lockon markers", func() {
+			b := lockon()
+			t.Transform(b)
+			Expect(b.Data).To(Equal(&datatypes.Control{Type: 0x22, P1: 0x2E}))
+		})
+
+		It("does not transform other Control blocks", func() {
+			b := &xivnet.Block{Data: &datatypes.Control{Type: 0x23, P1: 0x40}}
+			t.Transform(b)
+			Expect(b.Data).To(Equal(&datatypes.Control{Type: 0x23, P1: 0x40}))
+		})
+
+		It("keeps the first PDK it learned", func() {
+			t.Learn(&xivnet.Block{Data: &datatypes.Casting{ActionIDName: 0x100, ActionID: 0x101}})
+			b := lockon()
+			t.Transform(b)
+			Expect(b.Data).To(Equal(&datatypes.Control{Type: 0x22, P1: 0x2E}))
+		})
+
+		It("forgets the PDK when it is reset", func() {
+			t.Reset()
+			b := lockon()
+			t.Transform(b)
+			Expect(b.Data).To(Equal(&datatypes.Control{Type: 0x22, P1: 0x40}))
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package transform
+
+import (
+	"sync"
+
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Transformer
+
+// Transformer undoes the obfuscation that the game applies to blocks in
+// certain zones. Each stream gets its own instance of every Transformer, so
+// implementations may keep per-stream state without any locking.
+type Transformer interface {
+	// AppliesToZone returns whether blocks in the zone with the given
+	// territory type need to be transformed.
+	AppliesToZone(territoryTypeID uint16) bool
+
+	// Learn inspects a block received in an applicable zone in order to
+	// discover any state needed to transform later blocks, such as a key.
+	Learn(block *xivnet.Block)
+
+	// Transform mutates a block received in an applicable zone in place.
+	Transform(block *xivnet.Block)
+
+	// Reset clears any learned state. It is called whenever the stream
+	// changes zones.
+	Reset()
+}
+
+// Factory creates a new instance of a Transformer for a stream
+type Factory func(logger *zap.Logger) Transformer
+
+type namedFactory struct {
+	name    string
+	factory Factory
+}
+
+// Registry keeps track of the Transformers that are applied to the blocks of
+// every stream.
+type Registry struct {
+	lock      sync.RWMutex
+	factories []namedFactory
+}
+
+// NewRegistry returns a new Registry without any Transformers
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a Transformer to the registry. Transformers are applied in
+// the order that they are registered. Registering a name that already exists
+// replaces the previous Transformer.
+func (r *Registry) Register(name string, factory Factory) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i, f := range r.factories {
+		if f.name == name {
+			r.factories[i].factory = factory
+			return
+		}
+	}
+	r.factories = append(r.factories, namedFactory{name: name, factory: factory})
+}
+
+// Unregister removes the Transformer with the given name from the registry.
+// If the Transformer doesn't exist, it is a no-op.
+func (r *Registry) Unregister(name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i, f := range r.factories {
+		if f.name == name {
+			r.factories = append(r.factories[:i:i], r.factories[i+1:]...)
+			return
+		}
+	}
+}
+
+// NewPipeline creates a new instance of every registered Transformer for a
+// stream. Transformers registered afterwards do not apply to the Pipeline.
+func (r *Registry) NewPipeline(logger *zap.Logger) *Pipeline {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	p := &Pipeline{}
+	for _, f := range r.factories {
+		p.stages = append(p.stages, &stage{
+			transformer: f.factory(logger.Named(f.name)),
+		})
+	}
+	return p
+}
+
+type stage struct {
+	transformer Transformer
+	active      bool
+}
+
+// Pipeline applies the Transformers of a single stream to its blocks. It is
+// not safe for concurrent use.
+type Pipeline struct {
+	stages []*stage
+}
+
+// Apply runs the block through every Transformer that applies to the
+// current zone. An InitZone block resets all of the Transformers and
+// determines which of them apply to the new zone.
+func (p *Pipeline) Apply(block *xivnet.Block) {
+	if z, ok := block.Data.(*datatypes.InitZone); ok {
+		for _, s := range p.stages {
+			s.transformer.Reset()
+			s.active = s.transformer.AppliesToZone(z.TerritoryTypeID)
+		}
+	}
+	for _, s := range p.stages {
+		if !s.active {
+			continue
+		}
+		s.transformer.Learn(block)
+		s.transformer.Transform(block)
+	}
+}
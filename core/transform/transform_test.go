@@ -0,0 +1,118 @@
+package transform_test
+
+import (
+	"github.com/ff14wed/aetherometer/core/transform"
+	"github.com/ff14wed/aetherometer/core/transform/transformfakes"
+	"github.com/ff14wed/xivnet/v3"
+	"github.com/ff14wed/xivnet/v3/datatypes"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Registry", func() {
+	var (
+		registry *transform.Registry
+
+		fakeA, fakeB *transformfakes.FakeTransformer
+		order        []string
+	)
+
+	initZone := func(territoryTypeID uint16) *xivnet.Block {
+		return &xivnet.Block{Data: &datatypes.InitZone{TerritoryTypeID: territoryTypeID}}
+	}
+
+	BeforeEach(func() {
+		registry = transform.NewRegistry()
+		order = nil
+
+		fakeA = new(transformfakes.FakeTransformer)
+		fakeA.AppliesToZoneStub = func(territoryTypeID uint16) bool {
+			return territoryTypeID == 1
+		}
+		fakeA.TransformStub = func(*xivnet.Block) { order = append(order, "a") }
+
+		fakeB = new(transformfakes.FakeTransformer)
+		fakeB.AppliesToZoneStub = func(territoryTypeID uint16) bool {
+			return territoryTypeID <= 2
+		}
+		fakeB.TransformStub = func(*xivnet.Block) { order = append(order, "b") }
+
+		registry.Register("a", func(*zap.Logger) transform.Transformer { return fakeA })
+		registry.Register("b", func(*zap.Logger) transform.Transformer { return fakeB })
+	})
+
+	Describe("Pipeline", func() {
+		var pipeline *transform.Pipeline
+
+		BeforeEach(func() {
+			pipeline = registry.NewPipeline(zap.NewNop())
+		})
+
+		It("does not apply any transformers before the zone is known", func() {
+			pipeline.Apply(&xivnet.Block{})
+			Expect(fakeA.LearnCallCount()).To(BeZero())
+			Expect(fakeA.TransformCallCount()).To(BeZero())
+			Expect(fakeB.TransformCallCount()).To(BeZero())
+		})
+
+		It("resets every transformer when the zone changes", func() {
+			pipeline.Apply(initZone(1))
+			Expect(fakeA.ResetCallCount()).To(Equal(1))
+			Expect(fakeB.ResetCallCount()).To(Equal(1))
+			Expect(fakeA.AppliesToZoneArgsForCall(0)).To(BeEquivalentTo(1))
+		})
+
+		It("applies the transformers for the current zone in the order they were registered", func() {
+			pipeline.Apply(initZone(1))
+			order = nil
+
+			block := &xivnet.Block{Data: &datatypes.Casting{}}
+			pipeline.Apply(block)
+			Expect(order).To(Equal([]string{"a", "b"}))
+			Expect(fakeA.LearnArgsForCall(1)).To(Equal(block))
+			Expect(fakeA.TransformArgsForCall(1)).To(Equal(block))
+		})
+
+		It("only applies the transformers that apply to the current zone", func() {
+			pipeline.Apply(initZone(2))
+			order = nil
+
+			pipeline.Apply(&xivnet.Block{})
+			Expect(order).To(Equal([]string{"b"}))
+
+			pipeline.Apply(initZone(3))
+			order = nil
+
+			pipeline.Apply(&xivnet.Block{})
+			Expect(order).To(BeEmpty())
+		})
+	})
+
+	Describe("Register", func() {
+		It("replaces a transformer with the same name", func() {
+			fakeC := new(transformfakes.FakeTransformer)
+			fakeC.AppliesToZoneReturns(true)
+			registry.Register("a", func(*zap.Logger) transform.Transformer { return fakeC })
+
+			pipeline := registry.NewPipeline(zap.NewNop())
+			pipeline.Apply(initZone(1))
+			Expect(fakeA.ResetCallCount()).To(BeZero())
+			Expect(fakeC.TransformCallCount()).To(Equal(1))
+			Expect(order).To(Equal([]string{"b"}))
+		})
+	})
+
+	Describe("Unregister", func() {
+		It("removes the transformer from new pipelines", func() {
+			registry.Unregister("a")
+			registry.Unregister("missing")
+
+			pipeline := registry.NewPipeline(zap.NewNop())
+			pipeline.Apply(initZone(1))
+			Expect(fakeA.ResetCallCount()).To(BeZero())
+			Expect(order).To(Equal([]string{"b"}))
+		})
+	})
+})
@@ -16,6 +16,8 @@ import (
 
 	winio "github.com/Microsoft/go-winio"
 	"golang.org/x/sys/windows"
+
+	"github.com/ff14wed/aetherometer/core/process"
 )
 
 type Provider struct{}
@@ -55,6 +57,44 @@ func (p Provider) EnumerateProcesses() (map[uint32]string, error) {
 	return procMap, nil
 }
 
+// EnumerateProcessInfo enumerates all processes running on the system and
+// returns the name, parent, and command line of each process. The command line
+// is left empty for the processes that cannot be queried.
+//
+// API Methods used:
+// CreateToolhelp32Snapshot https://docs.microsoft.com/en-us/windows/desktop/api/tlhelp32/nf-tlhelp32-createtoolhelp32snapshot
+// Process32First https://docs.microsoft.com/en-us/windows/desktop/api/tlhelp32/nf-tlhelp32-process32firstw
+// Process32Next https://docs.microsoft.com/en-us/windows/desktop/api/tlhelp32/nf-tlhelp32-process32nextw
+// CloseHandle https://msdn.microsoft.com/en-us/library/windows/desktop/ms724211(v=vs.85).aspx
+func (p Provider) EnumerateProcessInfo() ([]process.ProcessInfo, error) {
+	snapshotHandle, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return nil, fmt.Errorf("CreateToolhelp32Snapshot error: %s", err.Error())
+	}
+	defer func() {
+		_ = windows.CloseHandle(snapshotHandle)
+	}()
+	var pe32 windows.ProcessEntry32
+	pe32.Size = pe32Size
+	err = windows.Process32First(snapshotHandle, &pe32)
+	if err != nil {
+		return nil, fmt.Errorf("Process32First error: %s", err.Error())
+	}
+
+	var infos []process.ProcessInfo
+	for err == nil {
+		cmdline, _ := getCommandLine(pe32.ProcessID)
+		infos = append(infos, process.ProcessInfo{
+			PID:     pe32.ProcessID,
+			PPID:    pe32.ParentProcessID,
+			Names:   []string{windows.UTF16ToString(pe32.ExeFile[:])},
+			Cmdline: cmdline,
+		})
+		err = windows.Process32Next(snapshotHandle, &pe32)
+	}
+	return infos, nil
+}
+
 // EnumerateProcessModules enumerates all modules loaded inside a process and
 // returns a list of modules
 //
@@ -107,6 +147,39 @@ func getRunningTime(handle windows.Handle) (time.Duration, error) {
 	return time.Since(createdAt), nil
 }
 
+// getCommandLine returns the command line that the process was started with
+//
+// API Methods used:
+// OpenProcess https://docs.microsoft.com/en-us/windows/desktop/api/processthreadsapi/nf-processthreadsapi-openprocess
+// NtQueryInformationProcess https://docs.microsoft.com/en-us/windows/win32/api/winternl/nf-winternl-ntqueryinformationprocess
+// CloseHandle https://msdn.microsoft.com/en-us/library/windows/desktop/ms724211(v=vs.85).aspx
+func getCommandLine(pid uint32) (string, error) {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, pid)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = windows.CloseHandle(handle)
+	}()
+
+	var size uint32
+	err = windows.NtQueryInformationProcess(handle, windows.ProcessCommandLineInformation, nil, 0, &size)
+	if err != windows.STATUS_INFO_LENGTH_MISMATCH || size < uint32(unsafe.Sizeof(windows.NTUnicodeString{})) {
+		return "", fmt.Errorf("NtQueryInformationProcess error: %v", err)
+	}
+	buf := make([]byte, size)
+	err = windows.NtQueryInformationProcess(handle, windows.ProcessCommandLineInformation, unsafe.Pointer(&buf[0]), size, &size)
+	if err != nil {
+		return "", fmt.Errorf("NtQueryInformationProcess error: %v", err)
+	}
+	cmdline := (*windows.NTUnicodeString)(unsafe.Pointer(&buf[0]))
+	if cmdline.Buffer == nil {
+		return "", nil
+	}
+	// Length is in bytes and the string is not necessarily null terminated
+	return string(utf16.Decode(unsafe.Slice(cmdline.Buffer, cmdline.Length/2))), nil
+}
+
 type ErrDLLAlreadyInjected struct {
 	DLLName string
 }
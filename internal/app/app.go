@@ -11,12 +11,15 @@ import (
 	"github.com/ff14wed/aetherometer/core/adapter"
 	"github.com/ff14wed/aetherometer/core/config"
 	"github.com/ff14wed/aetherometer/core/datasheet"
+	"github.com/ff14wed/aetherometer/core/journal"
 	"github.com/ff14wed/aetherometer/core/models"
+	"github.com/ff14wed/aetherometer/core/opcodes"
 	"github.com/ff14wed/aetherometer/core/server"
 	"github.com/ff14wed/aetherometer/core/server/handlers"
 	"github.com/ff14wed/aetherometer/core/store"
 	"github.com/ff14wed/aetherometer/core/store/update"
 	"github.com/ff14wed/aetherometer/core/stream"
+	"github.com/ff14wed/aetherometer/core/transform"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -47,12 +50,15 @@ type App struct {
 	appSupervisor    *suture.Supervisor
 	streamSupervisor *suture.Supervisor
 
-	collection     *datasheet.Collection
-	srv            *server.Server
-	storeProvider  *store.Provider
-	authHandler    *handlers.Auth
-	streamManager  *stream.Manager
-	streamAdapters map[string]stream.Adapter
+	collection        *datasheet.Collection
+	opcodeRegistry    *opcodes.Registry
+	transformRegistry *transform.Registry
+	srv               *server.Server
+	storeProvider     *store.Provider
+	eventJournal      *journal.Journal
+	authHandler       *handlers.Auth
+	streamManager     *stream.Manager
+	adapterSupervisor *stream.AdapterSupervisor
 
 	ready chan struct{}
 }
@@ -99,7 +105,34 @@ func (b *App) Initialize() error {
 
 	generator := update.NewGenerator(b.collection)
 
-	b.storeProvider = store.NewProvider(b.logger)
+	b.opcodeRegistry = opcodes.NewRegistry()
+	b.transformRegistry = transform.NewRegistry()
+	b.transformRegistry.Register("pdk", transform.NewPDKTransformer)
+
+	snapshotCfg := b.cfgProvider.Config().Snapshot
+	historyCfg := b.cfgProvider.Config().History
+	var storeOpts []store.Option
+	if snapshotCfg.File != "" {
+		storeOpts = append(storeOpts, store.WithSnapshot(snapshotCfg.File, time.Duration(snapshotCfg.Interval)))
+	}
+	if historyCfg.Duration > 0 {
+		storeOpts = append(storeOpts, store.WithHistory(time.Duration(historyCfg.Duration), time.Duration(historyCfg.KeyframeInterval)))
+	}
+	if journalCfg := b.cfgProvider.Config().Journal; journalCfg.Enabled && journalCfg.Dir != "" {
+		var journalOpts []journal.Option
+		if journalCfg.SegmentSize > 0 {
+			journalOpts = append(journalOpts, journal.WithSegmentSize(journalCfg.SegmentSize))
+		}
+		if journalCfg.MaxSegments > 0 {
+			journalOpts = append(journalOpts, journal.WithMaxSegments(journalCfg.MaxSegments))
+		}
+		b.eventJournal, err = journal.New(journalCfg.Dir, b.logger, journalOpts...)
+		if err != nil {
+			return fmt.Errorf("could not initialize event journal: %s", err)
+		}
+		storeOpts = append(storeOpts, store.WithEventLog(b.eventJournal.EventsChan()))
+	}
+	b.storeProvider = store.NewProvider(b.logger, storeOpts...)
 
 	b.authHandler, err = handlers.NewAuth(b.cfgProvider, b.logger)
 	if err != nil {
@@ -125,14 +158,16 @@ func (b *App) Initialize() error {
 		b.logger,
 	)
 
-	cfg := b.cfgProvider.Config()
-	b.streamAdapters, err = stream.BuildAdapterInventory(
-		adapter.Inventory(),
-		cfg,
+	b.adapterSupervisor = stream.NewAdapterSupervisor(
+		adapter.Inventory(b.opcodeRegistry, b.transformRegistry),
+		b.cfgProvider,
+		b.appSupervisor,
+		b.streamManager,
 		b.streamManager.StreamUp(),
 		b.streamManager.StreamDown(),
 		b.logger,
 	)
+	err = b.adapterSupervisor.LoadConfig(b.cfgProvider.Config())
 	if err != nil {
 		return fmt.Errorf("could not initialize adapters: %s", err)
 	}
@@ -150,12 +185,20 @@ func (b *App) Startup(ctx context.Context) {
 	b.appSupervisor.Add(b.cfgProvider)
 	b.cfgProvider.WaitUntilReady()
 
+	if opcodeFile := b.cfgProvider.Config().Sources.OpcodeFile; opcodeFile != "" {
+		b.appSupervisor.Add(opcodes.NewWatcher(opcodeFile, b.opcodeRegistry, b.logger))
+	}
+
 	b.appSupervisor.Add(b.storeProvider)
+	if b.eventJournal != nil {
+		b.appSupervisor.Add(b.eventJournal)
+	}
 
 	appEventWatcher := NewEventWatcher(
 		b.storeProvider.StreamEventSource(),
 		b.cfgProvider,
 		b.authHandler,
+		b.streamManager,
 		ctx,
 		b.logger,
 	)
@@ -163,11 +206,10 @@ func (b *App) Startup(ctx context.Context) {
 
 	b.appSupervisor.Add(b.streamSupervisor)
 
+	b.streamManager.SetThrottleRules(stream.NewThrottleRules(b.cfgProvider.Config().Throttle))
 	b.appSupervisor.Add(b.streamManager)
 
-	for _, adapter := range b.streamAdapters {
-		b.appSupervisor.Add(adapter)
-	}
+	b.appSupervisor.Add(b.adapterSupervisor)
 	b.appSupervisor.Add(b.srv)
 
 	close(b.ready)
@@ -208,7 +250,25 @@ func (b *App) initializeServer() *server.Server {
 		return string(b), err
 	}
 
-	queryResolver := models.NewResolver(b.storeProvider, b.authHandler, streamRequestHandler)
+	var eventJournalReader models.EventJournalReader
+	if b.eventJournal != nil {
+		eventJournalReader = b.eventJournal.Query
+	}
+
+	queryResolver := models.NewResolver(
+		b.storeProvider,
+		b.authHandler,
+		streamRequestHandler,
+		models.WithAdapterLister(b.streamManager.Adapters),
+		models.WithStreamCommandLister(b.streamManager.Commands),
+		models.WithOpcodeTableReporter(b.opcodeRegistry.Report),
+		models.WithThrottleStatsLister(b.streamManager.ThrottleStats),
+		models.WithHookMessageLister(b.streamManager.HookMessages),
+		models.WithCandidateProcessLister(b.streamManager.CandidateProcesses),
+		models.WithProcessAttacher(b.streamManager.AttachProcess),
+		models.WithProcessDetacher(b.streamManager.DetachProcess),
+		models.WithEventJournalReader(eventJournalReader),
+	)
 
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool {
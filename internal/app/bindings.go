@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -57,7 +58,7 @@ type StreamInfo struct {
 }
 
 func (b *Bindings) GetStreams() []StreamInfo {
-	streams, err := b.app.storeProvider.Streams()
+	streams, err := b.app.storeProvider.Streams(context.Background())
 	if err != nil {
 		return nil
 	}
@@ -5,6 +5,7 @@ package app
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/ff14wed/aetherometer/core/config"
 )
@@ -24,11 +25,21 @@ func defaultConfig() (config.Config, error) {
 				Cache: filepath.Join(dirPath, "resources", "maps"),
 			},
 		},
+		Snapshot: config.SnapshotConfig{
+			File: filepath.Join(dirPath, "snapshot.json"),
+		},
+		History: config.HistoryConfig{
+			Duration: config.Duration(time.Minute),
+		},
+		Journal: config.JournalConfig{
+			Dir: filepath.Join(dirPath, "journal"),
+		},
 		Adapters: config.Adapters{
 			Hook: config.HookConfig{
 				Enabled:      true,
 				DLLPath:      filepath.Join(dirPath, "resources", "win", "deucalion.dll"),
 				FFXIVProcess: "ffxiv_dx11.exe",
+				RecordDir:    filepath.Join(dirPath, "recordings"),
 			},
 		},
 	}, nil
@@ -7,6 +7,7 @@ import (
 	"github.com/ff14wed/aetherometer/core/config"
 	"github.com/ff14wed/aetherometer/core/models"
 	"github.com/ff14wed/aetherometer/core/server/handlers"
+	"github.com/ff14wed/aetherometer/core/stream"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 	"go.uber.org/zap"
 )
@@ -16,6 +17,7 @@ type EventWatcher struct {
 	ses            models.StreamEventSource
 	configProvider *config.Provider
 	authHandler    *handlers.Auth
+	streamManager  *stream.Manager
 
 	ctx    context.Context
 	logger *zap.Logger
@@ -29,6 +31,7 @@ func NewEventWatcher(
 	streamEventSource models.StreamEventSource,
 	configProvider *config.Provider,
 	authHandler *handlers.Auth,
+	streamManager *stream.Manager,
 	ctx context.Context,
 	logger *zap.Logger,
 ) *EventWatcher {
@@ -36,6 +39,7 @@ func NewEventWatcher(
 		ses:            streamEventSource,
 		configProvider: configProvider,
 		authHandler:    authHandler,
+		streamManager:  streamManager,
 		ctx:            ctx,
 		logger:         logger.Named("app-event-watcher"),
 
@@ -73,6 +77,7 @@ func (s *EventWatcher) Serve() {
 				s.logger.Error("AuthHandler RefreshConfig", zap.Error(err))
 				EventsEmit(s.ctx, "ErrorEvent", fmt.Sprintf("Error refreshing config for Auth: %s", err))
 			}
+			s.streamManager.SetThrottleRules(stream.NewThrottleRules(s.configProvider.Config().Throttle))
 		case msg := <-cfgErrorsCh:
 			EventsEmit(s.ctx, "ErrorEvent", msg)
 		case <-s.stop: